@@ -8,6 +8,9 @@ let currentMatchId = null;
 let matchTimeoutInterval = null;
 let opponentInfo = null;
 let pendingGameOver = null;
+let disconnectCountdownInterval = null;
+let lobbyPlayers = new Map();
+let opponentMuted = false;
 
 function initGame() {
     gameState = createInitialGameState();
@@ -55,6 +58,15 @@ function setupEventListeners() {
     $('disconnect-btn').addEventListener('click', disconnectFromServer);
     $('accept-match-btn').addEventListener('click', () => respondToMatch(true));
     $('reject-match-btn').addEventListener('click', () => respondToMatch(false));
+    $('create-private-btn').addEventListener('click', createPrivateLobby);
+    $('close-private-btn').addEventListener('click', closePrivateLobby);
+    $('join-private-form').addEventListener('submit', (e) => {
+        e.preventDefault();
+        joinPrivateLobby();
+    });
+    setupChatBox('lobby', CHAT_SCOPE_LOBBY);
+    setupChatBox('game', CHAT_SCOPE_GAME);
+    $('mute-opponent-btn').addEventListener('click', toggleOpponentMute);
 
     document.querySelectorAll('.ship-to-place').forEach(ship => {
         ship.addEventListener('click', () => selectShip(ship));
@@ -63,6 +75,7 @@ function setupEventListeners() {
 
 function startLocalGame() {
     isOnlineMode = false;
+    $('game-chat').classList.add('hidden');
     startPlacement();
 }
 
@@ -101,9 +114,8 @@ async function connectToServer() {
         $('my-player-name').textContent = player.displayName;
         showScreen('lobby-screen');
 
+        // Initial snapshot; the server pushes incremental updates afterwards
         refreshPlayerList();
-        // Auto-refresh player list every 3 seconds
-        setInterval(refreshPlayerList, 3000);
     } catch (error) {
         console.error('Connection error:', error);
         $('status-message').textContent = 'Erreur de connexion: ' + error.message;
@@ -117,12 +129,16 @@ async function connectToServer() {
 function setupMultiplayerEventHandlers() {
     multiplayerClient.on('queueStatus', (status) => {
         if (status.inQueue) {
-            $('queue-message').textContent = `En attente... (${status.playersInQueue} joueur(s) dans la file)`;
+            $('queue-message').textContent = `En attente... (position ${status.queuePosition}/${status.playersInQueue})`;
+        } else {
+            $('join-queue-btn').classList.remove('hidden');
+            $('leave-queue-btn').classList.add('hidden');
+            $('queue-status').classList.add('hidden');
         }
     });
 
     multiplayerClient.on('playerList', (update) => {
-        renderPlayerList(update.availablePlayers);
+        applyPlayerListUpdate(update);
     });
 
     multiplayerClient.on('matchProposal', (proposal) => {
@@ -153,9 +169,26 @@ function setupMultiplayerEventHandlers() {
     });
 
     multiplayerClient.on('gameOver', (result) => {
+        stopDisconnectCountdown();
         pendingGameOver = result;
     });
 
+    multiplayerClient.on('opponentDisconnected', (info) => {
+        startDisconnectCountdown(info.gracePeriodSeconds);
+    });
+
+    multiplayerClient.on('chatMessage', (message) => {
+        appendChatMessage(message);
+    });
+
+    multiplayerClient.on('opponentReconnected', () => {
+        stopDisconnectCountdown();
+        $('waiting-message').textContent = 'Votre adversaire est de retour!';
+        if (gameState) {
+            updateGameInstruction();
+        }
+    });
+
     multiplayerClient.on('error', (error) => {
         console.error('Multiplayer error:', error);
         alert('Erreur de connexion. Retour au menu.');
@@ -163,15 +196,57 @@ function setupMultiplayerEventHandlers() {
     });
 }
 
+function startDisconnectCountdown(seconds) {
+    stopDisconnectCountdown();
+
+    let timeLeft = seconds;
+    const render = () => {
+        const message = `Adversaire déconnecté... victoire par forfait dans ${timeLeft}s`;
+        $('waiting-message').textContent = message;
+        $('game-instruction').textContent = message;
+    };
+    render();
+
+    disconnectCountdownInterval = setInterval(() => {
+        timeLeft = Math.max(0, timeLeft - 1);
+        render();
+        if (timeLeft === 0) {
+            stopDisconnectCountdown();
+        }
+    }, 1000);
+}
+
+function stopDisconnectCountdown() {
+    if (disconnectCountdownInterval) {
+        clearInterval(disconnectCountdownInterval);
+        disconnectCountdownInterval = null;
+    }
+}
+
 async function refreshPlayerList() {
     try {
         const result = await multiplayerClient.listPlayers();
-        renderPlayerList(result.availablePlayers);
+        lobbyPlayers = new Map(result.availablePlayers.map(p => [p.id, p]));
+        renderPlayerList([...lobbyPlayers.values()]);
     } catch (error) {
         console.error('Failed to refresh player list:', error);
     }
 }
 
+// Apply an incremental lobby update: only ONLINE players can be challenged,
+// so players who queued or started a game are dropped like departed ones.
+function applyPlayerListUpdate(update) {
+    update.changedPlayers.forEach(p => {
+        if (p.status === 1) { // PLAYER_STATUS_ONLINE
+            lobbyPlayers.set(p.id, p);
+        } else {
+            lobbyPlayers.delete(p.id);
+        }
+    });
+    update.departedPlayerIds.forEach(id => lobbyPlayers.delete(id));
+    renderPlayerList([...lobbyPlayers.values()]);
+}
+
 function renderPlayerList(players) {
     const container = $('players-list');
     container.innerHTML = '';
@@ -239,6 +314,7 @@ function showMatchProposal(proposal) {
     }
 
     $('match-opponent-name').textContent = proposal.opponent.displayName;
+    $('match-rules').textContent = describeRules(proposal.rules);
 
     let timeLeft = proposal.timeoutSeconds;
     $('match-timeout').textContent = `${timeLeft}s`;
@@ -290,7 +366,11 @@ function startOnlineGame(game) {
     gameState.onlineGameId = game.gameId;
     gameState.myTurnFirst = game.yourTurnFirst;
     gameState.opponentGrid = createEmptyGrid();
+    gameState.rules = game.rules;
+    gameState.terrain = terrainFromProto(game.terrain);
 
+    resetPrivateLobbyUI();
+    resetGameChat();
     resetPlacementUI();
     $('placement-player').textContent = multiplayerClient.player.displayName;
     showScreen('placement-screen');
@@ -305,9 +385,185 @@ function disconnectFromServer() {
     isOnlineMode = false;
     currentMatchId = null;
     opponentInfo = null;
+    $('game-chat').classList.add('hidden');
     showScreen('welcome-screen');
 }
 
+// =============================================================================
+// Private lobbies
+// =============================================================================
+
+const RULE_SET_SALVO = 2;
+const BONUS_TURN_ON_SINK = 2;
+
+function describeRules(rules) {
+    if (!rules) return '';
+    const parts = [rules.ruleSet === RULE_SET_SALVO ? 'salve' : 'classique'];
+    if (rules.powersDisabled) parts.push('sans pouvoirs');
+    if (rules.noTouch) parts.push('bateaux non collés');
+    if (rules.terrain) parts.push('îles et mines');
+    if (rules.defensivePowers) parts.push('bouclier, réparation et leurre');
+    if (rules.bonusTurn) {
+        const cap = rules.maxBonusTurns ? ` (max ${rules.maxBonusTurns})` : '';
+        parts.push((rules.bonusTurn === BONUS_TURN_ON_SINK ? 'rejoue si coulé' : 'rejoue si touché') + cap);
+    }
+    return 'Règles: ' + parts.join(', ');
+}
+
+async function createPrivateLobby() {
+    try {
+        const lobby = await multiplayerClient.createPrivateLobby({
+            ruleSet: Number($('private-rule-set').value),
+            bonusTurn: Number($('private-bonus-turn').value),
+            powersDisabled: $('private-no-powers').checked,
+            noTouch: $('private-no-touch').checked,
+            terrain: $('private-terrain').checked,
+            defensivePowers: $('private-defensive-powers').checked,
+        });
+        $('private-lobby-code').textContent = lobby.code;
+        $('private-lobby-create').classList.add('hidden');
+        $('private-lobby-hosting').classList.remove('hidden');
+        // Hosting takes the player out of the public queue
+        $('join-queue-btn').classList.remove('hidden');
+        $('leave-queue-btn').classList.add('hidden');
+        $('queue-status').classList.add('hidden');
+    } catch (error) {
+        console.error('Failed to create private lobby:', error);
+        alert('Impossible de créer la partie privée');
+    }
+}
+
+async function closePrivateLobby() {
+    try {
+        await multiplayerClient.closePrivateLobby();
+    } catch (error) {
+        console.error('Failed to close private lobby:', error);
+    }
+    resetPrivateLobbyUI();
+}
+
+function resetPrivateLobbyUI() {
+    $('private-lobby-code').textContent = '';
+    $('private-lobby-create').classList.remove('hidden');
+    $('private-lobby-hosting').classList.add('hidden');
+}
+
+async function joinPrivateLobby() {
+    const code = $('private-code-input').value.trim();
+    if (!code) return;
+    try {
+        await multiplayerClient.joinPrivateLobby(code);
+        $('private-code-input').value = '';
+    } catch (error) {
+        console.error('Failed to join private lobby:', error);
+        alert('Code invalide ou partie introuvable');
+    }
+}
+
+// =============================================================================
+// Chat
+// =============================================================================
+
+const CHAT_SCOPE_GAME = 1;
+const CHAT_SCOPE_LOBBY = 2;
+
+const QUICK_EMOTES = {
+    1: '👋 Ohé!',               // AHOY
+    2: '🏴‍☠️ Arrr!',              // ARRR
+    3: '🎯 Joli tir!',          // NICE_SHOT
+    4: '💨 Mille sabords!',     // BLOW_ME_DOWN
+    5: '🦈 Par-dessus bord!',   // WALK_THE_PLANK
+    6: '🤝 Bien joué!',         // GOOD_GAME
+};
+
+function setupChatBox(prefix, scope) {
+    const emotes = $(`${prefix}-chat-emotes`);
+    Object.entries(QUICK_EMOTES).forEach(([emote, label]) => {
+        const btn = document.createElement('button');
+        btn.type = 'button';
+        btn.textContent = label;
+        btn.addEventListener('click', () => sendChat(scope, '', Number(emote)));
+        emotes.appendChild(btn);
+    });
+
+    $(`${prefix}-chat-form`).addEventListener('submit', (e) => {
+        e.preventDefault();
+        const input = $(`${prefix}-chat-input`);
+        const text = input.value.trim();
+        if (!text) return;
+        input.value = '';
+        sendChat(scope, text);
+    });
+}
+
+async function sendChat(scope, text, emote = 0) {
+    if (!multiplayerClient) return;
+    try {
+        const result = await multiplayerClient.sendChatMessage(scope, text, emote);
+        appendChatMessage(result.message);
+    } catch (error) {
+        console.error('Failed to send chat message:', error);
+        appendChatNotice(scope, error.rawMessage || 'Message non envoyé');
+    }
+}
+
+function chatContainer(scope) {
+    return $(scope === CHAT_SCOPE_GAME ? 'game-chat-messages' : 'lobby-chat-messages');
+}
+
+function appendChatMessage(message) {
+    const container = chatContainer(message.scope);
+    const item = document.createElement('div');
+    item.className = 'chat-message';
+    if (message.senderId === multiplayerClient.player.id) {
+        item.classList.add('mine');
+    }
+
+    const sender = document.createElement('span');
+    sender.className = 'chat-sender';
+    sender.textContent = message.senderName + ':';
+    item.appendChild(sender);
+
+    if (message.emote) {
+        item.classList.add('emote');
+        item.appendChild(document.createTextNode(QUICK_EMOTES[message.emote] || ''));
+    } else {
+        item.appendChild(document.createTextNode(message.text));
+    }
+
+    container.appendChild(item);
+    container.scrollTop = container.scrollHeight;
+}
+
+function appendChatNotice(scope, text) {
+    const container = chatContainer(scope);
+    const item = document.createElement('div');
+    item.className = 'chat-message emote';
+    item.textContent = text;
+    container.appendChild(item);
+    container.scrollTop = container.scrollHeight;
+}
+
+function resetGameChat() {
+    opponentMuted = false;
+    $('game-chat-messages').innerHTML = '';
+    $('mute-opponent-btn').textContent = "🔇 Ignorer l'adversaire";
+    $('game-chat').classList.remove('hidden');
+}
+
+async function toggleOpponentMute() {
+    if (!multiplayerClient || !opponentInfo) return;
+    try {
+        await multiplayerClient.mutePlayer(opponentInfo.id, !opponentMuted);
+        opponentMuted = !opponentMuted;
+        $('mute-opponent-btn').textContent = opponentMuted
+            ? "🔊 Écouter l'adversaire"
+            : "🔇 Ignorer l'adversaire";
+    } catch (error) {
+        console.error('Failed to mute opponent:', error);
+    }
+}
+
 // =============================================================================
 // Placement Phase
 // =============================================================================
@@ -361,9 +617,37 @@ function resetPlacement() {
     renderPlacementGrid();
 }
 
+// Local games have no rules object: ships may touch and there is no terrain.
+function placementConstraints() {
+    const constraints = { noTouch: Boolean(gameState.rules && gameState.rules.noTouch) };
+    if (gameState.terrain) {
+        constraints.blocked = new Set([...gameState.terrain.islands, ...gameState.terrain.mines]);
+    }
+    return constraints;
+}
+
+function terrainFromProto(terrain) {
+    if (!terrain) return null;
+    return {
+        islands: new Set(terrain.islands.map(c => cellKey(c.x, c.y))),
+        mines: new Set(terrain.yourMines.map(c => cellKey(c.x, c.y))),
+    };
+}
+
+// Mines are only shown on the player's own boards.
+function decorateTerrainCell(cell, x, y, showMines) {
+    if (!gameState.terrain) return;
+    const key = cellKey(x, y);
+    if (gameState.terrain.islands.has(key)) {
+        cell.classList.add('island');
+    } else if (showMines && gameState.terrain.mines.has(key)) {
+        cell.classList.add('mine');
+    }
+}
+
 function randomPlacement() {
     const player = gameState.currentPlayer;
-    placeShipsRandomly(gameState.players[player].grid, gameState.players[player].ships);
+    placeShipsRandomly(gameState.players[player].grid, gameState.players[player].ships, placementConstraints());
     document.querySelectorAll('.ship-to-place').forEach(el => {
         el.classList.add('placed');
         el.classList.remove('selected');
@@ -389,6 +673,7 @@ function renderPlacementGrid() {
             if (grid[y][x]) {
                 cell.classList.add('ship');
             }
+            decorateTerrainCell(cell, x, y, true);
 
             cell.addEventListener('mouseenter', () => showPreview(x, y));
             cell.addEventListener('mouseleave', clearPreview);
@@ -415,7 +700,7 @@ function showPreview(startX, startY) {
 
     const grid = gameState.players[gameState.currentPlayer].grid;
     const cells = getShipCells(startX, startY, gameState.selectedShip.size, gameState.isHorizontal);
-    const isValid = canPlaceShip(grid, startX, startY, gameState.selectedShip.size, gameState.isHorizontal);
+    const isValid = canPlaceShip(grid, startX, startY, gameState.selectedShip.size, gameState.isHorizontal, placementConstraints());
 
     cells.forEach(({ x, y }) => {
         if (x >= 0 && x < GRID_SIZE && y >= 0 && y < GRID_SIZE) {
@@ -440,7 +725,7 @@ function handlePlaceShip(startX, startY) {
     const grid = gameState.players[gameState.currentPlayer].grid;
     const ships = gameState.players[gameState.currentPlayer].ships;
 
-    const newShip = placeShipOnGrid(grid, ships, gameState.selectedShip, startX, startY, gameState.isHorizontal);
+    const newShip = placeShipOnGrid(grid, ships, gameState.selectedShip, startX, startY, gameState.isHorizontal, placementConstraints());
 
     if (!newShip) return;
 
@@ -550,6 +835,7 @@ function renderAttackGrid(opponentGrid) {
             } else if (cellData && cellData.revealed) {
                 cell.classList.add('revealed');
             }
+            decorateTerrainCell(cell, x, y, false);
 
             cell.addEventListener('click', () => handleAttack(x, y));
             cell.addEventListener('mouseenter', () => showPowerPreview(x, y));
@@ -568,6 +854,10 @@ function renderMyFleetPreview(myGrid) {
         for (let x = 0; x < GRID_SIZE; x++) {
             const cell = document.createElement('div');
             cell.className = 'cell';
+            cell.addEventListener('click', () => handleOwnGridClick(x, y));
+            if (gameState.shield && gameState.shield.has(cellKey(x, y))) {
+                cell.classList.add('shielded');
+            }
 
             const cellData = myGrid[y][x];
             if (cellData && cellData.shipId) {
@@ -578,9 +868,15 @@ function renderMyFleetPreview(myGrid) {
                 } else {
                     cell.classList.add('ship');
                 }
+            } else if (cellData && cellData.decoy) {
+                cell.classList.add(cellData.hit ? 'hit' : 'decoy');
             } else if (cellData === 'miss') {
                 cell.classList.add('miss');
             }
+            if (cellData && cellData.scanned) {
+                cell.classList.add('scanned');
+            }
+            decorateTerrainCell(cell, x, y, true);
 
             gridElement.appendChild(cell);
         }
@@ -626,38 +922,88 @@ function togglePower(powerType) {
             gameState.tripleDirection = 'horizontal';
         }
     }
-    renderPowers();
+    if (isOnlineMode) {
+        renderOnlinePowers();
+    } else {
+        renderPowers();
+    }
     updateGameInstruction();
     clearPowerPreview();
 }
 
+// Defensive powers target the player's own grid. They only exist online.
+const DEFENSIVE_POWERS = {
+    shield: { powerName: 'Bouclier', powerDesc: 'Cliquez sur votre grille: protège une zone 3x3 du prochain tir' },
+    repair: { powerName: 'Réparation', powerDesc: 'Cliquez sur une case touchée de votre grille pour la réparer' },
+    decoy: { powerName: 'Leurre', powerDesc: 'Cliquez sur une case vide de votre grille: un faux navire pour tromper l\'ennemi' },
+};
+
+function isDefensivePower(power) {
+    return Boolean(DEFENSIVE_POWERS[power]);
+}
+
 function updateGameInstruction() {
     const instruction = $('game-instruction');
 
     if (gameState.activePower) {
-        const powerInfo = SHIPS.find(s => s.power === gameState.activePower);
+        const powerInfo = SHIPS.find(s => s.power === gameState.activePower) || DEFENSIVE_POWERS[gameState.activePower];
         if (gameState.activePower === 'triple') {
-            const dirIcon = gameState.tripleDirection === 'horizontal' ? '↔️ Horizontal' : '↕️ Vertical';
+            const dirIcon = TRIPLE_DIRECTION_LABELS[gameState.tripleDirection];
             instruction.innerHTML = `<strong>${POWER_ICONS[gameState.activePower]} ${powerInfo.powerName}</strong> - Cliquez sur une case pour tirer (${dirIcon})<br><button class="pirate-btn small" onclick="toggleTripleDirection()">Changer direction</button>`;
         } else {
             instruction.innerHTML = `<strong>${POWER_ICONS[gameState.activePower]} ${powerInfo.powerName}</strong> - ${powerInfo.powerDesc}`;
         }
+    } else if (isOnlineMode && gameState.salvoShots > 0) {
+        const count = gameState.salvoTargets.length;
+        const ready = count === gameState.salvoShots ? '' : 'disabled';
+        instruction.innerHTML = `Salve: choisissez ${gameState.salvoShots} cibles (${count}/${gameState.salvoShots})<br><button class="pirate-btn small" onclick="fireSalvo()" ${ready}>🔥 Feu!</button>`;
     } else {
         instruction.textContent = 'Choisissez où tirer!';
     }
 }
 
+const TRIPLE_DIRECTION_LABELS = {
+    'horizontal': '↔️ Horizontal',
+    'vertical': '↕️ Vertical',
+    'diagonal-up': '↗️ Diagonale',
+    'diagonal-down': '↘️ Diagonale',
+};
+
+// Diagonal shots are resolved by the server, so only online games offer them.
+function tripleDirections() {
+    return isOnlineMode ? Object.keys(TRIPLE_DIRECTION_LABELS) : ['horizontal', 'vertical'];
+}
+
 function toggleTripleDirection() {
-    gameState.tripleDirection = gameState.tripleDirection === 'horizontal' ? 'vertical' : 'horizontal';
+    const directions = tripleDirections();
+    const next = (directions.indexOf(gameState.tripleDirection) + 1) % directions.length;
+    gameState.tripleDirection = directions[next];
     updateGameInstruction();
     clearPowerPreview();
 }
 
-function showPowerPreview(x, y) {
-    if (!gameState.activePower) return;
+// Online previews come from the server, which knows the power's exact
+// pattern. previewRequest drops answers that arrive after the cursor moved.
+let previewRequest = 0;
+
+async function showPowerPreview(x, y) {
+    if (!gameState.activePower || isDefensivePower(gameState.activePower)) return;
 
     clearPowerPreview();
-    const cells = getPowerTargetCells(x, y, gameState.activePower, gameState.tripleDirection);
+    let cells;
+    if (isOnlineMode) {
+        const request = ++previewRequest;
+        try {
+            const preview = await multiplayerClient.previewPower(gameState.activePower, x, y, gameState.tripleDirection || 'horizontal');
+            if (request !== previewRequest) return;
+            cells = preview.cells;
+        } catch (error) {
+            console.error('Preview error:', error);
+            return;
+        }
+    } else {
+        cells = getPowerTargetCells(x, y, gameState.activePower, gameState.tripleDirection);
+    }
 
     cells.forEach(({ x: cx, y: cy }) => {
         const cell = document.querySelector(`#attack-grid .cell[data-x="${cx}"][data-y="${cy}"]`);
@@ -668,6 +1014,7 @@ function showPowerPreview(x, y) {
 }
 
 function clearPowerPreview() {
+    previewRequest++;
     document.querySelectorAll('#attack-grid .cell.power-target').forEach(cell => {
         cell.classList.remove('power-target');
     });
@@ -691,10 +1038,16 @@ async function handleAttack(x, y) {
 }
 
 async function handleOnlineAttack(x, y) {
+    if (isDefensivePower(gameState.activePower)) return;
+    if (!gameState.activePower && gameState.salvoShots > 0) {
+        toggleSalvoTarget(x, y);
+        return;
+    }
+
     try {
         if (gameState.activePower) {
-            const horizontal = gameState.tripleDirection === 'horizontal';
-            const result = await multiplayerClient.usePower(gameState.activePower, x, y, horizontal);
+            const direction = gameState.tripleDirection || 'horizontal';
+            const result = await multiplayerClient.usePower(gameState.activePower, x, y, direction);
             showOnlinePowerResult(result);
             gameState.activePower = null;
         } else {
@@ -707,6 +1060,81 @@ async function handleOnlineAttack(x, y) {
     }
 }
 
+async function handleOwnGridClick(x, y) {
+    if (!isOnlineMode || !gameState.isMyTurn || !isDefensivePower(gameState.activePower)) return;
+    try {
+        const result = await multiplayerClient.usePower(gameState.activePower, x, y);
+        showOnlinePowerResult(result);
+        gameState.activePower = null;
+    } catch (error) {
+        console.error('Power error:', error);
+        alert(error.rawMessage || 'Impossible d\'utiliser ce pouvoir');
+    }
+}
+
+function toggleSalvoTarget(x, y) {
+    const cellData = gameState.opponentGrid[y][x];
+    if (cellData === 'miss' || (cellData && cellData.hit)) return;
+    if (gameState.terrain && gameState.terrain.islands.has(cellKey(x, y))) return;
+
+    const index = gameState.salvoTargets.findIndex(t => t.x === x && t.y === y);
+    if (index >= 0) {
+        gameState.salvoTargets.splice(index, 1);
+    } else if (gameState.salvoTargets.length < gameState.salvoShots) {
+        gameState.salvoTargets.push({ x, y });
+    }
+
+    const cell = document.querySelector(`#attack-grid .cell[data-x="${x}"][data-y="${y}"]`);
+    if (cell) {
+        cell.classList.toggle('salvo-target', gameState.salvoTargets.some(t => t.x === x && t.y === y));
+    }
+    updateGameInstruction();
+}
+
+async function fireSalvo() {
+    if (gameState.salvoTargets.length !== gameState.salvoShots) return;
+    try {
+        const result = await multiplayerClient.attackSalvo(gameState.salvoTargets);
+        gameState.salvoTargets = [];
+        showOnlineSalvoResult(result);
+    } catch (error) {
+        console.error('Salvo error:', error);
+        alert('Erreur lors de la salve');
+    }
+}
+
+function showOnlineSalvoResult(result) {
+    const hits = result.shots.filter(shot => shot.hit).length;
+
+    $('result-icon').textContent = result.sunkShips.length > 0 ? '☠️' : (hits > 0 ? '💥' : '💨');
+    $('result-title').textContent = `${hits} touché(s) sur ${result.shots.length}`;
+    $('result-message').textContent = result.sunkShips.length > 0
+        ? `Coulé: ${result.sunkShips.map(ship => ship.name).join(', ')}!`
+        : '';
+
+    const powerGained = $('power-gained');
+    if (result.powersGained.length > 0) {
+        powerGained.classList.remove('hidden');
+        $('power-gained-name').textContent = result.powersGained.map(power => power.name).join(', ');
+        $('power-description').textContent = `L'adversaire obtient ces pouvoirs!`;
+    } else {
+        powerGained.classList.add('hidden');
+    }
+
+    result.shots
+        .filter(shot => !shot.shielded)
+        .forEach(shot => updateOnlineAttackGrid(shot.target, shot.hit, shot.sunkShip));
+    if (result.shots.some(shot => shot.shielded)) {
+        $('result-message').textContent += ' 🛡️ Un bouclier a protégé une partie de la flotte ennemie.';
+    }
+    const blasts = result.shots.filter(shot => shot.mineBlast).map(shot => shot.mineBlast);
+    if (blasts.length > 0) {
+        $('result-message').textContent += ` 💣 ${blasts.length} mine(s) ont endommagé votre flotte!`;
+        blasts.forEach(applyMineBlast);
+    }
+    showScreen('result-screen');
+}
+
 function showOnlineAttackResult(result) {
     const resultIcon = $('result-icon');
     const resultTitle = $('result-title');
@@ -730,6 +1158,24 @@ function showOnlineAttackResult(result) {
         resultTitle.textContent = 'Touché!';
         resultMessage.textContent = 'Bien visé, Capitaine!';
         powerGained.classList.add('hidden');
+    } else if (result.shielded) {
+        resultIcon.textContent = '🛡️';
+        resultTitle.textContent = 'Bouclier!';
+        resultMessage.textContent = 'Le boulet rebondit sur un bouclier ennemi.';
+        powerGained.classList.add('hidden');
+        showScreen('result-screen');
+        return;
+    } else if (result.mine) {
+        resultIcon.textContent = '💣';
+        resultTitle.textContent = 'Une mine!';
+        resultMessage.textContent = describeMineBlast(result.mineBlast);
+        powerGained.classList.add('hidden');
+        applyMineBlast(result.mineBlast);
+    } else if (result.island) {
+        resultIcon.textContent = '🏝️';
+        resultTitle.textContent = 'Une île!';
+        resultMessage.textContent = 'Le boulet s\'écrase sur les rochers, tir perdu.';
+        powerGained.classList.add('hidden');
     } else {
         resultIcon.textContent = '💨';
         resultTitle.textContent = 'À l\'eau!';
@@ -752,12 +1198,22 @@ function showOnlinePowerResult(result) {
         2: '🎯', // TRIPLE
         3: '📡', // SONAR
         4: '🐙', // KRAKEN
+        5: '🛡️', // SHIELD
+        6: '🔧', // REPAIR
+        7: '🎭', // DECOY
     };
 
     resultIcon.textContent = powerIcons[result.powerUsed] || '⚡';
     resultTitle.textContent = result.message || 'Pouvoir utilisé!';
     resultMessage.textContent = '';
 
+    if (result.ownGrid) {
+        applyDefensivePower(result);
+        powerGained.classList.add('hidden');
+        showScreen('result-screen');
+        return;
+    }
+
     if (result.sunkShips && result.sunkShips.length > 0) {
         resultIcon.textContent = '☠️';
         resultTitle.textContent = 'Coulé!';
@@ -768,7 +1224,12 @@ function showOnlinePowerResult(result) {
         powerGained.classList.add('hidden');
     }
 
-    result.cellsAffected.forEach(cell => {
+    const shielded = result.cellsAffected.filter(cell => cell.state === 8).length; // SHIELDED
+    if (shielded > 0) {
+        resultMessage.textContent = `🛡️ Un bouclier a absorbé ${shielded} case(s).`;
+    }
+
+    result.cellsAffected.filter(cell => cell.state !== 8).forEach(cell => {
         const isHit = cell.state === 3 || cell.state === 4; // HIT or SUNK
         const isSunk = cell.state === 4;
         const isRevealed = cell.state === 5; // REVEALED (sonar)
@@ -776,6 +1237,11 @@ function showOnlinePowerResult(result) {
         updateOnlineAttackGrid(cell.position, isHit, sunkShip, isRevealed);
     });
 
+    if (result.mineBlasts.length > 0) {
+        resultMessage.textContent = `💣 ${result.mineBlasts.length} mine(s) ont endommagé votre flotte!`;
+        result.mineBlasts.forEach(applyMineBlast);
+    }
+
     showScreen('result-screen');
 }
 
@@ -804,6 +1270,44 @@ function updateOnlineAttackGrid(coord, hit, sunkShip, revealed) {
     }
 }
 
+function applyDefensivePower(result) {
+    if (result.powerUsed === 5) { // SHIELD
+        gameState.shield = new Set(result.cellsAffected.map(cell => cellKey(cell.position.x, cell.position.y)));
+        $('result-message').textContent = 'Votre bouclier protège la zone du prochain tir.';
+    } else if (result.powerUsed === 6) { // REPAIR
+        result.cellsAffected.forEach(({ position }) => {
+            const cell = gameState.players[1].grid[position.y][position.x];
+            if (cell && cell.shipId) cell.hit = false;
+        });
+        $('result-message').textContent = 'Votre navire est réparé!';
+    } else if (result.powerUsed === 7) { // DECOY
+        result.cellsAffected.forEach(({ position }) => {
+            gameState.players[1].grid[position.y][position.x] = { decoy: true, hit: false };
+        });
+        $('result-message').textContent = 'Un leurre flotte sur votre grille.';
+    }
+}
+
+function describeMineBlast(blast) {
+    if (!blast) return 'La mine explose, mais votre flotte est déjà par le fond.';
+    if (blast.sunkShip) return `L'explosion coule votre ${blast.sunkShip.name}!`;
+    return 'L\'explosion endommage un de vos navires!';
+}
+
+// applyMineBlast marks the damage an enemy mine did to the player's fleet.
+function applyMineBlast(blast) {
+    if (!blast || !blast.target) return;
+    const grid = gameState.players[1].grid;
+    const cell = grid[blast.target.y][blast.target.x];
+    if (!cell || !cell.shipId) return;
+    cell.hit = true;
+    if (blast.sunkShip) {
+        grid.forEach(row => row.forEach(other => {
+            if (other && other.shipId === cell.shipId) other.sunk = true;
+        }));
+    }
+}
+
 function handleTurnStarted(turn) {
     console.log('handleTurnStarted called:', turn);
     console.log('gameState before:', gameState);
@@ -811,6 +1315,12 @@ function handleTurnStarted(turn) {
     gameState.phase = 'battle';
     gameState.isMyTurn = turn.yourTurn;
     gameState.availablePowers = turn.availablePowers;
+    gameState.salvoShots = turn.salvoShots;
+    gameState.salvoTargets = [];
+    gameState.bonusTurn = turn.bonusTurn;
+    if (turn.view) {
+        applyView(turn.view);
+    }
 
     if (turn.yourTurn) {
         console.log('My turn - showing game-screen');
@@ -819,23 +1329,128 @@ function handleTurnStarted(turn) {
         renderOnlineGrids();
     } else {
         console.log('Opponent turn - showing waiting screen');
-        $('waiting-message').textContent = 'Tour de l\'adversaire...';
+        $('waiting-message').textContent = turn.bonusTurn
+            ? 'L\'adversaire a touché et rejoue...'
+            : 'Tour de l\'adversaire...';
         showScreen('waiting-opponent-screen');
     }
 }
 
 function handleOpponentAction(action) {
-    action.yourGridUpdates.forEach(update => {
-        const cell = gameState.players[1].grid[update.position.y][update.position.x];
-        if (cell && cell.shipId) {
-            cell.hit = true;
-            if (update.state === 4) { // SUNK
-                cell.sunk = true;
+    const mines = countMinesSetOff(action);
+    if (mines > 0) {
+        appendChatNotice(CHAT_SCOPE_GAME, `💣 ${mines} de vos mines ont explosé sous l'adversaire!`);
+    }
+    if (gameState.shield && shieldWasHit(action)) {
+        gameState.shield = null;
+        appendChatNotice(CHAT_SCOPE_GAME, '🛡️ Votre bouclier a absorbé le tir et s\'est effondré.');
+    }
+
+    const { case: kind, value } = action.action;
+    if (kind === 'power' && value.powerUsed === 6) { // REPAIR: no longer hit, still a known ship cell
+        value.cellsAffected.forEach(({ position }) => {
+            gameState.opponentGrid[position.y][position.x] = { revealed: true };
+        });
+        appendChatNotice(CHAT_SCOPE_GAME, '🔧 L\'adversaire a réparé un de ses navires.');
+    } else if (kind === 'power' && value.powerUsed === 5) {
+        appendChatNotice(CHAT_SCOPE_GAME, '🛡️ L\'adversaire a levé un bouclier.');
+    } else if (kind === 'power' && value.powerUsed === 7) {
+        appendChatNotice(CHAT_SCOPE_GAME, '🎭 L\'adversaire a mis un leurre à l\'eau.');
+    }
+
+    action.yourGridUpdates.forEach(applyOwnGridUpdate);
+}
+
+// applyOwnGridUpdate records what the opponent did to a cell of our grid.
+function applyOwnGridUpdate({ position, state }) {
+    const grid = gameState.players[1].grid;
+    const cell = grid[position.y][position.x];
+    switch (state) {
+        case 3: // HIT
+        case 4: // SUNK
+            if (cell && (cell.shipId || cell.decoy)) {
+                cell.hit = true;
+                cell.sunk = state === 4;
+            } else {
+                grid[position.y][position.x] = { decoy: true, hit: true };
             }
-        } else {
-            gameState.players[1].grid[update.position.y][update.position.x] = 'miss';
+            break;
+        case 2: // MISS
+        case 7: // MINE, spent
+            grid[position.y][position.x] = 'miss';
+            break;
+        case 5: // REVEALED: seen by the opponent's sonar
+            if (cell && typeof cell === 'object') {
+                cell.scanned = true;
+            } else if (!cell) {
+                grid[position.y][position.x] = { scanned: true };
+            }
+            break;
+        case 10: // DECOY
+            grid[position.y][position.x] = { decoy: true, hit: false };
+            break;
+    }
+}
+
+// applyView resyncs both grids with the server's view of the game, so that
+// nothing learned is lost, reconnections included.
+function applyView(view) {
+    const opponentGrid = createEmptyGrid();
+    view.opponentBoard.cells.forEach(({ position, state }) => {
+        const { x, y } = position;
+        switch (state) {
+            case 4: // SUNK
+                opponentGrid[y][x] = { hit: true, sunk: true, shipId: 'unknown' };
+                break;
+            case 3: // HIT
+                opponentGrid[y][x] = { hit: true, shipId: 'unknown' };
+                break;
+            case 5: // REVEALED
+                opponentGrid[y][x] = { revealed: true };
+                break;
+            case 1: // EMPTY, scanned
+            case 2: // MISS
+            case 7: // MINE
+                opponentGrid[y][x] = 'miss';
+                break;
         }
     });
+    gameState.opponentGrid = opponentGrid;
+
+    const grid = gameState.players[1].grid;
+    for (let y = 0; y < GRID_SIZE; y++) {
+        for (let x = 0; x < GRID_SIZE; x++) {
+            const cell = grid[y][x];
+            if (cell && cell.shipId) {
+                cell.hit = false;
+                cell.sunk = false;
+                cell.scanned = false;
+            } else {
+                grid[y][x] = null;
+            }
+        }
+    }
+    view.yourBoard.cells.forEach(applyOwnGridUpdate);
+}
+
+function shieldWasHit(action) {
+    const { case: kind, value } = action.action;
+    switch (kind) {
+        case 'attack': return value.shielded;
+        case 'salvo': return value.shots.some(shot => shot.shielded);
+        case 'power': return !value.ownGrid && value.cellsAffected.some(cell => cell.state === 8); // SHIELDED
+        default: return false;
+    }
+}
+
+function countMinesSetOff(action) {
+    const { case: kind, value } = action.action;
+    switch (kind) {
+        case 'attack': return value.mine ? 1 : 0;
+        case 'salvo': return value.shots.filter(shot => shot.mine).length;
+        case 'power': return value.cellsAffected.filter(cell => cell.state === 7).length; // MINE
+        default: return 0;
+    }
 }
 
 function handleOnlineGameOver(result) {
@@ -852,13 +1467,60 @@ function handleOnlineGameOver(result) {
         title.textContent = 'Défaite...';
         $('winner').textContent = opponentInfo ? opponentInfo.displayName : 'Adversaire';
     }
+    renderGameSummary(result);
     showScreen('victory-screen');
 }
 
+const GAME_OVER_REASONS = {
+    1: 'Flotte coulée',            // ALL_SHIPS_SUNK
+    2: 'Abandon',                  // FORFEIT
+    3: 'Temps de tour écoulé',     // TURN_TIMEOUT
+    4: 'Déconnexion',              // DISCONNECT
+    5: 'Temps de placement écoulé', // PLACEMENT_TIMEOUT
+};
+
+function renderGameSummary(result) {
+    const container = $('game-summary');
+    const summary = result.summary;
+    if (!summary) {
+        container.classList.add('hidden');
+        return;
+    }
+
+    const formatStats = (stats) => stats
+        ? `${stats.hits}/${stats.shotsFired} touchés (${Math.round(stats.accuracy * 100)}%), ${stats.powersUsed.length} pouvoir(s)`
+        : '-';
+    const formatShip = (fleetShip) => {
+        const ship = fleetShip.ship;
+        const cells = getShipCells(ship.start.x, ship.start.y, ship.size, ship.horizontal);
+        const last = cells[cells.length - 1];
+        const status = fleetShip.sunk ? '☠️' : `${fleetShip.hits}/${ship.size}`;
+        return `<li>${ship.name} (${ship.start.x},${ship.start.y}) → (${last.x},${last.y}) ${status}</li>`;
+    };
+
+    const minutes = Math.floor(summary.durationSeconds / 60);
+    const seconds = summary.durationSeconds % 60;
+
+    container.innerHTML = `
+        <p><strong>${GAME_OVER_REASONS[result.reason] || 'Fin de partie'}</strong> — ${minutes}m${String(seconds).padStart(2, '0')}s</p>
+        <p>Vous : ${formatStats(summary.yourStats)}</p>
+        <p>Adversaire : ${formatStats(summary.opponentStats)}</p>
+        <p>Flotte ennemie :</p>
+        <ul>${summary.opponentFleet.map(formatShip).join('')}</ul>
+        ${summary.opponentDecoys.length > 0
+            ? `<p>Leurres ennemis : ${summary.opponentDecoys.map(c => `(${c.x},${c.y})`).join(', ')}</p>`
+            : ''}
+    `;
+    container.classList.remove('hidden');
+}
+
 function renderOnlineBattleUI() {
     $('current-player').textContent = multiplayerClient.player.displayName;
     renderOnlinePowers();
     updateGameInstruction();
+    if (gameState.bonusTurn) {
+        $('game-instruction').insertAdjacentHTML('afterbegin', '<strong>🎯 Tir bonus!</strong> ');
+    }
 }
 
 function renderOnlineGrids() {
@@ -870,6 +1532,10 @@ function renderOnlinePowers() {
     const powersList = $('powers-list');
     powersList.innerHTML = '';
 
+    const powersDisabled = gameState.rules && gameState.rules.powersDisabled;
+    $('powers-panel').classList.toggle('hidden', powersDisabled);
+    if (powersDisabled) return;
+
     const powers = gameState.availablePowers || [];
 
     if (powers.length === 0) {
@@ -882,6 +1548,9 @@ function renderOnlinePowers() {
         2: '🎯',
         3: '📡',
         4: '🐙',
+        5: '🛡️',
+        6: '🔧',
+        7: '🎭',
     };
 
     const powerNames = {
@@ -889,6 +1558,9 @@ function renderOnlinePowers() {
         2: 'triple',
         3: 'sonar',
         4: 'kraken',
+        5: 'shield',
+        6: 'repair',
+        7: 'decoy',
     };
 
     powers.forEach((power) => {
@@ -1020,6 +1692,7 @@ function handleContinue() {
 
 function showVictory(winner) {
     $('winner').textContent = winner;
+    $('game-summary').classList.add('hidden');
     showScreen('victory-screen');
 }
 
@@ -12,7 +12,10 @@ const POWER_ICONS = {
     kraken: '🐙',
     sonar: '📡',
     triple: '🎯',
-    instakill: '💀'
+    instakill: '💀',
+    shield: '🛡️',
+    repair: '🔧',
+    decoy: '🎭'
 };
 
 function createEmptyGrid() {
@@ -45,20 +48,42 @@ function getShipCells(startX, startY, size, horizontal) {
     return cells;
 }
 
-function canPlaceShip(grid, startX, startY, size, horizontal) {
+function cellKey(x, y) {
+    return `${x},${y}`;
+}
+
+// Constraints come from the game rules: with noTouch, the ship may not
+// touch another one, even diagonally; blocked is a Set of cellKey()s, such
+// as islands and mines, where no ship may go.
+function canPlaceShip(grid, startX, startY, size, horizontal, { noTouch = false, blocked = null } = {}) {
     const cells = getShipCells(startX, startY, size, horizontal);
-    return cells.every(({ x, y }) =>
+    const fits = cells.every(({ x, y }) =>
         x >= 0 && x < GRID_SIZE &&
         y >= 0 && y < GRID_SIZE &&
-        !grid[y][x]
+        !grid[y][x] &&
+        !(blocked && blocked.has(cellKey(x, y)))
     );
+    if (!fits || !noTouch) return fits;
+
+    return cells.every(({ x, y }) => {
+        for (let dy = -1; dy <= 1; dy++) {
+            for (let dx = -1; dx <= 1; dx++) {
+                const nx = x + dx;
+                const ny = y + dy;
+                if (nx >= 0 && nx < GRID_SIZE && ny >= 0 && ny < GRID_SIZE && grid[ny][nx]) {
+                    return false;
+                }
+            }
+        }
+        return true;
+    });
 }
 
-function placeShipOnGrid(grid, ships, shipTemplate, startX, startY, horizontal) {
+function placeShipOnGrid(grid, ships, shipTemplate, startX, startY, horizontal, constraints = {}) {
     if (isShipAlreadyPlaced(ships, shipTemplate.name)) {
         return null;
     }
-    if (!canPlaceShip(grid, startX, startY, shipTemplate.size, horizontal)) {
+    if (!canPlaceShip(grid, startX, startY, shipTemplate.size, horizontal, constraints)) {
         return null;
     }
 
@@ -88,30 +113,34 @@ function isPlacementComplete(ships) {
     return ships.length >= SHIPS.length;
 }
 
-function placeShipsRandomly(grid, ships) {
-    ships.length = 0;
-    for (let y = 0; y < GRID_SIZE; y++) {
-        for (let x = 0; x < GRID_SIZE; x++) {
-            grid[y][x] = null;
+function placeShipsRandomly(grid, ships, constraints = {}) {
+    // A constrained layout can paint itself into a corner, so start over
+    // until every ship fits.
+    do {
+        ships.length = 0;
+        for (let y = 0; y < GRID_SIZE; y++) {
+            for (let x = 0; x < GRID_SIZE; x++) {
+                grid[y][x] = null;
+            }
         }
-    }
 
-    for (const shipTemplate of SHIPS) {
-        let placed = false;
-        let attempts = 0;
-        while (!placed && attempts < 100) {
-            const horizontal = Math.random() < 0.5;
-            const maxX = horizontal ? GRID_SIZE - shipTemplate.size : GRID_SIZE - 1;
-            const maxY = horizontal ? GRID_SIZE - 1 : GRID_SIZE - shipTemplate.size;
-            const startX = Math.floor(Math.random() * (maxX + 1));
-            const startY = Math.floor(Math.random() * (maxY + 1));
-
-            if (placeShipOnGrid(grid, ships, shipTemplate, startX, startY, horizontal)) {
-                placed = true;
+        for (const shipTemplate of SHIPS) {
+            let placed = false;
+            let attempts = 0;
+            while (!placed && attempts < 100) {
+                const horizontal = Math.random() < 0.5;
+                const maxX = horizontal ? GRID_SIZE - shipTemplate.size : GRID_SIZE - 1;
+                const maxY = horizontal ? GRID_SIZE - 1 : GRID_SIZE - shipTemplate.size;
+                const startX = Math.floor(Math.random() * (maxX + 1));
+                const startY = Math.floor(Math.random() * (maxY + 1));
+
+                if (placeShipOnGrid(grid, ships, shipTemplate, startX, startY, horizontal, constraints)) {
+                    placed = true;
+                }
+                attempts++;
             }
-            attempts++;
         }
-    }
+    } while (!isPlacementComplete(ships));
 }
 
 function getOpponent(currentPlayer) {
@@ -339,10 +368,12 @@ if (typeof module !== 'undefined' && module.exports) {
         createEmptyGrid,
         createInitialGameState,
         getShipCells,
+        cellKey,
         canPlaceShip,
         placeShipOnGrid,
         isShipAlreadyPlaced,
         isPlacementComplete,
+        placeShipsRandomly,
         getOpponent,
         getPowerTargetCells,
         markShipAsSunk,
@@ -4,10 +4,12 @@ const {
     createEmptyGrid,
     createInitialGameState,
     getShipCells,
+    cellKey,
     canPlaceShip,
     placeShipOnGrid,
     isShipAlreadyPlaced,
     isPlacementComplete,
+    placeShipsRandomly,
     getOpponent,
     getPowerTargetCells,
     executeNormalAttack,
@@ -80,6 +82,59 @@ describe('canPlaceShip', () => {
         grid[0][2] = { shipId: 1, hit: false };
         expect(canPlaceShip(grid, 0, 0, 5, true)).toBe(false);
     });
+
+    test('refuse un bateau collé en mode sans contact', () => {
+        const grid = createEmptyGrid();
+        grid[1][5] = { shipId: 1, hit: false };
+        expect(canPlaceShip(grid, 0, 0, 5, true)).toBe(true);
+        expect(canPlaceShip(grid, 0, 0, 5, true, { noTouch: true })).toBe(false);
+    });
+
+    test('refuse le placement sur une case bloquée', () => {
+        const grid = createEmptyGrid();
+        const blocked = new Set([cellKey(3, 0)]);
+        expect(canPlaceShip(grid, 0, 0, 5, true, { blocked })).toBe(false);
+        expect(canPlaceShip(grid, 0, 1, 5, true, { blocked })).toBe(true);
+    });
+});
+
+describe('placeShipsRandomly', () => {
+    test('place tous les bateaux', () => {
+        const grid = createEmptyGrid();
+        const ships = [];
+        placeShipsRandomly(grid, ships);
+        expect(isPlacementComplete(ships)).toBe(true);
+    });
+
+    test('respecte la règle sans contact', () => {
+        const grid = createEmptyGrid();
+        const ships = [];
+        placeShipsRandomly(grid, ships, { noTouch: true });
+        expect(isPlacementComplete(ships)).toBe(true);
+
+        for (const ship of ships) {
+            for (const { x, y } of ship.cells) {
+                for (let dy = -1; dy <= 1; dy++) {
+                    for (let dx = -1; dx <= 1; dx++) {
+                        const cell = grid[y + dy] && grid[y + dy][x + dx];
+                        if (cell) expect(cell.shipId).toBe(ship.id);
+                    }
+                }
+            }
+        }
+    });
+
+    test('évite les cases bloquées', () => {
+        const grid = createEmptyGrid();
+        const ships = [];
+        const blocked = new Set([cellKey(0, 0), cellKey(5, 5), cellKey(9, 9)]);
+        placeShipsRandomly(grid, ships, { blocked });
+        expect(isPlacementComplete(ships)).toBe(true);
+        blocked.forEach(key => {
+            const [x, y] = key.split(',').map(Number);
+            expect(grid[y][x]).toBeNull();
+        });
+    });
 });
 
 describe('placeShipOnGrid', () => {
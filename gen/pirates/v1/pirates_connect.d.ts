@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AttackRequest, AttackResult, ChallengePlayerRequest, ChallengePlayerResponse, ConnectRequest, ConnectResponse, ForfeitRequest, ForfeitResponse, GameEvent, JoinQueueRequest, LeaveQueueRequest, LeaveQueueResponse, ListPlayersRequest, MatchResult, PlacementResult, PlaceShipsRequest, PlayerListUpdate, PowerResult, QueueStatusUpdate, RespondToMatchRequest, SubscribeEventsRequest, UsePowerRequest } from "./pirates_pb.js";
+import { AttackRequest, AttackResult, AttackSalvoRequest, BlockPlayerRequest, ChallengeFriendRequest, ChallengeFriendResponse, ChallengePlayerRequest, ChallengePlayerResponse, ClosePrivateLobbyRequest, ClosePrivateLobbyResponse, ConnectRequest, ConnectResponse, CreatePrivateLobbyRequest, CreateTournamentRequest, DailyPuzzle, ForfeitRequest, ForfeitResponse, FriendList, GameEvent, GetFriendsRequest, GetLeaderboardRequest, GetPlayerProfileRequest, GetPuzzleLeaderboardRequest, GetQueueStatusRequest, GetTournamentRequest, InviteToPartyRequest, JoinPrivateLobbyRequest, JoinPrivateLobbyResponse, JoinQueueRequest, Leaderboard, LeavePartyRequest, LeavePartyResponse, LeaveQueueRequest, LeaveQueueResponse, ListPlayersRequest, ListTournamentsRequest, ListTournamentsResponse, MatchResult, MutePlayerRequest, MutePlayerResponse, Party, PlacementResult, PlaceShipsRequest, PlayerListUpdate, PlayerProfile, PowerPreview, PowerResult, PreviewPowerRequest, PrivateLobby, PuzzleAttackRequest, PuzzleLeaderboard, PuzzleShotResult, PuzzleUsePowerRequest, QueueStatusUpdate, RegisterForTournamentRequest, RemoveFriendRequest, RespondToFriendRequestRequest, RespondToMatchRequest, RespondToPartyInviteRequest, SalvoResult, SendChatMessageRequest, SendChatMessageResponse, SendFriendRequestRequest, StartDailyPuzzleRequest, StartTournamentRequest, SubscribeEventsRequest, Tournament, TournamentBracket, UnblockPlayerRequest, UsePowerRequest, WithdrawFromTournamentRequest } from "./pirates_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -43,6 +43,15 @@ export declare const PiratesService: {
       readonly O: typeof LeaveQueueResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetQueueStatus
+     */
+    readonly getQueueStatus: {
+      readonly name: "GetQueueStatus",
+      readonly I: typeof GetQueueStatusRequest,
+      readonly O: typeof QueueStatusUpdate,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc pirates.v1.PiratesService.ListPlayers
      */
@@ -70,6 +79,243 @@ export declare const PiratesService: {
       readonly O: typeof MatchResult,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Private lobbies
+     *
+     * @generated from rpc pirates.v1.PiratesService.CreatePrivateLobby
+     */
+    readonly createPrivateLobby: {
+      readonly name: "CreatePrivateLobby",
+      readonly I: typeof CreatePrivateLobbyRequest,
+      readonly O: typeof PrivateLobby,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.JoinPrivateLobby
+     */
+    readonly joinPrivateLobby: {
+      readonly name: "JoinPrivateLobby",
+      readonly I: typeof JoinPrivateLobbyRequest,
+      readonly O: typeof JoinPrivateLobbyResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.ClosePrivateLobby
+     */
+    readonly closePrivateLobby: {
+      readonly name: "ClosePrivateLobby",
+      readonly I: typeof ClosePrivateLobbyRequest,
+      readonly O: typeof ClosePrivateLobbyResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Parties
+     *
+     * @generated from rpc pirates.v1.PiratesService.InviteToParty
+     */
+    readonly inviteToParty: {
+      readonly name: "InviteToParty",
+      readonly I: typeof InviteToPartyRequest,
+      readonly O: typeof Party,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RespondToPartyInvite
+     */
+    readonly respondToPartyInvite: {
+      readonly name: "RespondToPartyInvite",
+      readonly I: typeof RespondToPartyInviteRequest,
+      readonly O: typeof Party,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.LeaveParty
+     */
+    readonly leaveParty: {
+      readonly name: "LeaveParty",
+      readonly I: typeof LeavePartyRequest,
+      readonly O: typeof LeavePartyResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Tournaments
+     *
+     * @generated from rpc pirates.v1.PiratesService.CreateTournament
+     */
+    readonly createTournament: {
+      readonly name: "CreateTournament",
+      readonly I: typeof CreateTournamentRequest,
+      readonly O: typeof Tournament,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.ListTournaments
+     */
+    readonly listTournaments: {
+      readonly name: "ListTournaments",
+      readonly I: typeof ListTournamentsRequest,
+      readonly O: typeof ListTournamentsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RegisterForTournament
+     */
+    readonly registerForTournament: {
+      readonly name: "RegisterForTournament",
+      readonly I: typeof RegisterForTournamentRequest,
+      readonly O: typeof Tournament,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.WithdrawFromTournament
+     */
+    readonly withdrawFromTournament: {
+      readonly name: "WithdrawFromTournament",
+      readonly I: typeof WithdrawFromTournamentRequest,
+      readonly O: typeof Tournament,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.StartTournament
+     */
+    readonly startTournament: {
+      readonly name: "StartTournament",
+      readonly I: typeof StartTournamentRequest,
+      readonly O: typeof TournamentBracket,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetTournament
+     */
+    readonly getTournament: {
+      readonly name: "GetTournament",
+      readonly I: typeof GetTournamentRequest,
+      readonly O: typeof TournamentBracket,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Daily puzzle
+     *
+     * @generated from rpc pirates.v1.PiratesService.StartDailyPuzzle
+     */
+    readonly startDailyPuzzle: {
+      readonly name: "StartDailyPuzzle",
+      readonly I: typeof StartDailyPuzzleRequest,
+      readonly O: typeof DailyPuzzle,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.PuzzleAttack
+     */
+    readonly puzzleAttack: {
+      readonly name: "PuzzleAttack",
+      readonly I: typeof PuzzleAttackRequest,
+      readonly O: typeof PuzzleShotResult,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.PuzzleUsePower
+     */
+    readonly puzzleUsePower: {
+      readonly name: "PuzzleUsePower",
+      readonly I: typeof PuzzleUsePowerRequest,
+      readonly O: typeof PuzzleShotResult,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetPuzzleLeaderboard
+     */
+    readonly getPuzzleLeaderboard: {
+      readonly name: "GetPuzzleLeaderboard",
+      readonly I: typeof GetPuzzleLeaderboardRequest,
+      readonly O: typeof PuzzleLeaderboard,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Statistics
+     *
+     * @generated from rpc pirates.v1.PiratesService.GetPlayerProfile
+     */
+    readonly getPlayerProfile: {
+      readonly name: "GetPlayerProfile",
+      readonly I: typeof GetPlayerProfileRequest,
+      readonly O: typeof PlayerProfile,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetLeaderboard
+     */
+    readonly getLeaderboard: {
+      readonly name: "GetLeaderboard",
+      readonly I: typeof GetLeaderboardRequest,
+      readonly O: typeof Leaderboard,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Friends
+     *
+     * @generated from rpc pirates.v1.PiratesService.SendFriendRequest
+     */
+    readonly sendFriendRequest: {
+      readonly name: "SendFriendRequest",
+      readonly I: typeof SendFriendRequestRequest,
+      readonly O: typeof FriendList,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RespondToFriendRequest
+     */
+    readonly respondToFriendRequest: {
+      readonly name: "RespondToFriendRequest",
+      readonly I: typeof RespondToFriendRequestRequest,
+      readonly O: typeof FriendList,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RemoveFriend
+     */
+    readonly removeFriend: {
+      readonly name: "RemoveFriend",
+      readonly I: typeof RemoveFriendRequest,
+      readonly O: typeof FriendList,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.BlockPlayer
+     */
+    readonly blockPlayer: {
+      readonly name: "BlockPlayer",
+      readonly I: typeof BlockPlayerRequest,
+      readonly O: typeof FriendList,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.UnblockPlayer
+     */
+    readonly unblockPlayer: {
+      readonly name: "UnblockPlayer",
+      readonly I: typeof UnblockPlayerRequest,
+      readonly O: typeof FriendList,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetFriends
+     */
+    readonly getFriends: {
+      readonly name: "GetFriends",
+      readonly I: typeof GetFriendsRequest,
+      readonly O: typeof FriendList,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.ChallengeFriend
+     */
+    readonly challengeFriend: {
+      readonly name: "ChallengeFriend",
+      readonly I: typeof ChallengeFriendRequest,
+      readonly O: typeof ChallengeFriendResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Game actions
      *
@@ -90,6 +336,15 @@ export declare const PiratesService: {
       readonly O: typeof AttackResult,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.AttackSalvo
+     */
+    readonly attackSalvo: {
+      readonly name: "AttackSalvo",
+      readonly I: typeof AttackSalvoRequest,
+      readonly O: typeof SalvoResult,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc pirates.v1.PiratesService.UsePower
      */
@@ -99,6 +354,15 @@ export declare const PiratesService: {
       readonly O: typeof PowerResult,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.PreviewPower
+     */
+    readonly previewPower: {
+      readonly name: "PreviewPower",
+      readonly I: typeof PreviewPowerRequest,
+      readonly O: typeof PowerPreview,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc pirates.v1.PiratesService.Forfeit
      */
@@ -108,6 +372,26 @@ export declare const PiratesService: {
       readonly O: typeof ForfeitResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Chat
+     *
+     * @generated from rpc pirates.v1.PiratesService.SendChatMessage
+     */
+    readonly sendChatMessage: {
+      readonly name: "SendChatMessage",
+      readonly I: typeof SendChatMessageRequest,
+      readonly O: typeof SendChatMessageResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.MutePlayer
+     */
+    readonly mutePlayer: {
+      readonly name: "MutePlayer",
+      readonly I: typeof MutePlayerRequest,
+      readonly O: typeof MutePlayerResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Server-streaming RPC for real-time events
      *
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AttackRequest, AttackResult, ChallengePlayerRequest, ChallengePlayerResponse, ConnectRequest, ConnectResponse, ForfeitRequest, ForfeitResponse, GameEvent, JoinQueueRequest, LeaveQueueRequest, LeaveQueueResponse, ListPlayersRequest, MatchResult, PlacementResult, PlaceShipsRequest, PlayerListUpdate, PowerResult, QueueStatusUpdate, RespondToMatchRequest, SubscribeEventsRequest, UsePowerRequest } from "./pirates_pb.js";
+import { AttackRequest, AttackResult, AttackSalvoRequest, BlockPlayerRequest, ChallengeFriendRequest, ChallengeFriendResponse, ChallengePlayerRequest, ChallengePlayerResponse, ClosePrivateLobbyRequest, ClosePrivateLobbyResponse, ConnectRequest, ConnectResponse, CreatePrivateLobbyRequest, CreateTournamentRequest, DailyPuzzle, ForfeitRequest, ForfeitResponse, FriendList, GameEvent, GetFriendsRequest, GetLeaderboardRequest, GetPlayerProfileRequest, GetPuzzleLeaderboardRequest, GetQueueStatusRequest, GetTournamentRequest, InviteToPartyRequest, JoinPrivateLobbyRequest, JoinPrivateLobbyResponse, JoinQueueRequest, Leaderboard, LeavePartyRequest, LeavePartyResponse, LeaveQueueRequest, LeaveQueueResponse, ListPlayersRequest, ListTournamentsRequest, ListTournamentsResponse, MatchResult, MutePlayerRequest, MutePlayerResponse, Party, PlacementResult, PlaceShipsRequest, PlayerListUpdate, PlayerProfile, PowerPreview, PowerResult, PreviewPowerRequest, PrivateLobby, PuzzleAttackRequest, PuzzleLeaderboard, PuzzleShotResult, PuzzleUsePowerRequest, QueueStatusUpdate, RegisterForTournamentRequest, RemoveFriendRequest, RespondToFriendRequestRequest, RespondToMatchRequest, RespondToPartyInviteRequest, SalvoResult, SendChatMessageRequest, SendChatMessageResponse, SendFriendRequestRequest, StartDailyPuzzleRequest, StartTournamentRequest, SubscribeEventsRequest, Tournament, TournamentBracket, UnblockPlayerRequest, UsePowerRequest, WithdrawFromTournamentRequest } from "./pirates_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -43,6 +43,15 @@ export const PiratesService = {
       O: LeaveQueueResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetQueueStatus
+     */
+    getQueueStatus: {
+      name: "GetQueueStatus",
+      I: GetQueueStatusRequest,
+      O: QueueStatusUpdate,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc pirates.v1.PiratesService.ListPlayers
      */
@@ -70,6 +79,243 @@ export const PiratesService = {
       O: MatchResult,
       kind: MethodKind.Unary,
     },
+    /**
+     * Private lobbies
+     *
+     * @generated from rpc pirates.v1.PiratesService.CreatePrivateLobby
+     */
+    createPrivateLobby: {
+      name: "CreatePrivateLobby",
+      I: CreatePrivateLobbyRequest,
+      O: PrivateLobby,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.JoinPrivateLobby
+     */
+    joinPrivateLobby: {
+      name: "JoinPrivateLobby",
+      I: JoinPrivateLobbyRequest,
+      O: JoinPrivateLobbyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.ClosePrivateLobby
+     */
+    closePrivateLobby: {
+      name: "ClosePrivateLobby",
+      I: ClosePrivateLobbyRequest,
+      O: ClosePrivateLobbyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Parties
+     *
+     * @generated from rpc pirates.v1.PiratesService.InviteToParty
+     */
+    inviteToParty: {
+      name: "InviteToParty",
+      I: InviteToPartyRequest,
+      O: Party,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RespondToPartyInvite
+     */
+    respondToPartyInvite: {
+      name: "RespondToPartyInvite",
+      I: RespondToPartyInviteRequest,
+      O: Party,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.LeaveParty
+     */
+    leaveParty: {
+      name: "LeaveParty",
+      I: LeavePartyRequest,
+      O: LeavePartyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Tournaments
+     *
+     * @generated from rpc pirates.v1.PiratesService.CreateTournament
+     */
+    createTournament: {
+      name: "CreateTournament",
+      I: CreateTournamentRequest,
+      O: Tournament,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.ListTournaments
+     */
+    listTournaments: {
+      name: "ListTournaments",
+      I: ListTournamentsRequest,
+      O: ListTournamentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RegisterForTournament
+     */
+    registerForTournament: {
+      name: "RegisterForTournament",
+      I: RegisterForTournamentRequest,
+      O: Tournament,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.WithdrawFromTournament
+     */
+    withdrawFromTournament: {
+      name: "WithdrawFromTournament",
+      I: WithdrawFromTournamentRequest,
+      O: Tournament,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.StartTournament
+     */
+    startTournament: {
+      name: "StartTournament",
+      I: StartTournamentRequest,
+      O: TournamentBracket,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetTournament
+     */
+    getTournament: {
+      name: "GetTournament",
+      I: GetTournamentRequest,
+      O: TournamentBracket,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Daily puzzle
+     *
+     * @generated from rpc pirates.v1.PiratesService.StartDailyPuzzle
+     */
+    startDailyPuzzle: {
+      name: "StartDailyPuzzle",
+      I: StartDailyPuzzleRequest,
+      O: DailyPuzzle,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.PuzzleAttack
+     */
+    puzzleAttack: {
+      name: "PuzzleAttack",
+      I: PuzzleAttackRequest,
+      O: PuzzleShotResult,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.PuzzleUsePower
+     */
+    puzzleUsePower: {
+      name: "PuzzleUsePower",
+      I: PuzzleUsePowerRequest,
+      O: PuzzleShotResult,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetPuzzleLeaderboard
+     */
+    getPuzzleLeaderboard: {
+      name: "GetPuzzleLeaderboard",
+      I: GetPuzzleLeaderboardRequest,
+      O: PuzzleLeaderboard,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Statistics
+     *
+     * @generated from rpc pirates.v1.PiratesService.GetPlayerProfile
+     */
+    getPlayerProfile: {
+      name: "GetPlayerProfile",
+      I: GetPlayerProfileRequest,
+      O: PlayerProfile,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetLeaderboard
+     */
+    getLeaderboard: {
+      name: "GetLeaderboard",
+      I: GetLeaderboardRequest,
+      O: Leaderboard,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Friends
+     *
+     * @generated from rpc pirates.v1.PiratesService.SendFriendRequest
+     */
+    sendFriendRequest: {
+      name: "SendFriendRequest",
+      I: SendFriendRequestRequest,
+      O: FriendList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RespondToFriendRequest
+     */
+    respondToFriendRequest: {
+      name: "RespondToFriendRequest",
+      I: RespondToFriendRequestRequest,
+      O: FriendList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.RemoveFriend
+     */
+    removeFriend: {
+      name: "RemoveFriend",
+      I: RemoveFriendRequest,
+      O: FriendList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.BlockPlayer
+     */
+    blockPlayer: {
+      name: "BlockPlayer",
+      I: BlockPlayerRequest,
+      O: FriendList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.UnblockPlayer
+     */
+    unblockPlayer: {
+      name: "UnblockPlayer",
+      I: UnblockPlayerRequest,
+      O: FriendList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.GetFriends
+     */
+    getFriends: {
+      name: "GetFriends",
+      I: GetFriendsRequest,
+      O: FriendList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.ChallengeFriend
+     */
+    challengeFriend: {
+      name: "ChallengeFriend",
+      I: ChallengeFriendRequest,
+      O: ChallengeFriendResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Game actions
      *
@@ -90,6 +336,15 @@ export const PiratesService = {
       O: AttackResult,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.AttackSalvo
+     */
+    attackSalvo: {
+      name: "AttackSalvo",
+      I: AttackSalvoRequest,
+      O: SalvoResult,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc pirates.v1.PiratesService.UsePower
      */
@@ -99,6 +354,15 @@ export const PiratesService = {
       O: PowerResult,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.PreviewPower
+     */
+    previewPower: {
+      name: "PreviewPower",
+      I: PreviewPowerRequest,
+      O: PowerPreview,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc pirates.v1.PiratesService.Forfeit
      */
@@ -108,6 +372,26 @@ export const PiratesService = {
       O: ForfeitResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Chat
+     *
+     * @generated from rpc pirates.v1.PiratesService.SendChatMessage
+     */
+    sendChatMessage: {
+      name: "SendChatMessage",
+      I: SendChatMessageRequest,
+      O: SendChatMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pirates.v1.PiratesService.MutePlayer
+     */
+    mutePlayer: {
+      name: "MutePlayer",
+      I: MutePlayerRequest,
+      O: MutePlayerResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Server-streaming RPC for real-time events
      *
@@ -34,6 +34,75 @@ export declare enum PowerType {
    * @generated from enum value: POWER_TYPE_KRAKEN = 4;
    */
   KRAKEN = 4,
+
+  /**
+   * @generated from enum value: POWER_TYPE_SHIELD = 5;
+   */
+  SHIELD = 5,
+
+  /**
+   * @generated from enum value: POWER_TYPE_REPAIR = 6;
+   */
+  REPAIR = 6,
+
+  /**
+   * @generated from enum value: POWER_TYPE_DECOY = 7;
+   */
+  DECOY = 7,
+}
+
+/**
+ * Orientation turns a power's pattern, counterclockwise from east in 45°
+ * steps. Patterns are defined facing east; diagonal orientations stretch
+ * them so that lines stay lines of touching cells.
+ *
+ * @generated from enum pirates.v1.Orientation
+ */
+export declare enum Orientation {
+  /**
+   * @generated from enum value: ORIENTATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORIENTATION_EAST = 1;
+   */
+  EAST = 1,
+
+  /**
+   * @generated from enum value: ORIENTATION_NORTH_EAST = 2;
+   */
+  NORTH_EAST = 2,
+
+  /**
+   * @generated from enum value: ORIENTATION_NORTH = 3;
+   */
+  NORTH = 3,
+
+  /**
+   * @generated from enum value: ORIENTATION_NORTH_WEST = 4;
+   */
+  NORTH_WEST = 4,
+
+  /**
+   * @generated from enum value: ORIENTATION_WEST = 5;
+   */
+  WEST = 5,
+
+  /**
+   * @generated from enum value: ORIENTATION_SOUTH_WEST = 6;
+   */
+  SOUTH_WEST = 6,
+
+  /**
+   * @generated from enum value: ORIENTATION_SOUTH = 7;
+   */
+  SOUTH = 7,
+
+  /**
+   * @generated from enum value: ORIENTATION_SOUTH_EAST = 8;
+   */
+  SOUTH_EAST = 8,
 }
 
 /**
@@ -69,6 +138,31 @@ export declare enum CellState {
    * @generated from enum value: CELL_STATE_REVEALED = 5;
    */
   REVEALED = 5,
+
+  /**
+   * @generated from enum value: CELL_STATE_ISLAND = 6;
+   */
+  ISLAND = 6,
+
+  /**
+   * @generated from enum value: CELL_STATE_MINE = 7;
+   */
+  MINE = 7,
+
+  /**
+   * @generated from enum value: CELL_STATE_SHIELDED = 8;
+   */
+  SHIELDED = 8,
+
+  /**
+   * @generated from enum value: CELL_STATE_REPAIRED = 9;
+   */
+  REPAIRED = 9,
+
+  /**
+   * @generated from enum value: CELL_STATE_DECOY = 10;
+   */
+  DECOY = 10,
 }
 
 /**
@@ -94,6 +188,232 @@ export declare enum PlayerStatus {
    * @generated from enum value: PLAYER_STATUS_IN_GAME = 3;
    */
   IN_GAME = 3,
+
+  /**
+   * @generated from enum value: PLAYER_STATUS_IN_PRIVATE_LOBBY = 4;
+   */
+  IN_PRIVATE_LOBBY = 4,
+}
+
+/**
+ * RuleSet selects the base variant a game is played with.
+ *
+ * @generated from enum pirates.v1.RuleSet
+ */
+export declare enum RuleSet {
+  /**
+   * @generated from enum value: RULE_SET_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RULE_SET_CLASSIC = 1;
+   */
+  CLASSIC = 1,
+
+  /**
+   * @generated from enum value: RULE_SET_SALVO = 2;
+   */
+  SALVO = 2,
+}
+
+/**
+ * BonusTurn is the house rule granting the attacker another shot.
+ *
+ * @generated from enum pirates.v1.BonusTurn
+ */
+export declare enum BonusTurn {
+  /**
+   * @generated from enum value: BONUS_TURN_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BONUS_TURN_ON_HIT = 1;
+   */
+  ON_HIT = 1,
+
+  /**
+   * @generated from enum value: BONUS_TURN_ON_SINK = 2;
+   */
+  ON_SINK = 2,
+}
+
+/**
+ * @generated from enum pirates.v1.GameOverReason
+ */
+export declare enum GameOverReason {
+  /**
+   * @generated from enum value: GAME_OVER_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GAME_OVER_REASON_ALL_SHIPS_SUNK = 1;
+   */
+  ALL_SHIPS_SUNK = 1,
+
+  /**
+   * @generated from enum value: GAME_OVER_REASON_FORFEIT = 2;
+   */
+  FORFEIT = 2,
+
+  /**
+   * @generated from enum value: GAME_OVER_REASON_TURN_TIMEOUT = 3;
+   */
+  TURN_TIMEOUT = 3,
+
+  /**
+   * @generated from enum value: GAME_OVER_REASON_DISCONNECT = 4;
+   */
+  DISCONNECT = 4,
+
+  /**
+   * @generated from enum value: GAME_OVER_REASON_PLACEMENT_TIMEOUT = 5;
+   */
+  PLACEMENT_TIMEOUT = 5,
+}
+
+/**
+ * @generated from enum pirates.v1.ChatScope
+ */
+export declare enum ChatScope {
+  /**
+   * @generated from enum value: CHAT_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHAT_SCOPE_GAME = 1;
+   */
+  GAME = 1,
+
+  /**
+   * @generated from enum value: CHAT_SCOPE_LOBBY = 2;
+   */
+  LOBBY = 2,
+}
+
+/**
+ * Preset quick emotes, rendered by the client.
+ *
+ * @generated from enum pirates.v1.QuickEmote
+ */
+export declare enum QuickEmote {
+  /**
+   * @generated from enum value: QUICK_EMOTE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: QUICK_EMOTE_AHOY = 1;
+   */
+  AHOY = 1,
+
+  /**
+   * @generated from enum value: QUICK_EMOTE_ARRR = 2;
+   */
+  ARRR = 2,
+
+  /**
+   * @generated from enum value: QUICK_EMOTE_NICE_SHOT = 3;
+   */
+  NICE_SHOT = 3,
+
+  /**
+   * @generated from enum value: QUICK_EMOTE_BLOW_ME_DOWN = 4;
+   */
+  BLOW_ME_DOWN = 4,
+
+  /**
+   * @generated from enum value: QUICK_EMOTE_WALK_THE_PLANK = 5;
+   */
+  WALK_THE_PLANK = 5,
+
+  /**
+   * @generated from enum value: QUICK_EMOTE_GOOD_GAME = 6;
+   */
+  GOOD_GAME = 6,
+}
+
+/**
+ * @generated from enum pirates.v1.TournamentFormat
+ */
+export declare enum TournamentFormat {
+  /**
+   * @generated from enum value: TOURNAMENT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TOURNAMENT_FORMAT_SINGLE_ELIMINATION = 1;
+   */
+  SINGLE_ELIMINATION = 1,
+
+  /**
+   * @generated from enum value: TOURNAMENT_FORMAT_DOUBLE_ELIMINATION = 2;
+   */
+  DOUBLE_ELIMINATION = 2,
+
+  /**
+   * @generated from enum value: TOURNAMENT_FORMAT_ROUND_ROBIN = 3;
+   */
+  ROUND_ROBIN = 3,
+
+  /**
+   * @generated from enum value: TOURNAMENT_FORMAT_SWISS = 4;
+   */
+  SWISS = 4,
+}
+
+/**
+ * @generated from enum pirates.v1.TournamentStatus
+ */
+export declare enum TournamentStatus {
+  /**
+   * @generated from enum value: TOURNAMENT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TOURNAMENT_STATUS_REGISTRATION = 1;
+   */
+  REGISTRATION = 1,
+
+  /**
+   * @generated from enum value: TOURNAMENT_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: TOURNAMENT_STATUS_FINISHED = 3;
+   */
+  FINISHED = 3,
+}
+
+/**
+ * @generated from enum pirates.v1.LeaderboardScope
+ */
+export declare enum LeaderboardScope {
+  /**
+   * @generated from enum value: LEADERBOARD_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LEADERBOARD_SCOPE_GLOBAL = 1;
+   */
+  GLOBAL = 1,
+
+  /**
+   * @generated from enum value: LEADERBOARD_SCOPE_WEEKLY = 2;
+   */
+  WEEKLY = 2,
+
+  /**
+   * @generated from enum value: LEADERBOARD_SCOPE_RULE_SET = 3;
+   */
+  RULE_SET = 3,
 }
 
 /**
@@ -233,80 +553,219 @@ export declare class Player extends Message<Player> {
 }
 
 /**
- * @generated from message pirates.v1.ConnectRequest
+ * GameRules are the options a game is played with. The zero value is the
+ * classic game with powers.
+ *
+ * @generated from message pirates.v1.GameRules
  */
-export declare class ConnectRequest extends Message<ConnectRequest> {
+export declare class GameRules extends Message<GameRules> {
   /**
-   * @generated from field: string display_name = 1;
+   * @generated from field: pirates.v1.RuleSet rule_set = 1;
    */
-  displayName: string;
+  ruleSet: RuleSet;
 
-  constructor(data?: PartialMessage<ConnectRequest>);
+  /**
+   * @generated from field: bool powers_disabled = 2;
+   */
+  powersDisabled: boolean;
 
-  static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.ConnectRequest";
-  static readonly fields: FieldList;
+  /**
+   * @generated from field: int32 salvo_shots = 3;
+   */
+  salvoShots: number;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectRequest;
+  /**
+   * @generated from field: pirates.v1.BonusTurn bonus_turn = 4;
+   */
+  bonusTurn: BonusTurn;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectRequest;
+  /**
+   * @generated from field: int32 max_bonus_turns = 5;
+   */
+  maxBonusTurns: number;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectRequest;
+  /**
+   * @generated from field: bool no_touch = 6;
+   */
+  noTouch: boolean;
 
-  static equals(a: ConnectRequest | PlainMessage<ConnectRequest> | undefined, b: ConnectRequest | PlainMessage<ConnectRequest> | undefined): boolean;
-}
+  /**
+   * @generated from field: bool terrain = 7;
+   */
+  terrain: boolean;
 
-/**
- * @generated from message pirates.v1.ConnectResponse
- */
-export declare class ConnectResponse extends Message<ConnectResponse> {
   /**
-   * @generated from field: pirates.v1.Player player = 1;
+   * @generated from field: bool defensive_powers = 8;
    */
-  player?: Player;
+  defensivePowers: boolean;
 
   /**
-   * @generated from field: string session_token = 2;
+   * @generated from field: bool teams = 9;
    */
-  sessionToken: string;
+  teams: boolean;
 
-  constructor(data?: PartialMessage<ConnectResponse>);
+  /**
+   * @generated from field: int32 turn_seconds = 10;
+   */
+  turnSeconds: number;
+
+  /**
+   * @generated from field: int32 series_length = 11;
+   */
+  seriesLength: number;
+
+  /**
+   * @generated from field: bool unranked = 12;
+   */
+  unranked: boolean;
+
+  constructor(data?: PartialMessage<GameRules>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.ConnectResponse";
+  static readonly typeName = "pirates.v1.GameRules";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameRules;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameRules;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameRules;
 
-  static equals(a: ConnectResponse | PlainMessage<ConnectResponse> | undefined, b: ConnectResponse | PlainMessage<ConnectResponse> | undefined): boolean;
+  static equals(a: GameRules | PlainMessage<GameRules> | undefined, b: GameRules | PlainMessage<GameRules> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.JoinQueueRequest
+ * Terrain is a terrain game's layout as seen by one player. Islands are the
+ * same on both boards; mines stay hidden from the opponent.
+ *
+ * @generated from message pirates.v1.Terrain
  */
-export declare class JoinQueueRequest extends Message<JoinQueueRequest> {
+export declare class Terrain extends Message<Terrain> {
   /**
-   * @generated from field: string session_token = 1;
+   * @generated from field: repeated pirates.v1.Coordinate islands = 1;
    */
-  sessionToken: string;
+  islands: Coordinate[];
 
-  constructor(data?: PartialMessage<JoinQueueRequest>);
+  /**
+   * @generated from field: repeated pirates.v1.Coordinate your_mines = 2;
+   */
+  yourMines: Coordinate[];
+
+  constructor(data?: PartialMessage<Terrain>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.JoinQueueRequest";
+  static readonly typeName = "pirates.v1.Terrain";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinQueueRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Terrain;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinQueueRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Terrain;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinQueueRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Terrain;
 
-  static equals(a: JoinQueueRequest | PlainMessage<JoinQueueRequest> | undefined, b: JoinQueueRequest | PlainMessage<JoinQueueRequest> | undefined): boolean;
+  static equals(a: Terrain | PlainMessage<Terrain> | undefined, b: Terrain | PlainMessage<Terrain> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ConnectRequest
+ */
+export declare class ConnectRequest extends Message<ConnectRequest> {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName: string;
+
+  /**
+   * The session token of an earlier connection, to come back as the same
+   * player: with their ID, friends, block list and records.
+   *
+   * @generated from field: string resume_token = 2;
+   */
+  resumeToken: string;
+
+  constructor(data?: PartialMessage<ConnectRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ConnectRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectRequest;
+
+  static equals(a: ConnectRequest | PlainMessage<ConnectRequest> | undefined, b: ConnectRequest | PlainMessage<ConnectRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ConnectResponse
+ */
+export declare class ConnectResponse extends Message<ConnectResponse> {
+  /**
+   * @generated from field: pirates.v1.Player player = 1;
+   */
+  player?: Player;
+
+  /**
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken: string;
+
+  constructor(data?: PartialMessage<ConnectResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ConnectResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectResponse;
+
+  static equals(a: ConnectResponse | PlainMessage<ConnectResponse> | undefined, b: ConnectResponse | PlainMessage<ConnectResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.JoinQueueRequest
+ */
+export declare class JoinQueueRequest extends Message<JoinQueueRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * Queue for 2v2 games. A party of two plays as one team; players queued
+   * alone are teamed up in pairs. Only a party's leader queues it.
+   * Shorthand for the "teams" queue when queue is unset.
+   *
+   * @generated from field: bool teams = 2;
+   */
+  teams: boolean;
+
+  /**
+   * The queue to join, from QueueStatusUpdate.queues. Unset joins the
+   * "ranked" queue. Joining a queue leaves the one you waited in.
+   *
+   * @generated from field: string queue = 3;
+   */
+  queue: string;
+
+  constructor(data?: PartialMessage<JoinQueueRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.JoinQueueRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinQueueRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinQueueRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinQueueRequest;
+
+  static equals(a: JoinQueueRequest | PlainMessage<JoinQueueRequest> | undefined, b: JoinQueueRequest | PlainMessage<JoinQueueRequest> | undefined): boolean;
 }
 
 /**
@@ -352,6 +811,30 @@ export declare class LeaveQueueResponse extends Message<LeaveQueueResponse> {
   static equals(a: LeaveQueueResponse | PlainMessage<LeaveQueueResponse> | undefined, b: LeaveQueueResponse | PlainMessage<LeaveQueueResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message pirates.v1.GetQueueStatusRequest
+ */
+export declare class GetQueueStatusRequest extends Message<GetQueueStatusRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  constructor(data?: PartialMessage<GetQueueStatusRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GetQueueStatusRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQueueStatusRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQueueStatusRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQueueStatusRequest;
+
+  static equals(a: GetQueueStatusRequest | PlainMessage<GetQueueStatusRequest> | undefined, b: GetQueueStatusRequest | PlainMessage<GetQueueStatusRequest> | undefined): boolean;
+}
+
 /**
  * @generated from message pirates.v1.ListPlayersRequest
  */
@@ -390,6 +873,30 @@ export declare class ChallengePlayerRequest extends Message<ChallengePlayerReque
    */
   targetPlayerId: string;
 
+  /**
+   * Free-for-all: further players to invite, up to four players in all.
+   * Every invited player must accept.
+   *
+   * @generated from field: repeated string additional_player_ids = 3;
+   */
+  additionalPlayerIds: string[];
+
+  /**
+   * 2v2: your teammate. The target and a single additional player form the
+   * other team.
+   *
+   * @generated from field: string ally_player_id = 4;
+   */
+  allyPlayerId: string;
+
+  /**
+   * The options of the game, shown to the invited players. Teams are set
+   * by ally_player_id.
+   *
+   * @generated from field: pirates.v1.GameRules rules = 5;
+   */
+  rules?: GameRules;
+
   constructor(data?: PartialMessage<ChallengePlayerRequest>);
 
   static readonly runtime: typeof proto3;
@@ -464,585 +971,2502 @@ export declare class RespondToMatchRequest extends Message<RespondToMatchRequest
 }
 
 /**
- * @generated from message pirates.v1.ForfeitRequest
+ * @generated from message pirates.v1.CreatePrivateLobbyRequest
  */
-export declare class ForfeitRequest extends Message<ForfeitRequest> {
+export declare class CreatePrivateLobbyRequest extends Message<CreatePrivateLobbyRequest> {
   /**
    * @generated from field: string session_token = 1;
    */
   sessionToken: string;
 
-  constructor(data?: PartialMessage<ForfeitRequest>);
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 2;
+   */
+  rules?: GameRules;
+
+  constructor(data?: PartialMessage<CreatePrivateLobbyRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.ForfeitRequest";
+  static readonly typeName = "pirates.v1.CreatePrivateLobbyRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForfeitRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePrivateLobbyRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForfeitRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePrivateLobbyRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForfeitRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePrivateLobbyRequest;
 
-  static equals(a: ForfeitRequest | PlainMessage<ForfeitRequest> | undefined, b: ForfeitRequest | PlainMessage<ForfeitRequest> | undefined): boolean;
+  static equals(a: CreatePrivateLobbyRequest | PlainMessage<CreatePrivateLobbyRequest> | undefined, b: CreatePrivateLobbyRequest | PlainMessage<CreatePrivateLobbyRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.ForfeitResponse
+ * @generated from message pirates.v1.PrivateLobby
  */
-export declare class ForfeitResponse extends Message<ForfeitResponse> {
-  constructor(data?: PartialMessage<ForfeitResponse>);
+export declare class PrivateLobby extends Message<PrivateLobby> {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 2;
+   */
+  rules?: GameRules;
+
+  constructor(data?: PartialMessage<PrivateLobby>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.ForfeitResponse";
+  static readonly typeName = "pirates.v1.PrivateLobby";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForfeitResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrivateLobby;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForfeitResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrivateLobby;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForfeitResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrivateLobby;
 
-  static equals(a: ForfeitResponse | PlainMessage<ForfeitResponse> | undefined, b: ForfeitResponse | PlainMessage<ForfeitResponse> | undefined): boolean;
+  static equals(a: PrivateLobby | PlainMessage<PrivateLobby> | undefined, b: PrivateLobby | PlainMessage<PrivateLobby> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.PlaceShipsRequest
+ * @generated from message pirates.v1.JoinPrivateLobbyRequest
  */
-export declare class PlaceShipsRequest extends Message<PlaceShipsRequest> {
+export declare class JoinPrivateLobbyRequest extends Message<JoinPrivateLobbyRequest> {
   /**
    * @generated from field: string session_token = 1;
    */
   sessionToken: string;
 
   /**
-   * @generated from field: repeated pirates.v1.Ship ships = 2;
+   * @generated from field: string code = 2;
    */
-  ships: Ship[];
+  code: string;
 
-  constructor(data?: PartialMessage<PlaceShipsRequest>);
+  constructor(data?: PartialMessage<JoinPrivateLobbyRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.PlaceShipsRequest";
+  static readonly typeName = "pirates.v1.JoinPrivateLobbyRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlaceShipsRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinPrivateLobbyRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlaceShipsRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinPrivateLobbyRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlaceShipsRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinPrivateLobbyRequest;
 
-  static equals(a: PlaceShipsRequest | PlainMessage<PlaceShipsRequest> | undefined, b: PlaceShipsRequest | PlainMessage<PlaceShipsRequest> | undefined): boolean;
+  static equals(a: JoinPrivateLobbyRequest | PlainMessage<JoinPrivateLobbyRequest> | undefined, b: JoinPrivateLobbyRequest | PlainMessage<JoinPrivateLobbyRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.AttackRequest
+ * @generated from message pirates.v1.JoinPrivateLobbyResponse
  */
-export declare class AttackRequest extends Message<AttackRequest> {
-  /**
-   * @generated from field: string session_token = 1;
-   */
-  sessionToken: string;
-
+export declare class JoinPrivateLobbyResponse extends Message<JoinPrivateLobbyResponse> {
   /**
-   * @generated from field: pirates.v1.Coordinate target = 2;
+   * @generated from field: string match_id = 1;
    */
-  target?: Coordinate;
+  matchId: string;
 
-  constructor(data?: PartialMessage<AttackRequest>);
+  constructor(data?: PartialMessage<JoinPrivateLobbyResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.AttackRequest";
+  static readonly typeName = "pirates.v1.JoinPrivateLobbyResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttackRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinPrivateLobbyResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttackRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinPrivateLobbyResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttackRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinPrivateLobbyResponse;
 
-  static equals(a: AttackRequest | PlainMessage<AttackRequest> | undefined, b: AttackRequest | PlainMessage<AttackRequest> | undefined): boolean;
+  static equals(a: JoinPrivateLobbyResponse | PlainMessage<JoinPrivateLobbyResponse> | undefined, b: JoinPrivateLobbyResponse | PlainMessage<JoinPrivateLobbyResponse> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.UsePowerRequest
+ * @generated from message pirates.v1.ClosePrivateLobbyRequest
  */
-export declare class UsePowerRequest extends Message<UsePowerRequest> {
+export declare class ClosePrivateLobbyRequest extends Message<ClosePrivateLobbyRequest> {
   /**
    * @generated from field: string session_token = 1;
    */
   sessionToken: string;
 
-  /**
-   * @generated from field: pirates.v1.PowerType power = 2;
-   */
-  power: PowerType;
+  constructor(data?: PartialMessage<ClosePrivateLobbyRequest>);
 
-  /**
-   * @generated from field: pirates.v1.Coordinate target = 3;
-   */
-  target?: Coordinate;
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ClosePrivateLobbyRequest";
+  static readonly fields: FieldList;
 
-  /**
-   * @generated from field: bool horizontal = 4;
-   */
-  horizontal: boolean;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClosePrivateLobbyRequest;
 
-  constructor(data?: PartialMessage<UsePowerRequest>);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClosePrivateLobbyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClosePrivateLobbyRequest;
+
+  static equals(a: ClosePrivateLobbyRequest | PlainMessage<ClosePrivateLobbyRequest> | undefined, b: ClosePrivateLobbyRequest | PlainMessage<ClosePrivateLobbyRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ClosePrivateLobbyResponse
+ */
+export declare class ClosePrivateLobbyResponse extends Message<ClosePrivateLobbyResponse> {
+  constructor(data?: PartialMessage<ClosePrivateLobbyResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.UsePowerRequest";
+  static readonly typeName = "pirates.v1.ClosePrivateLobbyResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UsePowerRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClosePrivateLobbyResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UsePowerRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClosePrivateLobbyResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UsePowerRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClosePrivateLobbyResponse;
 
-  static equals(a: UsePowerRequest | PlainMessage<UsePowerRequest> | undefined, b: UsePowerRequest | PlainMessage<UsePowerRequest> | undefined): boolean;
+  static equals(a: ClosePrivateLobbyResponse | PlainMessage<ClosePrivateLobbyResponse> | undefined, b: ClosePrivateLobbyResponse | PlainMessage<ClosePrivateLobbyResponse> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.SubscribeEventsRequest
+ * Creates a party led by the inviter if they are not in one yet. Only the
+ * party's leader invites.
+ *
+ * @generated from message pirates.v1.InviteToPartyRequest
  */
-export declare class SubscribeEventsRequest extends Message<SubscribeEventsRequest> {
+export declare class InviteToPartyRequest extends Message<InviteToPartyRequest> {
   /**
    * @generated from field: string session_token = 1;
    */
   sessionToken: string;
 
-  constructor(data?: PartialMessage<SubscribeEventsRequest>);
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  constructor(data?: PartialMessage<InviteToPartyRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.SubscribeEventsRequest";
+  static readonly typeName = "pirates.v1.InviteToPartyRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscribeEventsRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteToPartyRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscribeEventsRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InviteToPartyRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscribeEventsRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InviteToPartyRequest;
 
-  static equals(a: SubscribeEventsRequest | PlainMessage<SubscribeEventsRequest> | undefined, b: SubscribeEventsRequest | PlainMessage<SubscribeEventsRequest> | undefined): boolean;
+  static equals(a: InviteToPartyRequest | PlainMessage<InviteToPartyRequest> | undefined, b: InviteToPartyRequest | PlainMessage<InviteToPartyRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.QueueStatusUpdate
+ * @generated from message pirates.v1.RespondToPartyInviteRequest
  */
-export declare class QueueStatusUpdate extends Message<QueueStatusUpdate> {
+export declare class RespondToPartyInviteRequest extends Message<RespondToPartyInviteRequest> {
   /**
-   * @generated from field: bool in_queue = 1;
+   * @generated from field: string session_token = 1;
    */
-  inQueue: boolean;
+  sessionToken: string;
 
   /**
-   * @generated from field: int32 queue_position = 2;
+   * @generated from field: string party_id = 2;
    */
-  queuePosition: number;
+  partyId: string;
 
   /**
-   * @generated from field: int32 players_in_queue = 3;
+   * @generated from field: bool accepted = 3;
    */
-  playersInQueue: number;
+  accepted: boolean;
 
-  constructor(data?: PartialMessage<QueueStatusUpdate>);
+  constructor(data?: PartialMessage<RespondToPartyInviteRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.QueueStatusUpdate";
+  static readonly typeName = "pirates.v1.RespondToPartyInviteRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueueStatusUpdate;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RespondToPartyInviteRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueueStatusUpdate;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RespondToPartyInviteRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueueStatusUpdate;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RespondToPartyInviteRequest;
 
-  static equals(a: QueueStatusUpdate | PlainMessage<QueueStatusUpdate> | undefined, b: QueueStatusUpdate | PlainMessage<QueueStatusUpdate> | undefined): boolean;
+  static equals(a: RespondToPartyInviteRequest | PlainMessage<RespondToPartyInviteRequest> | undefined, b: RespondToPartyInviteRequest | PlainMessage<RespondToPartyInviteRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.PlayerListUpdate
+ * @generated from message pirates.v1.LeavePartyRequest
  */
-export declare class PlayerListUpdate extends Message<PlayerListUpdate> {
+export declare class LeavePartyRequest extends Message<LeavePartyRequest> {
   /**
-   * @generated from field: repeated pirates.v1.Player available_players = 1;
+   * @generated from field: string session_token = 1;
    */
-  availablePlayers: Player[];
+  sessionToken: string;
 
-  constructor(data?: PartialMessage<PlayerListUpdate>);
+  constructor(data?: PartialMessage<LeavePartyRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.PlayerListUpdate";
+  static readonly typeName = "pirates.v1.LeavePartyRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerListUpdate;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeavePartyRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerListUpdate;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeavePartyRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerListUpdate;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeavePartyRequest;
 
-  static equals(a: PlayerListUpdate | PlainMessage<PlayerListUpdate> | undefined, b: PlayerListUpdate | PlainMessage<PlayerListUpdate> | undefined): boolean;
+  static equals(a: LeavePartyRequest | PlainMessage<LeavePartyRequest> | undefined, b: LeavePartyRequest | PlainMessage<LeavePartyRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.MatchProposal
+ * @generated from message pirates.v1.LeavePartyResponse
  */
-export declare class MatchProposal extends Message<MatchProposal> {
+export declare class LeavePartyResponse extends Message<LeavePartyResponse> {
+  constructor(data?: PartialMessage<LeavePartyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.LeavePartyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeavePartyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeavePartyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeavePartyResponse;
+
+  static equals(a: LeavePartyResponse | PlainMessage<LeavePartyResponse> | undefined, b: LeavePartyResponse | PlainMessage<LeavePartyResponse> | undefined): boolean;
+}
+
+/**
+ * The organizer does not play unless they register.
+ *
+ * @generated from message pirates.v1.CreateTournamentRequest
+ */
+export declare class CreateTournamentRequest extends Message<CreateTournamentRequest> {
   /**
-   * @generated from field: string match_id = 1;
+   * @generated from field: string session_token = 1;
    */
-  matchId: string;
+  sessionToken: string;
 
   /**
-   * @generated from field: pirates.v1.Player opponent = 2;
+   * @generated from field: string name = 2;
    */
-  opponent?: Player;
+  name: string;
 
   /**
-   * @generated from field: bool you_initiated = 3;
+   * @generated from field: pirates.v1.TournamentFormat format = 3;
    */
-  youInitiated: boolean;
+  format: TournamentFormat;
 
   /**
-   * @generated from field: int32 timeout_seconds = 4;
+   * @generated from field: pirates.v1.GameRules rules = 4;
    */
-  timeoutSeconds: number;
+  rules?: GameRules;
 
-  constructor(data?: PartialMessage<MatchProposal>);
+  constructor(data?: PartialMessage<CreateTournamentRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.MatchProposal";
+  static readonly typeName = "pirates.v1.CreateTournamentRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MatchProposal;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTournamentRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MatchProposal;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTournamentRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MatchProposal;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTournamentRequest;
 
-  static equals(a: MatchProposal | PlainMessage<MatchProposal> | undefined, b: MatchProposal | PlainMessage<MatchProposal> | undefined): boolean;
+  static equals(a: CreateTournamentRequest | PlainMessage<CreateTournamentRequest> | undefined, b: CreateTournamentRequest | PlainMessage<CreateTournamentRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.MatchResult
+ * @generated from message pirates.v1.ListTournamentsRequest
  */
-export declare class MatchResult extends Message<MatchResult> {
+export declare class ListTournamentsRequest extends Message<ListTournamentsRequest> {
   /**
-   * @generated from field: string match_id = 1;
+   * @generated from field: string session_token = 1;
    */
-  matchId: string;
+  sessionToken: string;
+
+  constructor(data?: PartialMessage<ListTournamentsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ListTournamentsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTournamentsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTournamentsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTournamentsRequest;
 
+  static equals(a: ListTournamentsRequest | PlainMessage<ListTournamentsRequest> | undefined, b: ListTournamentsRequest | PlainMessage<ListTournamentsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ListTournamentsResponse
+ */
+export declare class ListTournamentsResponse extends Message<ListTournamentsResponse> {
   /**
-   * @generated from field: bool accepted = 2;
+   * @generated from field: repeated pirates.v1.Tournament tournaments = 1;
    */
-  accepted: boolean;
+  tournaments: Tournament[];
+
+  constructor(data?: PartialMessage<ListTournamentsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ListTournamentsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTournamentsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTournamentsResponse;
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTournamentsResponse;
+
+  static equals(a: ListTournamentsResponse | PlainMessage<ListTournamentsResponse> | undefined, b: ListTournamentsResponse | PlainMessage<ListTournamentsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.RegisterForTournamentRequest
+ */
+export declare class RegisterForTournamentRequest extends Message<RegisterForTournamentRequest> {
   /**
-   * @generated from field: string rejection_reason = 3;
+   * @generated from field: string session_token = 1;
    */
-  rejectionReason: string;
+  sessionToken: string;
 
-  constructor(data?: PartialMessage<MatchResult>);
+  /**
+   * @generated from field: string tournament_id = 2;
+   */
+  tournamentId: string;
+
+  constructor(data?: PartialMessage<RegisterForTournamentRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.MatchResult";
+  static readonly typeName = "pirates.v1.RegisterForTournamentRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MatchResult;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterForTournamentRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MatchResult;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterForTournamentRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MatchResult;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterForTournamentRequest;
 
-  static equals(a: MatchResult | PlainMessage<MatchResult> | undefined, b: MatchResult | PlainMessage<MatchResult> | undefined): boolean;
+  static equals(a: RegisterForTournamentRequest | PlainMessage<RegisterForTournamentRequest> | undefined, b: RegisterForTournamentRequest | PlainMessage<RegisterForTournamentRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.GameStarted
+ * Only open while the tournament has not started.
+ *
+ * @generated from message pirates.v1.WithdrawFromTournamentRequest
  */
-export declare class GameStarted extends Message<GameStarted> {
+export declare class WithdrawFromTournamentRequest extends Message<WithdrawFromTournamentRequest> {
   /**
-   * @generated from field: string game_id = 1;
+   * @generated from field: string session_token = 1;
    */
-  gameId: string;
+  sessionToken: string;
 
   /**
-   * @generated from field: pirates.v1.Player opponent = 2;
+   * @generated from field: string tournament_id = 2;
    */
-  opponent?: Player;
+  tournamentId: string;
+
+  constructor(data?: PartialMessage<WithdrawFromTournamentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.WithdrawFromTournamentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WithdrawFromTournamentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WithdrawFromTournamentRequest;
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WithdrawFromTournamentRequest;
+
+  static equals(a: WithdrawFromTournamentRequest | PlainMessage<WithdrawFromTournamentRequest> | undefined, b: WithdrawFromTournamentRequest | PlainMessage<WithdrawFromTournamentRequest> | undefined): boolean;
+}
+
+/**
+ * Only the organizer starts the tournament, with at least two players.
+ *
+ * @generated from message pirates.v1.StartTournamentRequest
+ */
+export declare class StartTournamentRequest extends Message<StartTournamentRequest> {
   /**
-   * @generated from field: bool your_turn_first = 3;
+   * @generated from field: string session_token = 1;
    */
-  yourTurnFirst: boolean;
+  sessionToken: string;
 
-  constructor(data?: PartialMessage<GameStarted>);
+  /**
+   * @generated from field: string tournament_id = 2;
+   */
+  tournamentId: string;
+
+  constructor(data?: PartialMessage<StartTournamentRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.GameStarted";
+  static readonly typeName = "pirates.v1.StartTournamentRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameStarted;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartTournamentRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameStarted;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartTournamentRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameStarted;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartTournamentRequest;
 
-  static equals(a: GameStarted | PlainMessage<GameStarted> | undefined, b: GameStarted | PlainMessage<GameStarted> | undefined): boolean;
+  static equals(a: StartTournamentRequest | PlainMessage<StartTournamentRequest> | undefined, b: StartTournamentRequest | PlainMessage<StartTournamentRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.PlacementResult
+ * @generated from message pirates.v1.GetTournamentRequest
  */
-export declare class PlacementResult extends Message<PlacementResult> {
+export declare class GetTournamentRequest extends Message<GetTournamentRequest> {
   /**
-   * @generated from field: bool valid = 1;
+   * @generated from field: string session_token = 1;
    */
-  valid: boolean;
+  sessionToken: string;
 
   /**
-   * @generated from field: string error_message = 2;
+   * @generated from field: string tournament_id = 2;
    */
-  errorMessage: string;
+  tournamentId: string;
+
+  constructor(data?: PartialMessage<GetTournamentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GetTournamentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTournamentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTournamentRequest;
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTournamentRequest;
+
+  static equals(a: GetTournamentRequest | PlainMessage<GetTournamentRequest> | undefined, b: GetTournamentRequest | PlainMessage<GetTournamentRequest> | undefined): boolean;
+}
+
+/**
+ * Starts the day's puzzle, or resumes it: each player gets one attempt a
+ * day.
+ *
+ * @generated from message pirates.v1.StartDailyPuzzleRequest
+ */
+export declare class StartDailyPuzzleRequest extends Message<StartDailyPuzzleRequest> {
   /**
-   * @generated from field: bool waiting_for_opponent = 3;
+   * @generated from field: string session_token = 1;
    */
-  waitingForOpponent: boolean;
+  sessionToken: string;
 
-  constructor(data?: PartialMessage<PlacementResult>);
+  constructor(data?: PartialMessage<StartDailyPuzzleRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.PlacementResult";
+  static readonly typeName = "pirates.v1.StartDailyPuzzleRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementResult;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartDailyPuzzleRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementResult;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartDailyPuzzleRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementResult;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartDailyPuzzleRequest;
 
-  static equals(a: PlacementResult | PlainMessage<PlacementResult> | undefined, b: PlacementResult | PlainMessage<PlacementResult> | undefined): boolean;
+  static equals(a: StartDailyPuzzleRequest | PlainMessage<StartDailyPuzzleRequest> | undefined, b: StartDailyPuzzleRequest | PlainMessage<StartDailyPuzzleRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.TurnStarted
+ * Fires at the puzzle last started.
+ *
+ * @generated from message pirates.v1.PuzzleAttackRequest
  */
-export declare class TurnStarted extends Message<TurnStarted> {
+export declare class PuzzleAttackRequest extends Message<PuzzleAttackRequest> {
   /**
-   * @generated from field: bool your_turn = 1;
+   * @generated from field: string session_token = 1;
    */
-  yourTurn: boolean;
+  sessionToken: string;
 
   /**
-   * @generated from field: repeated pirates.v1.Power available_powers = 2;
+   * @generated from field: pirates.v1.Coordinate target = 2;
    */
-  availablePowers: Power[];
+  target?: Coordinate;
 
-  constructor(data?: PartialMessage<TurnStarted>);
+  constructor(data?: PartialMessage<PuzzleAttackRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.TurnStarted";
+  static readonly typeName = "pirates.v1.PuzzleAttackRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TurnStarted;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PuzzleAttackRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TurnStarted;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PuzzleAttackRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TurnStarted;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PuzzleAttackRequest;
 
-  static equals(a: TurnStarted | PlainMessage<TurnStarted> | undefined, b: TurnStarted | PlainMessage<TurnStarted> | undefined): boolean;
+  static equals(a: PuzzleAttackRequest | PlainMessage<PuzzleAttackRequest> | undefined, b: PuzzleAttackRequest | PlainMessage<PuzzleAttackRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.AttackResult
+ * @generated from message pirates.v1.PuzzleUsePowerRequest
  */
-export declare class AttackResult extends Message<AttackResult> {
+export declare class PuzzleUsePowerRequest extends Message<PuzzleUsePowerRequest> {
   /**
-   * @generated from field: pirates.v1.Coordinate target = 1;
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: pirates.v1.PowerType power = 2;
+   */
+  power: PowerType;
+
+  /**
+   * @generated from field: pirates.v1.Coordinate target = 3;
    */
   target?: Coordinate;
 
   /**
-   * @generated from field: bool hit = 2;
+   * @generated from field: pirates.v1.Orientation orientation = 4;
    */
-  hit: boolean;
+  orientation: Orientation;
+
+  constructor(data?: PartialMessage<PuzzleUsePowerRequest>);
 
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PuzzleUsePowerRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PuzzleUsePowerRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PuzzleUsePowerRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PuzzleUsePowerRequest;
+
+  static equals(a: PuzzleUsePowerRequest | PlainMessage<PuzzleUsePowerRequest> | undefined, b: PuzzleUsePowerRequest | PlainMessage<PuzzleUsePowerRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.GetPuzzleLeaderboardRequest
+ */
+export declare class GetPuzzleLeaderboardRequest extends Message<GetPuzzleLeaderboardRequest> {
   /**
-   * @generated from field: pirates.v1.Ship sunk_ship = 3;
+   * @generated from field: string session_token = 1;
    */
-  sunkShip?: Ship;
+  sessionToken: string;
 
   /**
-   * @generated from field: pirates.v1.Power power_gained = 4;
+   * @generated from field: string date = 2;
    */
-  powerGained?: Power;
+  date: string;
 
-  constructor(data?: PartialMessage<AttackResult>);
+  constructor(data?: PartialMessage<GetPuzzleLeaderboardRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.AttackResult";
+  static readonly typeName = "pirates.v1.GetPuzzleLeaderboardRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttackResult;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPuzzleLeaderboardRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttackResult;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPuzzleLeaderboardRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttackResult;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPuzzleLeaderboardRequest;
 
-  static equals(a: AttackResult | PlainMessage<AttackResult> | undefined, b: AttackResult | PlainMessage<AttackResult> | undefined): boolean;
+  static equals(a: GetPuzzleLeaderboardRequest | PlainMessage<GetPuzzleLeaderboardRequest> | undefined, b: GetPuzzleLeaderboardRequest | PlainMessage<GetPuzzleLeaderboardRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.CellReveal
+ * @generated from message pirates.v1.GetPlayerProfileRequest
  */
-export declare class CellReveal extends Message<CellReveal> {
+export declare class GetPlayerProfileRequest extends Message<GetPlayerProfileRequest> {
   /**
-   * @generated from field: pirates.v1.Coordinate position = 1;
+   * @generated from field: string session_token = 1;
    */
-  position?: Coordinate;
+  sessionToken: string;
 
   /**
-   * @generated from field: pirates.v1.CellState state = 2;
+   * @generated from field: string player_id = 2;
    */
-  state: CellState;
+  playerId: string;
 
-  constructor(data?: PartialMessage<CellReveal>);
+  constructor(data?: PartialMessage<GetPlayerProfileRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.CellReveal";
+  static readonly typeName = "pirates.v1.GetPlayerProfileRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CellReveal;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPlayerProfileRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CellReveal;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPlayerProfileRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CellReveal;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPlayerProfileRequest;
 
-  static equals(a: CellReveal | PlainMessage<CellReveal> | undefined, b: CellReveal | PlainMessage<CellReveal> | undefined): boolean;
+  static equals(a: GetPlayerProfileRequest | PlainMessage<GetPlayerProfileRequest> | undefined, b: GetPlayerProfileRequest | PlainMessage<GetPlayerProfileRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.PowerResult
+ * @generated from message pirates.v1.GetLeaderboardRequest
  */
-export declare class PowerResult extends Message<PowerResult> {
+export declare class GetLeaderboardRequest extends Message<GetLeaderboardRequest> {
   /**
-   * @generated from field: pirates.v1.PowerType power_used = 1;
+   * @generated from field: string session_token = 1;
    */
-  powerUsed: PowerType;
+  sessionToken: string;
 
   /**
-   * @generated from field: repeated pirates.v1.CellReveal cells_affected = 2;
+   * @generated from field: pirates.v1.LeaderboardScope scope = 2;
    */
-  cellsAffected: CellReveal[];
+  scope: LeaderboardScope;
 
   /**
-   * @generated from field: repeated pirates.v1.Ship sunk_ships = 3;
+   * @generated from field: pirates.v1.RuleSet rule_set = 3;
    */
-  sunkShips: Ship[];
+  ruleSet: RuleSet;
 
   /**
-   * @generated from field: string message = 4;
+   * @generated from field: int32 page_size = 4;
    */
-  message: string;
+  pageSize: number;
 
-  constructor(data?: PartialMessage<PowerResult>);
+  /**
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+
+  constructor(data?: PartialMessage<GetLeaderboardRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.PowerResult";
+  static readonly typeName = "pirates.v1.GetLeaderboardRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PowerResult;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLeaderboardRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PowerResult;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLeaderboardRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PowerResult;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLeaderboardRequest;
 
-  static equals(a: PowerResult | PlainMessage<PowerResult> | undefined, b: PowerResult | PlainMessage<PowerResult> | undefined): boolean;
+  static equals(a: GetLeaderboardRequest | PlainMessage<GetLeaderboardRequest> | undefined, b: GetLeaderboardRequest | PlainMessage<GetLeaderboardRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.OpponentAction
+ * Either player_id or display_name must be set.
+ *
+ * @generated from message pirates.v1.SendFriendRequestRequest
  */
-export declare class OpponentAction extends Message<OpponentAction> {
+export declare class SendFriendRequestRequest extends Message<SendFriendRequestRequest> {
   /**
-   * @generated from oneof pirates.v1.OpponentAction.action
+   * @generated from field: string session_token = 1;
    */
-  action: {
-    /**
-     * @generated from field: pirates.v1.AttackResult attack = 1;
-     */
-    value: AttackResult;
-    case: "attack";
-  } | {
-    /**
-     * @generated from field: pirates.v1.PowerResult power = 2;
-     */
-    value: PowerResult;
-    case: "power";
-  } | { case: undefined; value?: undefined };
+  sessionToken: string;
 
   /**
-   * @generated from field: repeated pirates.v1.CellReveal your_grid_updates = 3;
+   * @generated from field: string player_id = 2;
    */
-  yourGridUpdates: CellReveal[];
+  playerId: string;
 
-  constructor(data?: PartialMessage<OpponentAction>);
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  constructor(data?: PartialMessage<SendFriendRequestRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.OpponentAction";
+  static readonly typeName = "pirates.v1.SendFriendRequestRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OpponentAction;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendFriendRequestRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OpponentAction;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendFriendRequestRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OpponentAction;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendFriendRequestRequest;
 
-  static equals(a: OpponentAction | PlainMessage<OpponentAction> | undefined, b: OpponentAction | PlainMessage<OpponentAction> | undefined): boolean;
+  static equals(a: SendFriendRequestRequest | PlainMessage<SendFriendRequestRequest> | undefined, b: SendFriendRequestRequest | PlainMessage<SendFriendRequestRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.GameOver
+ * @generated from message pirates.v1.RespondToFriendRequestRequest
  */
-export declare class GameOver extends Message<GameOver> {
+export declare class RespondToFriendRequestRequest extends Message<RespondToFriendRequestRequest> {
   /**
-   * @generated from field: bool you_won = 1;
+   * @generated from field: string session_token = 1;
    */
-  youWon: boolean;
+  sessionToken: string;
 
   /**
-   * @generated from field: string reason = 2;
+   * @generated from field: string player_id = 2;
    */
-  reason: string;
+  playerId: string;
 
-  constructor(data?: PartialMessage<GameOver>);
+  /**
+   * @generated from field: bool accepted = 3;
+   */
+  accepted: boolean;
+
+  constructor(data?: PartialMessage<RespondToFriendRequestRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.GameOver";
+  static readonly typeName = "pirates.v1.RespondToFriendRequestRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameOver;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RespondToFriendRequestRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameOver;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RespondToFriendRequestRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameOver;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RespondToFriendRequestRequest;
 
-  static equals(a: GameOver | PlainMessage<GameOver> | undefined, b: GameOver | PlainMessage<GameOver> | undefined): boolean;
+  static equals(a: RespondToFriendRequestRequest | PlainMessage<RespondToFriendRequestRequest> | undefined, b: RespondToFriendRequestRequest | PlainMessage<RespondToFriendRequestRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message pirates.v1.GameEvent
+ * @generated from message pirates.v1.RemoveFriendRequest
  */
-export declare class GameEvent extends Message<GameEvent> {
+export declare class RemoveFriendRequest extends Message<RemoveFriendRequest> {
   /**
-   * @generated from oneof pirates.v1.GameEvent.event
+   * @generated from field: string session_token = 1;
    */
-  event: {
-    /**
-     * @generated from field: pirates.v1.QueueStatusUpdate queue_status = 1;
-     */
+  sessionToken: string;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  constructor(data?: PartialMessage<RemoveFriendRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.RemoveFriendRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveFriendRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveFriendRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveFriendRequest;
+
+  static equals(a: RemoveFriendRequest | PlainMessage<RemoveFriendRequest> | undefined, b: RemoveFriendRequest | PlainMessage<RemoveFriendRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.BlockPlayerRequest
+ */
+export declare class BlockPlayerRequest extends Message<BlockPlayerRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  constructor(data?: PartialMessage<BlockPlayerRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.BlockPlayerRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockPlayerRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockPlayerRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockPlayerRequest;
+
+  static equals(a: BlockPlayerRequest | PlainMessage<BlockPlayerRequest> | undefined, b: BlockPlayerRequest | PlainMessage<BlockPlayerRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.UnblockPlayerRequest
+ */
+export declare class UnblockPlayerRequest extends Message<UnblockPlayerRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  constructor(data?: PartialMessage<UnblockPlayerRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.UnblockPlayerRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnblockPlayerRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnblockPlayerRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnblockPlayerRequest;
+
+  static equals(a: UnblockPlayerRequest | PlainMessage<UnblockPlayerRequest> | undefined, b: UnblockPlayerRequest | PlainMessage<UnblockPlayerRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.GetFriendsRequest
+ */
+export declare class GetFriendsRequest extends Message<GetFriendsRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  constructor(data?: PartialMessage<GetFriendsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GetFriendsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFriendsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFriendsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFriendsRequest;
+
+  static equals(a: GetFriendsRequest | PlainMessage<GetFriendsRequest> | undefined, b: GetFriendsRequest | PlainMessage<GetFriendsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ChallengeFriendRequest
+ */
+export declare class ChallengeFriendRequest extends Message<ChallengeFriendRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 3;
+   */
+  rules?: GameRules;
+
+  constructor(data?: PartialMessage<ChallengeFriendRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ChallengeFriendRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChallengeFriendRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChallengeFriendRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChallengeFriendRequest;
+
+  static equals(a: ChallengeFriendRequest | PlainMessage<ChallengeFriendRequest> | undefined, b: ChallengeFriendRequest | PlainMessage<ChallengeFriendRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ChallengeFriendResponse
+ */
+export declare class ChallengeFriendResponse extends Message<ChallengeFriendResponse> {
+  /**
+   * @generated from field: string match_id = 1;
+   */
+  matchId: string;
+
+  /**
+   * The friend is in a game: the challenge is proposed once it is over.
+   *
+   * @generated from field: bool queued = 2;
+   */
+  queued: boolean;
+
+  constructor(data?: PartialMessage<ChallengeFriendResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ChallengeFriendResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChallengeFriendResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChallengeFriendResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChallengeFriendResponse;
+
+  static equals(a: ChallengeFriendResponse | PlainMessage<ChallengeFriendResponse> | undefined, b: ChallengeFriendResponse | PlainMessage<ChallengeFriendResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ForfeitRequest
+ */
+export declare class ForfeitRequest extends Message<ForfeitRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  constructor(data?: PartialMessage<ForfeitRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ForfeitRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForfeitRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForfeitRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForfeitRequest;
+
+  static equals(a: ForfeitRequest | PlainMessage<ForfeitRequest> | undefined, b: ForfeitRequest | PlainMessage<ForfeitRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ForfeitResponse
+ */
+export declare class ForfeitResponse extends Message<ForfeitResponse> {
+  constructor(data?: PartialMessage<ForfeitResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ForfeitResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForfeitResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForfeitResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForfeitResponse;
+
+  static equals(a: ForfeitResponse | PlainMessage<ForfeitResponse> | undefined, b: ForfeitResponse | PlainMessage<ForfeitResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PlaceShipsRequest
+ */
+export declare class PlaceShipsRequest extends Message<PlaceShipsRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.Ship ships = 2;
+   */
+  ships: Ship[];
+
+  constructor(data?: PartialMessage<PlaceShipsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlaceShipsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlaceShipsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlaceShipsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlaceShipsRequest;
+
+  static equals(a: PlaceShipsRequest | PlainMessage<PlaceShipsRequest> | undefined, b: PlaceShipsRequest | PlainMessage<PlaceShipsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.AttackRequest
+ */
+export declare class AttackRequest extends Message<AttackRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: pirates.v1.Coordinate target = 2;
+   */
+  target?: Coordinate;
+
+  /**
+   * The opponent fired at. Empty targets the next opponent still in the
+   * game, the only one in a two-player game.
+   *
+   * @generated from field: string target_player_id = 3;
+   */
+  targetPlayerId: string;
+
+  constructor(data?: PartialMessage<AttackRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.AttackRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttackRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttackRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttackRequest;
+
+  static equals(a: AttackRequest | PlainMessage<AttackRequest> | undefined, b: AttackRequest | PlainMessage<AttackRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.AttackSalvoRequest
+ */
+export declare class AttackSalvoRequest extends Message<AttackSalvoRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.Coordinate targets = 2;
+   */
+  targets: Coordinate[];
+
+  /**
+   * @generated from field: string target_player_id = 3;
+   */
+  targetPlayerId: string;
+
+  constructor(data?: PartialMessage<AttackSalvoRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.AttackSalvoRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttackSalvoRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttackSalvoRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttackSalvoRequest;
+
+  static equals(a: AttackSalvoRequest | PlainMessage<AttackSalvoRequest> | undefined, b: AttackSalvoRequest | PlainMessage<AttackSalvoRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.UsePowerRequest
+ */
+export declare class UsePowerRequest extends Message<UsePowerRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: pirates.v1.PowerType power = 2;
+   */
+  power: PowerType;
+
+  /**
+   * @generated from field: pirates.v1.Coordinate target = 3;
+   */
+  target?: Coordinate;
+
+  /**
+   * @generated from field: bool horizontal = 4;
+   */
+  horizontal: boolean;
+
+  /**
+   * @generated from field: pirates.v1.Orientation orientation = 5;
+   */
+  orientation: Orientation;
+
+  /**
+   * @generated from field: string target_player_id = 6;
+   */
+  targetPlayerId: string;
+
+  constructor(data?: PartialMessage<UsePowerRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.UsePowerRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UsePowerRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UsePowerRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UsePowerRequest;
+
+  static equals(a: UsePowerRequest | PlainMessage<UsePowerRequest> | undefined, b: UsePowerRequest | PlainMessage<UsePowerRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PreviewPowerRequest
+ */
+export declare class PreviewPowerRequest extends Message<PreviewPowerRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: pirates.v1.PowerType power = 2;
+   */
+  power: PowerType;
+
+  /**
+   * @generated from field: pirates.v1.Coordinate target = 3;
+   */
+  target?: Coordinate;
+
+  /**
+   * @generated from field: bool horizontal = 4;
+   */
+  horizontal: boolean;
+
+  /**
+   * @generated from field: pirates.v1.Orientation orientation = 5;
+   */
+  orientation: Orientation;
+
+  /**
+   * @generated from field: string target_player_id = 6;
+   */
+  targetPlayerId: string;
+
+  constructor(data?: PartialMessage<PreviewPowerRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PreviewPowerRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewPowerRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewPowerRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewPowerRequest;
+
+  static equals(a: PreviewPowerRequest | PlainMessage<PreviewPowerRequest> | undefined, b: PreviewPowerRequest | PlainMessage<PreviewPowerRequest> | undefined): boolean;
+}
+
+/**
+ * PowerPreview lists the cells a power would touch, without their contents.
+ *
+ * @generated from message pirates.v1.PowerPreview
+ */
+export declare class PowerPreview extends Message<PowerPreview> {
+  /**
+   * @generated from field: repeated pirates.v1.Coordinate cells = 1;
+   */
+  cells: Coordinate[];
+
+  /**
+   * @generated from field: bool own_grid = 2;
+   */
+  ownGrid: boolean;
+
+  constructor(data?: PartialMessage<PowerPreview>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PowerPreview";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PowerPreview;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PowerPreview;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PowerPreview;
+
+  static equals(a: PowerPreview | PlainMessage<PowerPreview> | undefined, b: PowerPreview | PlainMessage<PowerPreview> | undefined): boolean;
+}
+
+/**
+ * Either text or emote must be set.
+ *
+ * @generated from message pirates.v1.SendChatMessageRequest
+ */
+export declare class SendChatMessageRequest extends Message<SendChatMessageRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: pirates.v1.ChatScope scope = 2;
+   */
+  scope: ChatScope;
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text: string;
+
+  /**
+   * @generated from field: pirates.v1.QuickEmote emote = 4;
+   */
+  emote: QuickEmote;
+
+  constructor(data?: PartialMessage<SendChatMessageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.SendChatMessageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendChatMessageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendChatMessageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendChatMessageRequest;
+
+  static equals(a: SendChatMessageRequest | PlainMessage<SendChatMessageRequest> | undefined, b: SendChatMessageRequest | PlainMessage<SendChatMessageRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.SendChatMessageResponse
+ */
+export declare class SendChatMessageResponse extends Message<SendChatMessageResponse> {
+  /**
+   * @generated from field: pirates.v1.ChatMessage message = 1;
+   */
+  message?: ChatMessage;
+
+  constructor(data?: PartialMessage<SendChatMessageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.SendChatMessageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendChatMessageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendChatMessageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendChatMessageResponse;
+
+  static equals(a: SendChatMessageResponse | PlainMessage<SendChatMessageResponse> | undefined, b: SendChatMessageResponse | PlainMessage<SendChatMessageResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.MutePlayerRequest
+ */
+export declare class MutePlayerRequest extends Message<MutePlayerRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: bool muted = 3;
+   */
+  muted: boolean;
+
+  constructor(data?: PartialMessage<MutePlayerRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.MutePlayerRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MutePlayerRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MutePlayerRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MutePlayerRequest;
+
+  static equals(a: MutePlayerRequest | PlainMessage<MutePlayerRequest> | undefined, b: MutePlayerRequest | PlainMessage<MutePlayerRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.MutePlayerResponse
+ */
+export declare class MutePlayerResponse extends Message<MutePlayerResponse> {
+  constructor(data?: PartialMessage<MutePlayerResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.MutePlayerResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MutePlayerResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MutePlayerResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MutePlayerResponse;
+
+  static equals(a: MutePlayerResponse | PlainMessage<MutePlayerResponse> | undefined, b: MutePlayerResponse | PlainMessage<MutePlayerResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.SubscribeEventsRequest
+ */
+export declare class SubscribeEventsRequest extends Message<SubscribeEventsRequest> {
+  /**
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  constructor(data?: PartialMessage<SubscribeEventsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.SubscribeEventsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscribeEventsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscribeEventsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscribeEventsRequest;
+
+  static equals(a: SubscribeEventsRequest | PlainMessage<SubscribeEventsRequest> | undefined, b: SubscribeEventsRequest | PlainMessage<SubscribeEventsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.QueueStatusUpdate
+ */
+export declare class QueueStatusUpdate extends Message<QueueStatusUpdate> {
+  /**
+   * @generated from field: bool in_queue = 1;
+   */
+  inQueue: boolean;
+
+  /**
+   * @generated from field: int32 queue_position = 2;
+   */
+  queuePosition: number;
+
+  /**
+   * @generated from field: int32 players_in_queue = 3;
+   */
+  playersInQueue: number;
+
+  /**
+   * @generated from field: string queue = 4;
+   */
+  queue: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.QueueInfo queues = 5;
+   */
+  queues: QueueInfo[];
+
+  constructor(data?: PartialMessage<QueueStatusUpdate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.QueueStatusUpdate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueueStatusUpdate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueueStatusUpdate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueueStatusUpdate;
+
+  static equals(a: QueueStatusUpdate | PlainMessage<QueueStatusUpdate> | undefined, b: QueueStatusUpdate | PlainMessage<QueueStatusUpdate> | undefined): boolean;
+}
+
+/**
+ * QueueInfo describes a matchmaking queue. Its matches are played with its
+ * rules.
+ *
+ * @generated from message pirates.v1.QueueInfo
+ */
+export declare class QueueInfo extends Message<QueueInfo> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 2;
+   */
+  rules?: GameRules;
+
+  /**
+   * @generated from field: bool rating_matched = 3;
+   */
+  ratingMatched: boolean;
+
+  /**
+   * @generated from field: int32 timeout_seconds = 4;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * @generated from field: int32 players = 5;
+   */
+  players: number;
+
+  /**
+   * @generated from field: int32 estimated_wait_seconds = 6;
+   */
+  estimatedWaitSeconds: number;
+
+  constructor(data?: PartialMessage<QueueInfo>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.QueueInfo";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueueInfo;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueueInfo;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueueInfo;
+
+  static equals(a: QueueInfo | PlainMessage<QueueInfo> | undefined, b: QueueInfo | PlainMessage<QueueInfo> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PlayerListUpdate
+ */
+export declare class PlayerListUpdate extends Message<PlayerListUpdate> {
+  /**
+   * @generated from field: repeated pirates.v1.Player available_players = 1;
+   */
+  availablePlayers: Player[];
+
+  /**
+   * Incremental lobby push: players who connected or changed status, and
+   * players who left the server since the previous update.
+   *
+   * @generated from field: repeated pirates.v1.Player changed_players = 2;
+   */
+  changedPlayers: Player[];
+
+  /**
+   * @generated from field: repeated string departed_player_ids = 3;
+   */
+  departedPlayerIds: string[];
+
+  constructor(data?: PartialMessage<PlayerListUpdate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlayerListUpdate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerListUpdate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerListUpdate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerListUpdate;
+
+  static equals(a: PlayerListUpdate | PlainMessage<PlayerListUpdate> | undefined, b: PlayerListUpdate | PlainMessage<PlayerListUpdate> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.MatchProposal
+ */
+export declare class MatchProposal extends Message<MatchProposal> {
+  /**
+   * @generated from field: string match_id = 1;
+   */
+  matchId: string;
+
+  /**
+   * @generated from field: pirates.v1.Player opponent = 2;
+   */
+  opponent?: Player;
+
+  /**
+   * @generated from field: bool you_initiated = 3;
+   */
+  youInitiated: boolean;
+
+  /**
+   * @generated from field: int32 timeout_seconds = 4;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 5;
+   */
+  rules?: GameRules;
+
+  /**
+   * @generated from field: repeated pirates.v1.Player opponents = 6;
+   */
+  opponents: Player[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Player allies = 7;
+   */
+  allies: Player[];
+
+  /**
+   * @generated from field: string tournament_id = 8;
+   */
+  tournamentId: string;
+
+  constructor(data?: PartialMessage<MatchProposal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.MatchProposal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MatchProposal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MatchProposal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MatchProposal;
+
+  static equals(a: MatchProposal | PlainMessage<MatchProposal> | undefined, b: MatchProposal | PlainMessage<MatchProposal> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.MatchResult
+ */
+export declare class MatchResult extends Message<MatchResult> {
+  /**
+   * @generated from field: string match_id = 1;
+   */
+  matchId: string;
+
+  /**
+   * @generated from field: bool accepted = 2;
+   */
+  accepted: boolean;
+
+  /**
+   * @generated from field: string rejection_reason = 3;
+   */
+  rejectionReason: string;
+
+  constructor(data?: PartialMessage<MatchResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.MatchResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MatchResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MatchResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MatchResult;
+
+  static equals(a: MatchResult | PlainMessage<MatchResult> | undefined, b: MatchResult | PlainMessage<MatchResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.GameStarted
+ */
+export declare class GameStarted extends Message<GameStarted> {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: pirates.v1.Player opponent = 2;
+   */
+  opponent?: Player;
+
+  /**
+   * @generated from field: bool your_turn_first = 3;
+   */
+  yourTurnFirst: boolean;
+
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 4;
+   */
+  rules?: GameRules;
+
+  /**
+   * @generated from field: pirates.v1.Terrain terrain = 5;
+   */
+  terrain?: Terrain;
+
+  /**
+   * @generated from field: repeated pirates.v1.Player opponents = 6;
+   */
+  opponents: Player[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Player allies = 7;
+   */
+  allies: Player[];
+
+  /**
+   * @generated from field: pirates.v1.Series series = 8;
+   */
+  series?: Series;
+
+  constructor(data?: PartialMessage<GameStarted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GameStarted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameStarted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameStarted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameStarted;
+
+  static equals(a: GameStarted | PlainMessage<GameStarted> | undefined, b: GameStarted | PlainMessage<GameStarted> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PlacementResult
+ */
+export declare class PlacementResult extends Message<PlacementResult> {
+  /**
+   * @generated from field: bool valid = 1;
+   */
+  valid: boolean;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+
+  /**
+   * @generated from field: bool waiting_for_opponent = 3;
+   */
+  waitingForOpponent: boolean;
+
+  constructor(data?: PartialMessage<PlacementResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlacementResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementResult;
+
+  static equals(a: PlacementResult | PlainMessage<PlacementResult> | undefined, b: PlacementResult | PlainMessage<PlacementResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.TurnStarted
+ */
+export declare class TurnStarted extends Message<TurnStarted> {
+  /**
+   * @generated from field: bool your_turn = 1;
+   */
+  yourTurn: boolean;
+
+  /**
+   * @generated from field: repeated pirates.v1.Power available_powers = 2;
+   */
+  availablePowers: Power[];
+
+  /**
+   * @generated from field: int32 salvo_shots = 3;
+   */
+  salvoShots: number;
+
+  /**
+   * @generated from field: bool bonus_turn = 4;
+   */
+  bonusTurn: boolean;
+
+  /**
+   * @generated from field: pirates.v1.GameView view = 5;
+   */
+  view?: GameView;
+
+  /**
+   * @generated from field: string current_player_id = 6;
+   */
+  currentPlayerId: string;
+
+  /**
+   * @generated from field: bool eliminated = 7;
+   */
+  eliminated: boolean;
+
+  /**
+   * @generated from field: int64 deadline_unix_ms = 8;
+   */
+  deadlineUnixMs: bigint;
+
+  constructor(data?: PartialMessage<TurnStarted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.TurnStarted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TurnStarted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TurnStarted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TurnStarted;
+
+  static equals(a: TurnStarted | PlainMessage<TurnStarted> | undefined, b: TurnStarted | PlainMessage<TurnStarted> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.AttackResult
+ */
+export declare class AttackResult extends Message<AttackResult> {
+  /**
+   * @generated from field: pirates.v1.Coordinate target = 1;
+   */
+  target?: Coordinate;
+
+  /**
+   * @generated from field: bool hit = 2;
+   */
+  hit: boolean;
+
+  /**
+   * @generated from field: pirates.v1.Ship sunk_ship = 3;
+   */
+  sunkShip?: Ship;
+
+  /**
+   * @generated from field: pirates.v1.Power power_gained = 4;
+   */
+  powerGained?: Power;
+
+  /**
+   * @generated from field: bool island = 5;
+   */
+  island: boolean;
+
+  /**
+   * @generated from field: bool mine = 6;
+   */
+  mine: boolean;
+
+  /**
+   * @generated from field: pirates.v1.AttackResult mine_blast = 7;
+   */
+  mineBlast?: AttackResult;
+
+  /**
+   * @generated from field: bool shielded = 8;
+   */
+  shielded: boolean;
+
+  constructor(data?: PartialMessage<AttackResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.AttackResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttackResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttackResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttackResult;
+
+  static equals(a: AttackResult | PlainMessage<AttackResult> | undefined, b: AttackResult | PlainMessage<AttackResult> | undefined): boolean;
+}
+
+/**
+ * SalvoResult is the outcome of every shot of a salvo, resolved together.
+ *
+ * @generated from message pirates.v1.SalvoResult
+ */
+export declare class SalvoResult extends Message<SalvoResult> {
+  /**
+   * @generated from field: repeated pirates.v1.AttackResult shots = 1;
+   */
+  shots: AttackResult[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Ship sunk_ships = 2;
+   */
+  sunkShips: Ship[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Power powers_gained = 3;
+   */
+  powersGained: Power[];
+
+  constructor(data?: PartialMessage<SalvoResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.SalvoResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SalvoResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SalvoResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SalvoResult;
+
+  static equals(a: SalvoResult | PlainMessage<SalvoResult> | undefined, b: SalvoResult | PlainMessage<SalvoResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.CellReveal
+ */
+export declare class CellReveal extends Message<CellReveal> {
+  /**
+   * @generated from field: pirates.v1.Coordinate position = 1;
+   */
+  position?: Coordinate;
+
+  /**
+   * @generated from field: pirates.v1.CellState state = 2;
+   */
+  state: CellState;
+
+  constructor(data?: PartialMessage<CellReveal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.CellReveal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CellReveal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CellReveal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CellReveal;
+
+  static equals(a: CellReveal | PlainMessage<CellReveal> | undefined, b: CellReveal | PlainMessage<CellReveal> | undefined): boolean;
+}
+
+/**
+ * BoardView is one board as a player is allowed to see it. Cells nothing is
+ * known about are left out.
+ *
+ * @generated from message pirates.v1.BoardView
+ */
+export declare class BoardView extends Message<BoardView> {
+  /**
+   * @generated from field: repeated pirates.v1.CellReveal cells = 1;
+   */
+  cells: CellReveal[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Ship ships = 2;
+   */
+  ships: Ship[];
+
+  constructor(data?: PartialMessage<BoardView>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.BoardView";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BoardView;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BoardView;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BoardView;
+
+  static equals(a: BoardView | PlainMessage<BoardView> | undefined, b: BoardView | PlainMessage<BoardView> | undefined): boolean;
+}
+
+/**
+ * GameView is everything a player may know about the boards.
+ *
+ * @generated from message pirates.v1.GameView
+ */
+export declare class GameView extends Message<GameView> {
+  /**
+   * @generated from field: pirates.v1.BoardView your_board = 1;
+   */
+  yourBoard?: BoardView;
+
+  /**
+   * @generated from field: pirates.v1.BoardView opponent_board = 2;
+   */
+  opponentBoard?: BoardView;
+
+  /**
+   * @generated from field: repeated pirates.v1.PlayerBoard opponents = 3;
+   */
+  opponents: PlayerBoard[];
+
+  /**
+   * @generated from field: repeated pirates.v1.PlayerBoard allies = 4;
+   */
+  allies: PlayerBoard[];
+
+  constructor(data?: PartialMessage<GameView>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GameView";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameView;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameView;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameView;
+
+  static equals(a: GameView | PlainMessage<GameView> | undefined, b: GameView | PlainMessage<GameView> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PlayerBoard
+ */
+export declare class PlayerBoard extends Message<PlayerBoard> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: pirates.v1.BoardView board = 2;
+   */
+  board?: BoardView;
+
+  /**
+   * @generated from field: bool eliminated = 3;
+   */
+  eliminated: boolean;
+
+  constructor(data?: PartialMessage<PlayerBoard>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlayerBoard";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerBoard;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerBoard;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerBoard;
+
+  static equals(a: PlayerBoard | PlainMessage<PlayerBoard> | undefined, b: PlayerBoard | PlainMessage<PlayerBoard> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PowerResult
+ */
+export declare class PowerResult extends Message<PowerResult> {
+  /**
+   * @generated from field: pirates.v1.PowerType power_used = 1;
+   */
+  powerUsed: PowerType;
+
+  /**
+   * @generated from field: repeated pirates.v1.CellReveal cells_affected = 2;
+   */
+  cellsAffected: CellReveal[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Ship sunk_ships = 3;
+   */
+  sunkShips: Ship[];
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.AttackResult mine_blasts = 5;
+   */
+  mineBlasts: AttackResult[];
+
+  /**
+   * @generated from field: bool own_grid = 6;
+   */
+  ownGrid: boolean;
+
+  /**
+   * @generated from field: repeated pirates.v1.Power powers_gained = 7;
+   */
+  powersGained: Power[];
+
+  constructor(data?: PartialMessage<PowerResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PowerResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PowerResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PowerResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PowerResult;
+
+  static equals(a: PowerResult | PlainMessage<PowerResult> | undefined, b: PowerResult | PlainMessage<PowerResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.OpponentAction
+ */
+export declare class OpponentAction extends Message<OpponentAction> {
+  /**
+   * @generated from oneof pirates.v1.OpponentAction.action
+   */
+  action: {
+    /**
+     * @generated from field: pirates.v1.AttackResult attack = 1;
+     */
+    value: AttackResult;
+    case: "attack";
+  } | {
+    /**
+     * @generated from field: pirates.v1.PowerResult power = 2;
+     */
+    value: PowerResult;
+    case: "power";
+  } | {
+    /**
+     * @generated from field: pirates.v1.SalvoResult salvo = 4;
+     */
+    value: SalvoResult;
+    case: "salvo";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * What the action did to your grid. Sonar scans come back as REVEALED,
+   * whatever the cell holds. Empty when another player was targeted.
+   *
+   * @generated from field: repeated pirates.v1.CellReveal your_grid_updates = 3;
+   */
+  yourGridUpdates: CellReveal[];
+
+  /**
+   * @generated from field: string attacker_id = 5;
+   */
+  attackerId: string;
+
+  /**
+   * @generated from field: string target_player_id = 6;
+   */
+  targetPlayerId: string;
+
+  constructor(data?: PartialMessage<OpponentAction>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.OpponentAction";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OpponentAction;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OpponentAction;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OpponentAction;
+
+  static equals(a: OpponentAction | PlainMessage<OpponentAction> | undefined, b: OpponentAction | PlainMessage<OpponentAction> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.FleetShip
+ */
+export declare class FleetShip extends Message<FleetShip> {
+  /**
+   * @generated from field: pirates.v1.Ship ship = 1;
+   */
+  ship?: Ship;
+
+  /**
+   * @generated from field: int32 hits = 2;
+   */
+  hits: number;
+
+  /**
+   * @generated from field: bool sunk = 3;
+   */
+  sunk: boolean;
+
+  constructor(data?: PartialMessage<FleetShip>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.FleetShip";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FleetShip;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FleetShip;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FleetShip;
+
+  static equals(a: FleetShip | PlainMessage<FleetShip> | undefined, b: FleetShip | PlainMessage<FleetShip> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PlayerGameStats
+ */
+export declare class PlayerGameStats extends Message<PlayerGameStats> {
+  /**
+   * @generated from field: int32 shots_fired = 1;
+   */
+  shotsFired: number;
+
+  /**
+   * @generated from field: int32 hits = 2;
+   */
+  hits: number;
+
+  /**
+   * @generated from field: float accuracy = 3;
+   */
+  accuracy: number;
+
+  /**
+   * @generated from field: repeated pirates.v1.PowerType powers_used = 4;
+   */
+  powersUsed: PowerType[];
+
+  /**
+   * @generated from field: int32 ships_sunk = 5;
+   */
+  shipsSunk: number;
+
+  constructor(data?: PartialMessage<PlayerGameStats>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlayerGameStats";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerGameStats;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerGameStats;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerGameStats;
+
+  static equals(a: PlayerGameStats | PlainMessage<PlayerGameStats> | undefined, b: PlayerGameStats | PlainMessage<PlayerGameStats> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.GameSummary
+ */
+export declare class GameSummary extends Message<GameSummary> {
+  /**
+   * @generated from field: repeated pirates.v1.FleetShip your_fleet = 1;
+   */
+  yourFleet: FleetShip[];
+
+  /**
+   * @generated from field: repeated pirates.v1.FleetShip opponent_fleet = 2;
+   */
+  opponentFleet: FleetShip[];
+
+  /**
+   * @generated from field: pirates.v1.PlayerGameStats your_stats = 3;
+   */
+  yourStats?: PlayerGameStats;
+
+  /**
+   * @generated from field: pirates.v1.PlayerGameStats opponent_stats = 4;
+   */
+  opponentStats?: PlayerGameStats;
+
+  /**
+   * @generated from field: int32 duration_seconds = 5;
+   */
+  durationSeconds: number;
+
+  /**
+   * @generated from field: int32 rating_change = 6;
+   */
+  ratingChange: number;
+
+  /**
+   * @generated from field: repeated pirates.v1.Coordinate opponent_decoys = 7;
+   */
+  opponentDecoys: Coordinate[];
+
+  /**
+   * Every opponent, in turn order from you. The opponent_* fields above
+   * describe the winner, or the next opponent if you won.
+   *
+   * @generated from field: repeated pirates.v1.PlayerSummary opponents = 8;
+   */
+  opponents: PlayerSummary[];
+
+  /**
+   * @generated from field: repeated pirates.v1.PlayerSummary allies = 9;
+   */
+  allies: PlayerSummary[];
+
+  constructor(data?: PartialMessage<GameSummary>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GameSummary";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameSummary;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameSummary;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameSummary;
+
+  static equals(a: GameSummary | PlainMessage<GameSummary> | undefined, b: GameSummary | PlainMessage<GameSummary> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PlayerSummary
+ */
+export declare class PlayerSummary extends Message<PlayerSummary> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.FleetShip fleet = 2;
+   */
+  fleet: FleetShip[];
+
+  /**
+   * @generated from field: pirates.v1.PlayerGameStats stats = 3;
+   */
+  stats?: PlayerGameStats;
+
+  /**
+   * @generated from field: repeated pirates.v1.Coordinate decoys = 4;
+   */
+  decoys: Coordinate[];
+
+  constructor(data?: PartialMessage<PlayerSummary>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlayerSummary";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerSummary;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerSummary;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerSummary;
+
+  static equals(a: PlayerSummary | PlainMessage<PlayerSummary> | undefined, b: PlayerSummary | PlainMessage<PlayerSummary> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.GameOver
+ */
+export declare class GameOver extends Message<GameOver> {
+  /**
+   * @generated from field: bool you_won = 1;
+   */
+  youWon: boolean;
+
+  /**
+   * @generated from field: pirates.v1.GameOverReason reason = 3;
+   */
+  reason: GameOverReason;
+
+  /**
+   * @generated from field: pirates.v1.GameSummary summary = 4;
+   */
+  summary?: GameSummary;
+
+  /**
+   * Empty when the game ended without a winner. In team games, you_won is
+   * set for the whole winning team.
+   *
+   * @generated from field: string winner_id = 5;
+   */
+  winnerId: string;
+
+  /**
+   * @generated from field: pirates.v1.Series series = 6;
+   */
+  series?: Series;
+
+  constructor(data?: PartialMessage<GameOver>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GameOver";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameOver;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameOver;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameOver;
+
+  static equals(a: GameOver | PlainMessage<GameOver> | undefined, b: GameOver | PlainMessage<GameOver> | undefined): boolean;
+}
+
+/**
+ * Series is the score of a match played as a series of games. The next
+ * game starts right after the previous one until a side wins most games.
+ *
+ * @generated from message pirates.v1.Series
+ */
+export declare class Series extends Message<Series> {
+  /**
+   * @generated from field: int32 length = 1;
+   */
+  length: number;
+
+  /**
+   * @generated from field: int32 game_number = 2;
+   */
+  gameNumber: number;
+
+  /**
+   * @generated from field: repeated pirates.v1.SeriesScore scores = 3;
+   */
+  scores: SeriesScore[];
+
+  /**
+   * @generated from field: bool over = 4;
+   */
+  over: boolean;
+
+  /**
+   * @generated from field: string winner_id = 5;
+   */
+  winnerId: string;
+
+  constructor(data?: PartialMessage<Series>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.Series";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Series;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Series;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Series;
+
+  static equals(a: Series | PlainMessage<Series> | undefined, b: Series | PlainMessage<Series> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.SeriesScore
+ */
+export declare class SeriesScore extends Message<SeriesScore> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: int32 wins = 2;
+   */
+  wins: number;
+
+  constructor(data?: PartialMessage<SeriesScore>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.SeriesScore";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SeriesScore;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SeriesScore;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SeriesScore;
+
+  static equals(a: SeriesScore | PlainMessage<SeriesScore> | undefined, b: SeriesScore | PlainMessage<SeriesScore> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.OpponentDisconnected
+ */
+export declare class OpponentDisconnected extends Message<OpponentDisconnected> {
+  /**
+   * @generated from field: int32 grace_period_seconds = 1;
+   */
+  gracePeriodSeconds: number;
+
+  constructor(data?: PartialMessage<OpponentDisconnected>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.OpponentDisconnected";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OpponentDisconnected;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OpponentDisconnected;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OpponentDisconnected;
+
+  static equals(a: OpponentDisconnected | PlainMessage<OpponentDisconnected> | undefined, b: OpponentDisconnected | PlainMessage<OpponentDisconnected> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.OpponentReconnected
+ */
+export declare class OpponentReconnected extends Message<OpponentReconnected> {
+  constructor(data?: PartialMessage<OpponentReconnected>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.OpponentReconnected";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OpponentReconnected;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OpponentReconnected;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OpponentReconnected;
+
+  static equals(a: OpponentReconnected | PlainMessage<OpponentReconnected> | undefined, b: OpponentReconnected | PlainMessage<OpponentReconnected> | undefined): boolean;
+}
+
+/**
+ * PlayerEliminated is sent to every player of a game when one of them is
+ * out. The game goes on while more than one player is left; eliminated
+ * players keep receiving its events as spectators.
+ *
+ * @generated from message pirates.v1.PlayerEliminated
+ */
+export declare class PlayerEliminated extends Message<PlayerEliminated> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: pirates.v1.GameOverReason reason = 2;
+   */
+  reason: GameOverReason;
+
+  constructor(data?: PartialMessage<PlayerEliminated>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlayerEliminated";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerEliminated;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerEliminated;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerEliminated;
+
+  static equals(a: PlayerEliminated | PlainMessage<PlayerEliminated> | undefined, b: PlayerEliminated | PlainMessage<PlayerEliminated> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.ChatMessage
+ */
+export declare class ChatMessage extends Message<ChatMessage> {
+  /**
+   * @generated from field: string sender_id = 1;
+   */
+  senderId: string;
+
+  /**
+   * @generated from field: string sender_name = 2;
+   */
+  senderName: string;
+
+  /**
+   * @generated from field: pirates.v1.ChatScope scope = 3;
+   */
+  scope: ChatScope;
+
+  /**
+   * @generated from field: string text = 4;
+   */
+  text: string;
+
+  /**
+   * @generated from field: pirates.v1.QuickEmote emote = 5;
+   */
+  emote: QuickEmote;
+
+  /**
+   * @generated from field: int64 sent_at_unix_ms = 6;
+   */
+  sentAtUnixMs: bigint;
+
+  constructor(data?: PartialMessage<ChatMessage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.ChatMessage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChatMessage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChatMessage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChatMessage;
+
+  static equals(a: ChatMessage | PlainMessage<ChatMessage> | undefined, b: ChatMessage | PlainMessage<ChatMessage> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.GameEvent
+ */
+export declare class GameEvent extends Message<GameEvent> {
+  /**
+   * @generated from oneof pirates.v1.GameEvent.event
+   */
+  event: {
+    /**
+     * @generated from field: pirates.v1.QueueStatusUpdate queue_status = 1;
+     */
     value: QueueStatusUpdate;
     case: "queueStatus";
   } | {
@@ -1083,30 +3507,906 @@ export declare class GameEvent extends Message<GameEvent> {
     case: "opponentAction";
   } | {
     /**
-     * @generated from field: pirates.v1.GameOver game_over = 8;
+     * @generated from field: pirates.v1.GameOver game_over = 8;
+     */
+    value: GameOver;
+    case: "gameOver";
+  } | {
+    /**
+     * @generated from field: pirates.v1.PlacementResult placement_update = 9;
+     */
+    value: PlacementResult;
+    case: "placementUpdate";
+  } | {
+    /**
+     * @generated from field: pirates.v1.OpponentDisconnected opponent_disconnected = 10;
+     */
+    value: OpponentDisconnected;
+    case: "opponentDisconnected";
+  } | {
+    /**
+     * @generated from field: pirates.v1.OpponentReconnected opponent_reconnected = 11;
+     */
+    value: OpponentReconnected;
+    case: "opponentReconnected";
+  } | {
+    /**
+     * @generated from field: pirates.v1.ChatMessage chat_message = 12;
+     */
+    value: ChatMessage;
+    case: "chatMessage";
+  } | {
+    /**
+     * @generated from field: pirates.v1.PlayerEliminated player_eliminated = 13;
+     */
+    value: PlayerEliminated;
+    case: "playerEliminated";
+  } | {
+    /**
+     * @generated from field: pirates.v1.PartyInvite party_invite = 14;
+     */
+    value: PartyInvite;
+    case: "partyInvite";
+  } | {
+    /**
+     * @generated from field: pirates.v1.Party party_update = 15;
+     */
+    value: Party;
+    case: "partyUpdate";
+  } | {
+    /**
+     * @generated from field: pirates.v1.PartyDisbanded party_disbanded = 16;
+     */
+    value: PartyDisbanded;
+    case: "partyDisbanded";
+  } | {
+    /**
+     * @generated from field: pirates.v1.TournamentBracket tournament_update = 17;
+     */
+    value: TournamentBracket;
+    case: "tournamentUpdate";
+  } | {
+    /**
+     * @generated from field: pirates.v1.Achievement achievement_unlocked = 18;
+     */
+    value: Achievement;
+    case: "achievementUnlocked";
+  } | {
+    /**
+     * @generated from field: pirates.v1.FriendRequest friend_request = 19;
+     */
+    value: FriendRequest;
+    case: "friendRequest";
+  } | {
+    /**
+     * @generated from field: pirates.v1.FriendList friend_list = 20;
      */
-    value: GameOver;
-    case: "gameOver";
+    value: FriendList;
+    case: "friendList";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<GameEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.GameEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameEvent;
+
+  static equals(a: GameEvent | PlainMessage<GameEvent> | undefined, b: GameEvent | PlainMessage<GameEvent> | undefined): boolean;
+}
+
+/**
+ * Party is a group of players who queue together: as one team for 2v2
+ * games, or never against each other in one-on-one games.
+ *
+ * @generated from message pirates.v1.Party
+ */
+export declare class Party extends Message<Party> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string leader_id = 2;
+   */
+  leaderId: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.Player members = 3;
+   */
+  members: Player[];
+
+  constructor(data?: PartialMessage<Party>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.Party";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Party;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Party;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Party;
+
+  static equals(a: Party | PlainMessage<Party> | undefined, b: Party | PlainMessage<Party> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PartyInvite
+ */
+export declare class PartyInvite extends Message<PartyInvite> {
+  /**
+   * @generated from field: pirates.v1.Party party = 1;
+   */
+  party?: Party;
+
+  /**
+   * @generated from field: pirates.v1.Player inviter = 2;
+   */
+  inviter?: Player;
+
+  constructor(data?: PartialMessage<PartyInvite>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PartyInvite";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PartyInvite;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PartyInvite;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PartyInvite;
+
+  static equals(a: PartyInvite | PlainMessage<PartyInvite> | undefined, b: PartyInvite | PlainMessage<PartyInvite> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PartyDisbanded
+ */
+export declare class PartyDisbanded extends Message<PartyDisbanded> {
+  /**
+   * @generated from field: string party_id = 1;
+   */
+  partyId: string;
+
+  constructor(data?: PartialMessage<PartyDisbanded>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PartyDisbanded";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PartyDisbanded;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PartyDisbanded;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PartyDisbanded;
+
+  static equals(a: PartyDisbanded | PlainMessage<PartyDisbanded> | undefined, b: PartyDisbanded | PlainMessage<PartyDisbanded> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.Tournament
+ */
+export declare class Tournament extends Message<Tournament> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string organizer_id = 3;
+   */
+  organizerId: string;
+
+  /**
+   * @generated from field: pirates.v1.TournamentFormat format = 4;
+   */
+  format: TournamentFormat;
+
+  /**
+   * @generated from field: pirates.v1.GameRules rules = 5;
+   */
+  rules?: GameRules;
+
+  /**
+   * @generated from field: pirates.v1.TournamentStatus status = 6;
+   */
+  status: TournamentStatus;
+
+  /**
+   * @generated from field: repeated string player_ids = 7;
+   */
+  playerIds: string[];
+
+  /**
+   * @generated from field: int32 current_round = 8;
+   */
+  currentRound: number;
+
+  /**
+   * @generated from field: string winner_id = 9;
+   */
+  winnerId: string;
+
+  constructor(data?: PartialMessage<Tournament>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.Tournament";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Tournament;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Tournament;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Tournament;
+
+  static equals(a: Tournament | PlainMessage<Tournament> | undefined, b: Tournament | PlainMessage<Tournament> | undefined): boolean;
+}
+
+/**
+ * TournamentMatch is a match of a round. A match without a second player
+ * is a bye, won by the first.
+ *
+ * @generated from message pirates.v1.TournamentMatch
+ */
+export declare class TournamentMatch extends Message<TournamentMatch> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: int32 round = 2;
+   */
+  round: number;
+
+  /**
+   * @generated from field: string player1_id = 3;
+   */
+  player1Id: string;
+
+  /**
+   * @generated from field: string player2_id = 4;
+   */
+  player2Id: string;
+
+  /**
+   * @generated from field: string game_id = 5;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: bool finished = 6;
+   */
+  finished: boolean;
+
+  /**
+   * @generated from field: string winner_id = 7;
+   */
+  winnerId: string;
+
+  /**
+   * @generated from field: bool no_show = 8;
+   */
+  noShow: boolean;
+
+  /**
+   * @generated from field: int64 deadline_unix_ms = 9;
+   */
+  deadlineUnixMs: bigint;
+
+  constructor(data?: PartialMessage<TournamentMatch>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.TournamentMatch";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TournamentMatch;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TournamentMatch;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TournamentMatch;
+
+  static equals(a: TournamentMatch | PlainMessage<TournamentMatch> | undefined, b: TournamentMatch | PlainMessage<TournamentMatch> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.TournamentStanding
+ */
+export declare class TournamentStanding extends Message<TournamentStanding> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: int32 rank = 2;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: int32 wins = 3;
+   */
+  wins: number;
+
+  /**
+   * @generated from field: int32 losses = 4;
+   */
+  losses: number;
+
+  /**
+   * @generated from field: bool eliminated = 5;
+   */
+  eliminated: boolean;
+
+  constructor(data?: PartialMessage<TournamentStanding>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.TournamentStanding";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TournamentStanding;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TournamentStanding;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TournamentStanding;
+
+  static equals(a: TournamentStanding | PlainMessage<TournamentStanding> | undefined, b: TournamentStanding | PlainMessage<TournamentStanding> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.TournamentBracket
+ */
+export declare class TournamentBracket extends Message<TournamentBracket> {
+  /**
+   * @generated from field: pirates.v1.Tournament tournament = 1;
+   */
+  tournament?: Tournament;
+
+  /**
+   * @generated from field: repeated pirates.v1.TournamentMatch matches = 2;
+   */
+  matches: TournamentMatch[];
+
+  /**
+   * @generated from field: repeated pirates.v1.TournamentStanding standings = 3;
+   */
+  standings: TournamentStanding[];
+
+  constructor(data?: PartialMessage<TournamentBracket>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.TournamentBracket";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TournamentBracket;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TournamentBracket;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TournamentBracket;
+
+  static equals(a: TournamentBracket | PlainMessage<TournamentBracket> | undefined, b: TournamentBracket | PlainMessage<TournamentBracket> | undefined): boolean;
+}
+
+/**
+ * DailyPuzzle is a player's attempt at the day's puzzle. The hidden fleet
+ * never reaches the client: the board only shows the shots fired and the
+ * ships sunk.
+ *
+ * @generated from message pirates.v1.DailyPuzzle
+ */
+export declare class DailyPuzzle extends Message<DailyPuzzle> {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.Power available_powers = 2;
+   */
+  availablePowers: Power[];
+
+  /**
+   * @generated from field: pirates.v1.BoardView board = 3;
+   */
+  board?: BoardView;
+
+  /**
+   * @generated from field: int32 shots = 4;
+   */
+  shots: number;
+
+  /**
+   * @generated from field: bool solved = 5;
+   */
+  solved: boolean;
+
+  /**
+   * @generated from field: int32 rank = 6;
+   */
+  rank: number;
+
+  constructor(data?: PartialMessage<DailyPuzzle>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.DailyPuzzle";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DailyPuzzle;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DailyPuzzle;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DailyPuzzle;
+
+  static equals(a: DailyPuzzle | PlainMessage<DailyPuzzle> | undefined, b: DailyPuzzle | PlainMessage<DailyPuzzle> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PuzzleShotResult
+ */
+export declare class PuzzleShotResult extends Message<PuzzleShotResult> {
+  /**
+   * @generated from oneof pirates.v1.PuzzleShotResult.action
+   */
+  action: {
+    /**
+     * @generated from field: pirates.v1.AttackResult attack = 1;
+     */
+    value: AttackResult;
+    case: "attack";
   } | {
     /**
-     * @generated from field: pirates.v1.PlacementResult placement_update = 9;
+     * @generated from field: pirates.v1.PowerResult power = 2;
      */
-    value: PlacementResult;
-    case: "placementUpdate";
+    value: PowerResult;
+    case: "power";
   } | { case: undefined; value?: undefined };
 
-  constructor(data?: PartialMessage<GameEvent>);
+  /**
+   * @generated from field: pirates.v1.DailyPuzzle puzzle = 3;
+   */
+  puzzle?: DailyPuzzle;
+
+  constructor(data?: PartialMessage<PuzzleShotResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PuzzleShotResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PuzzleShotResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PuzzleShotResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PuzzleShotResult;
+
+  static equals(a: PuzzleShotResult | PlainMessage<PuzzleShotResult> | undefined, b: PuzzleShotResult | PlainMessage<PuzzleShotResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PuzzleLeaderboard
+ */
+export declare class PuzzleLeaderboard extends Message<PuzzleLeaderboard> {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: repeated pirates.v1.PuzzleScore scores = 2;
+   */
+  scores: PuzzleScore[];
+
+  constructor(data?: PartialMessage<PuzzleLeaderboard>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "pirates.v1.GameEvent";
+  static readonly typeName = "pirates.v1.PuzzleLeaderboard";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameEvent;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PuzzleLeaderboard;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameEvent;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PuzzleLeaderboard;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameEvent;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PuzzleLeaderboard;
 
-  static equals(a: GameEvent | PlainMessage<GameEvent> | undefined, b: GameEvent | PlainMessage<GameEvent> | undefined): boolean;
+  static equals(a: PuzzleLeaderboard | PlainMessage<PuzzleLeaderboard> | undefined, b: PuzzleLeaderboard | PlainMessage<PuzzleLeaderboard> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.PuzzleScore
+ */
+export declare class PuzzleScore extends Message<PuzzleScore> {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: int32 shots = 4;
+   */
+  shots: number;
+
+  /**
+   * @generated from field: int32 duration_seconds = 5;
+   */
+  durationSeconds: number;
+
+  constructor(data?: PartialMessage<PuzzleScore>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PuzzleScore";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PuzzleScore;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PuzzleScore;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PuzzleScore;
+
+  static equals(a: PuzzleScore | PlainMessage<PuzzleScore> | undefined, b: PuzzleScore | PlainMessage<PuzzleScore> | undefined): boolean;
+}
+
+/**
+ * PlayerProfile sums up every game a player finished. Games that ended
+ * without a winner are not counted.
+ *
+ * @generated from message pirates.v1.PlayerProfile
+ */
+export declare class PlayerProfile extends Message<PlayerProfile> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: int32 games_played = 3;
+   */
+  gamesPlayed: number;
+
+  /**
+   * @generated from field: int32 wins = 4;
+   */
+  wins: number;
+
+  /**
+   * @generated from field: int32 losses = 5;
+   */
+  losses: number;
+
+  /**
+   * @generated from field: int32 forfeits = 6;
+   */
+  forfeits: number;
+
+  /**
+   * @generated from field: float average_shots_to_win = 7;
+   */
+  averageShotsToWin: number;
+
+  /**
+   * @generated from field: float accuracy = 8;
+   */
+  accuracy: number;
+
+  /**
+   * @generated from field: pirates.v1.PowerType favorite_power = 9;
+   */
+  favoritePower: PowerType;
+
+  /**
+   * @generated from field: int32 current_streak = 10;
+   */
+  currentStreak: number;
+
+  /**
+   * @generated from field: int32 rating = 11;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: repeated pirates.v1.RatingChange rating_history = 12;
+   */
+  ratingHistory: RatingChange[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Achievement achievements = 13;
+   */
+  achievements: Achievement[];
+
+  constructor(data?: PartialMessage<PlayerProfile>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.PlayerProfile";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerProfile;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerProfile;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerProfile;
+
+  static equals(a: PlayerProfile | PlainMessage<PlayerProfile> | undefined, b: PlayerProfile | PlainMessage<PlayerProfile> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.RatingChange
+ */
+export declare class RatingChange extends Message<RatingChange> {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: int32 rating = 2;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: int32 change = 3;
+   */
+  change: number;
+
+  /**
+   * @generated from field: int64 at_unix_ms = 4;
+   */
+  atUnixMs: bigint;
+
+  constructor(data?: PartialMessage<RatingChange>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.RatingChange";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RatingChange;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RatingChange;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RatingChange;
+
+  static equals(a: RatingChange | PlainMessage<RatingChange> | undefined, b: RatingChange | PlainMessage<RatingChange> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.Leaderboard
+ */
+export declare class Leaderboard extends Message<Leaderboard> {
+  /**
+   * @generated from field: pirates.v1.LeaderboardScope scope = 1;
+   */
+  scope: LeaderboardScope;
+
+  /**
+   * @generated from field: pirates.v1.RuleSet rule_set = 2;
+   */
+  ruleSet: RuleSet;
+
+  /**
+   * @generated from field: repeated pirates.v1.LeaderboardEntry entries = 3;
+   */
+  entries: LeaderboardEntry[];
+
+  /**
+   * @generated from field: string next_page_token = 4;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total = 5;
+   */
+  total: number;
+
+  constructor(data?: PartialMessage<Leaderboard>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.Leaderboard";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Leaderboard;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Leaderboard;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Leaderboard;
+
+  static equals(a: Leaderboard | PlainMessage<Leaderboard> | undefined, b: Leaderboard | PlainMessage<Leaderboard> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.LeaderboardEntry
+ */
+export declare class LeaderboardEntry extends Message<LeaderboardEntry> {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: int32 rating = 4;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: int32 points = 5;
+   */
+  points: number;
+
+  /**
+   * @generated from field: int32 wins = 6;
+   */
+  wins: number;
+
+  /**
+   * @generated from field: int32 losses = 7;
+   */
+  losses: number;
+
+  constructor(data?: PartialMessage<LeaderboardEntry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.LeaderboardEntry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaderboardEntry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaderboardEntry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaderboardEntry;
+
+  static equals(a: LeaderboardEntry | PlainMessage<LeaderboardEntry> | undefined, b: LeaderboardEntry | PlainMessage<LeaderboardEntry> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.Achievement
+ */
+export declare class Achievement extends Message<Achievement> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int32 progress = 4;
+   */
+  progress: number;
+
+  /**
+   * @generated from field: int32 goal = 5;
+   */
+  goal: number;
+
+  /**
+   * @generated from field: bool unlocked = 6;
+   */
+  unlocked: boolean;
+
+  /**
+   * @generated from field: int64 unlocked_at_unix_ms = 7;
+   */
+  unlockedAtUnixMs: bigint;
+
+  constructor(data?: PartialMessage<Achievement>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.Achievement";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Achievement;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Achievement;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Achievement;
+
+  static equals(a: Achievement | PlainMessage<Achievement> | undefined, b: Achievement | PlainMessage<Achievement> | undefined): boolean;
+}
+
+/**
+ * FriendList is a player's friends, with their presence, and the players
+ * they blocked.
+ *
+ * @generated from message pirates.v1.FriendList
+ */
+export declare class FriendList extends Message<FriendList> {
+  /**
+   * @generated from field: repeated pirates.v1.Player friends = 1;
+   */
+  friends: Player[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Player incoming_requests = 2;
+   */
+  incomingRequests: Player[];
+
+  /**
+   * @generated from field: repeated pirates.v1.Player outgoing_requests = 3;
+   */
+  outgoingRequests: Player[];
+
+  /**
+   * @generated from field: repeated string blocked_player_ids = 4;
+   */
+  blockedPlayerIds: string[];
+
+  constructor(data?: PartialMessage<FriendList>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.FriendList";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FriendList;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FriendList;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FriendList;
+
+  static equals(a: FriendList | PlainMessage<FriendList> | undefined, b: FriendList | PlainMessage<FriendList> | undefined): boolean;
+}
+
+/**
+ * @generated from message pirates.v1.FriendRequest
+ */
+export declare class FriendRequest extends Message<FriendRequest> {
+  /**
+   * @generated from field: pirates.v1.Player from = 1;
+   */
+  from?: Player;
+
+  constructor(data?: PartialMessage<FriendRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "pirates.v1.FriendRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FriendRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FriendRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FriendRequest;
+
+  static equals(a: FriendRequest | PlainMessage<FriendRequest> | undefined, b: FriendRequest | PlainMessage<FriendRequest> | undefined): boolean;
 }
 
@@ -16,6 +16,31 @@ export const PowerType = /*@__PURE__*/ proto3.makeEnum(
     {no: 2, name: "POWER_TYPE_TRIPLE", localName: "TRIPLE"},
     {no: 3, name: "POWER_TYPE_SONAR", localName: "SONAR"},
     {no: 4, name: "POWER_TYPE_KRAKEN", localName: "KRAKEN"},
+    {no: 5, name: "POWER_TYPE_SHIELD", localName: "SHIELD"},
+    {no: 6, name: "POWER_TYPE_REPAIR", localName: "REPAIR"},
+    {no: 7, name: "POWER_TYPE_DECOY", localName: "DECOY"},
+  ],
+);
+
+/**
+ * Orientation turns a power's pattern, counterclockwise from east in 45°
+ * steps. Patterns are defined facing east; diagonal orientations stretch
+ * them so that lines stay lines of touching cells.
+ *
+ * @generated from enum pirates.v1.Orientation
+ */
+export const Orientation = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.Orientation",
+  [
+    {no: 0, name: "ORIENTATION_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "ORIENTATION_EAST", localName: "EAST"},
+    {no: 2, name: "ORIENTATION_NORTH_EAST", localName: "NORTH_EAST"},
+    {no: 3, name: "ORIENTATION_NORTH", localName: "NORTH"},
+    {no: 4, name: "ORIENTATION_NORTH_WEST", localName: "NORTH_WEST"},
+    {no: 5, name: "ORIENTATION_WEST", localName: "WEST"},
+    {no: 6, name: "ORIENTATION_SOUTH_WEST", localName: "SOUTH_WEST"},
+    {no: 7, name: "ORIENTATION_SOUTH", localName: "SOUTH"},
+    {no: 8, name: "ORIENTATION_SOUTH_EAST", localName: "SOUTH_EAST"},
   ],
 );
 
@@ -31,6 +56,11 @@ export const CellState = /*@__PURE__*/ proto3.makeEnum(
     {no: 3, name: "CELL_STATE_HIT", localName: "HIT"},
     {no: 4, name: "CELL_STATE_SUNK", localName: "SUNK"},
     {no: 5, name: "CELL_STATE_REVEALED", localName: "REVEALED"},
+    {no: 6, name: "CELL_STATE_ISLAND", localName: "ISLAND"},
+    {no: 7, name: "CELL_STATE_MINE", localName: "MINE"},
+    {no: 8, name: "CELL_STATE_SHIELDED", localName: "SHIELDED"},
+    {no: 9, name: "CELL_STATE_REPAIRED", localName: "REPAIRED"},
+    {no: 10, name: "CELL_STATE_DECOY", localName: "DECOY"},
   ],
 );
 
@@ -44,6 +74,120 @@ export const PlayerStatus = /*@__PURE__*/ proto3.makeEnum(
     {no: 1, name: "PLAYER_STATUS_ONLINE", localName: "ONLINE"},
     {no: 2, name: "PLAYER_STATUS_IN_QUEUE", localName: "IN_QUEUE"},
     {no: 3, name: "PLAYER_STATUS_IN_GAME", localName: "IN_GAME"},
+    {no: 4, name: "PLAYER_STATUS_IN_PRIVATE_LOBBY", localName: "IN_PRIVATE_LOBBY"},
+  ],
+);
+
+/**
+ * RuleSet selects the base variant a game is played with.
+ *
+ * @generated from enum pirates.v1.RuleSet
+ */
+export const RuleSet = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.RuleSet",
+  [
+    {no: 0, name: "RULE_SET_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "RULE_SET_CLASSIC", localName: "CLASSIC"},
+    {no: 2, name: "RULE_SET_SALVO", localName: "SALVO"},
+  ],
+);
+
+/**
+ * BonusTurn is the house rule granting the attacker another shot.
+ *
+ * @generated from enum pirates.v1.BonusTurn
+ */
+export const BonusTurn = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.BonusTurn",
+  [
+    {no: 0, name: "BONUS_TURN_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "BONUS_TURN_ON_HIT", localName: "ON_HIT"},
+    {no: 2, name: "BONUS_TURN_ON_SINK", localName: "ON_SINK"},
+  ],
+);
+
+/**
+ * @generated from enum pirates.v1.GameOverReason
+ */
+export const GameOverReason = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.GameOverReason",
+  [
+    {no: 0, name: "GAME_OVER_REASON_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "GAME_OVER_REASON_ALL_SHIPS_SUNK", localName: "ALL_SHIPS_SUNK"},
+    {no: 2, name: "GAME_OVER_REASON_FORFEIT", localName: "FORFEIT"},
+    {no: 3, name: "GAME_OVER_REASON_TURN_TIMEOUT", localName: "TURN_TIMEOUT"},
+    {no: 4, name: "GAME_OVER_REASON_DISCONNECT", localName: "DISCONNECT"},
+    {no: 5, name: "GAME_OVER_REASON_PLACEMENT_TIMEOUT", localName: "PLACEMENT_TIMEOUT"},
+  ],
+);
+
+/**
+ * @generated from enum pirates.v1.ChatScope
+ */
+export const ChatScope = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.ChatScope",
+  [
+    {no: 0, name: "CHAT_SCOPE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "CHAT_SCOPE_GAME", localName: "GAME"},
+    {no: 2, name: "CHAT_SCOPE_LOBBY", localName: "LOBBY"},
+  ],
+);
+
+/**
+ * Preset quick emotes, rendered by the client.
+ *
+ * @generated from enum pirates.v1.QuickEmote
+ */
+export const QuickEmote = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.QuickEmote",
+  [
+    {no: 0, name: "QUICK_EMOTE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "QUICK_EMOTE_AHOY", localName: "AHOY"},
+    {no: 2, name: "QUICK_EMOTE_ARRR", localName: "ARRR"},
+    {no: 3, name: "QUICK_EMOTE_NICE_SHOT", localName: "NICE_SHOT"},
+    {no: 4, name: "QUICK_EMOTE_BLOW_ME_DOWN", localName: "BLOW_ME_DOWN"},
+    {no: 5, name: "QUICK_EMOTE_WALK_THE_PLANK", localName: "WALK_THE_PLANK"},
+    {no: 6, name: "QUICK_EMOTE_GOOD_GAME", localName: "GOOD_GAME"},
+  ],
+);
+
+/**
+ * @generated from enum pirates.v1.TournamentFormat
+ */
+export const TournamentFormat = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.TournamentFormat",
+  [
+    {no: 0, name: "TOURNAMENT_FORMAT_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "TOURNAMENT_FORMAT_SINGLE_ELIMINATION", localName: "SINGLE_ELIMINATION"},
+    {no: 2, name: "TOURNAMENT_FORMAT_DOUBLE_ELIMINATION", localName: "DOUBLE_ELIMINATION"},
+    {no: 3, name: "TOURNAMENT_FORMAT_ROUND_ROBIN", localName: "ROUND_ROBIN"},
+    {no: 4, name: "TOURNAMENT_FORMAT_SWISS", localName: "SWISS"},
+  ],
+);
+
+/**
+ * @generated from enum pirates.v1.TournamentStatus
+ */
+export const TournamentStatus = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.TournamentStatus",
+  [
+    {no: 0, name: "TOURNAMENT_STATUS_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "TOURNAMENT_STATUS_REGISTRATION", localName: "REGISTRATION"},
+    {no: 2, name: "TOURNAMENT_STATUS_RUNNING", localName: "RUNNING"},
+    {no: 3, name: "TOURNAMENT_STATUS_FINISHED", localName: "FINISHED"},
+  ],
+);
+
+/**
+ * @generated from enum pirates.v1.LeaderboardScope
+ */
+export const LeaderboardScope = /*@__PURE__*/ proto3.makeEnum(
+  "pirates.v1.LeaderboardScope",
+  [
+    {no: 0, name: "LEADERBOARD_SCOPE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "LEADERBOARD_SCOPE_GLOBAL", localName: "GLOBAL"},
+    {no: 2, name: "LEADERBOARD_SCOPE_WEEKLY", localName: "WEEKLY"},
+    {no: 3, name: "LEADERBOARD_SCOPE_RULE_SET", localName: "RULE_SET"},
   ],
 );
 
@@ -95,6 +239,44 @@ export const Player = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * GameRules are the options a game is played with. The zero value is the
+ * classic game with powers.
+ *
+ * @generated from message pirates.v1.GameRules
+ */
+export const GameRules = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GameRules",
+  () => [
+    { no: 1, name: "rule_set", kind: "enum", T: proto3.getEnumType(RuleSet) },
+    { no: 2, name: "powers_disabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "salvo_shots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "bonus_turn", kind: "enum", T: proto3.getEnumType(BonusTurn) },
+    { no: 5, name: "max_bonus_turns", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "no_touch", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "terrain", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "defensive_powers", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "teams", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "turn_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "series_length", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "unranked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * Terrain is a terrain game's layout as seen by one player. Islands are the
+ * same on both boards; mines stay hidden from the opponent.
+ *
+ * @generated from message pirates.v1.Terrain
+ */
+export const Terrain = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.Terrain",
+  () => [
+    { no: 1, name: "islands", kind: "message", T: Coordinate, repeated: true },
+    { no: 2, name: "your_mines", kind: "message", T: Coordinate, repeated: true },
+  ],
+);
+
 /**
  * @generated from message pirates.v1.ConnectRequest
  */
@@ -102,6 +284,7 @@ export const ConnectRequest = /*@__PURE__*/ proto3.makeMessageType(
   "pirates.v1.ConnectRequest",
   () => [
     { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resume_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -123,6 +306,8 @@ export const JoinQueueRequest = /*@__PURE__*/ proto3.makeMessageType(
   "pirates.v1.JoinQueueRequest",
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "teams", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "queue", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -144,6 +329,16 @@ export const LeaveQueueResponse = /*@__PURE__*/ proto3.makeMessageType(
   [],
 );
 
+/**
+ * @generated from message pirates.v1.GetQueueStatusRequest
+ */
+export const GetQueueStatusRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GetQueueStatusRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * @generated from message pirates.v1.ListPlayersRequest
  */
@@ -162,6 +357,9 @@ export const ChallengePlayerRequest = /*@__PURE__*/ proto3.makeMessageType(
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "target_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "additional_player_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "ally_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "rules", kind: "message", T: GameRules },
   ],
 );
 
@@ -188,225 +386,1184 @@ export const RespondToMatchRequest = /*@__PURE__*/ proto3.makeMessageType(
 );
 
 /**
- * @generated from message pirates.v1.ForfeitRequest
+ * @generated from message pirates.v1.CreatePrivateLobbyRequest
  */
-export const ForfeitRequest = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.ForfeitRequest",
+export const CreatePrivateLobbyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.CreatePrivateLobbyRequest",
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rules", kind: "message", T: GameRules },
   ],
 );
 
 /**
- * @generated from message pirates.v1.ForfeitResponse
+ * @generated from message pirates.v1.PrivateLobby
  */
-export const ForfeitResponse = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.ForfeitResponse",
+export const PrivateLobby = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PrivateLobby",
+  () => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rules", kind: "message", T: GameRules },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.JoinPrivateLobbyRequest
+ */
+export const JoinPrivateLobbyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.JoinPrivateLobbyRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.JoinPrivateLobbyResponse
+ */
+export const JoinPrivateLobbyResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.JoinPrivateLobbyResponse",
+  () => [
+    { no: 1, name: "match_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ClosePrivateLobbyRequest
+ */
+export const ClosePrivateLobbyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ClosePrivateLobbyRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ClosePrivateLobbyResponse
+ */
+export const ClosePrivateLobbyResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ClosePrivateLobbyResponse",
   [],
 );
 
 /**
- * @generated from message pirates.v1.PlaceShipsRequest
+ * Creates a party led by the inviter if they are not in one yet. Only the
+ * party's leader invites.
+ *
+ * @generated from message pirates.v1.InviteToPartyRequest
  */
-export const PlaceShipsRequest = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.PlaceShipsRequest",
+export const InviteToPartyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.InviteToPartyRequest",
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "ships", kind: "message", T: Ship, repeated: true },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.AttackRequest
+ * @generated from message pirates.v1.RespondToPartyInviteRequest
  */
-export const AttackRequest = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.AttackRequest",
+export const RespondToPartyInviteRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.RespondToPartyInviteRequest",
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "target", kind: "message", T: Coordinate },
+    { no: 2, name: "party_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "accepted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.UsePowerRequest
+ * @generated from message pirates.v1.LeavePartyRequest
  */
-export const UsePowerRequest = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.UsePowerRequest",
+export const LeavePartyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.LeavePartyRequest",
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "power", kind: "enum", T: proto3.getEnumType(PowerType) },
-    { no: 3, name: "target", kind: "message", T: Coordinate },
-    { no: 4, name: "horizontal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.SubscribeEventsRequest
+ * @generated from message pirates.v1.LeavePartyResponse
  */
-export const SubscribeEventsRequest = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.SubscribeEventsRequest",
+export const LeavePartyResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.LeavePartyResponse",
+  [],
+);
+
+/**
+ * The organizer does not play unless they register.
+ *
+ * @generated from message pirates.v1.CreateTournamentRequest
+ */
+export const CreateTournamentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.CreateTournamentRequest",
   () => [
     { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "format", kind: "enum", T: proto3.getEnumType(TournamentFormat) },
+    { no: 4, name: "rules", kind: "message", T: GameRules },
   ],
 );
 
 /**
- * @generated from message pirates.v1.QueueStatusUpdate
+ * @generated from message pirates.v1.ListTournamentsRequest
  */
-export const QueueStatusUpdate = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.QueueStatusUpdate",
+export const ListTournamentsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ListTournamentsRequest",
   () => [
-    { no: 1, name: "in_queue", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "queue_position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "players_in_queue", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.PlayerListUpdate
+ * @generated from message pirates.v1.ListTournamentsResponse
  */
-export const PlayerListUpdate = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.PlayerListUpdate",
+export const ListTournamentsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ListTournamentsResponse",
   () => [
-    { no: 1, name: "available_players", kind: "message", T: Player, repeated: true },
+    { no: 1, name: "tournaments", kind: "message", T: Tournament, repeated: true },
   ],
 );
 
 /**
- * @generated from message pirates.v1.MatchProposal
+ * @generated from message pirates.v1.RegisterForTournamentRequest
  */
-export const MatchProposal = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.MatchProposal",
+export const RegisterForTournamentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.RegisterForTournamentRequest",
   () => [
-    { no: 1, name: "match_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "opponent", kind: "message", T: Player },
-    { no: 3, name: "you_initiated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tournament_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.MatchResult
+ * Only open while the tournament has not started.
+ *
+ * @generated from message pirates.v1.WithdrawFromTournamentRequest
  */
-export const MatchResult = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.MatchResult",
+export const WithdrawFromTournamentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.WithdrawFromTournamentRequest",
   () => [
-    { no: 1, name: "match_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "accepted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "rejection_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tournament_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.GameStarted
+ * Only the organizer starts the tournament, with at least two players.
+ *
+ * @generated from message pirates.v1.StartTournamentRequest
  */
-export const GameStarted = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.GameStarted",
+export const StartTournamentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.StartTournamentRequest",
   () => [
-    { no: 1, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "opponent", kind: "message", T: Player },
-    { no: 3, name: "your_turn_first", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tournament_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.PlacementResult
+ * @generated from message pirates.v1.GetTournamentRequest
  */
-export const PlacementResult = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.PlacementResult",
+export const GetTournamentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GetTournamentRequest",
   () => [
-    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "waiting_for_opponent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tournament_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.TurnStarted
+ * Starts the day's puzzle, or resumes it: each player gets one attempt a
+ * day.
+ *
+ * @generated from message pirates.v1.StartDailyPuzzleRequest
  */
-export const TurnStarted = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.TurnStarted",
+export const StartDailyPuzzleRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.StartDailyPuzzleRequest",
   () => [
-    { no: 1, name: "your_turn", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "available_powers", kind: "message", T: Power, repeated: true },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.AttackResult
+ * Fires at the puzzle last started.
+ *
+ * @generated from message pirates.v1.PuzzleAttackRequest
  */
-export const AttackResult = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.AttackResult",
+export const PuzzleAttackRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PuzzleAttackRequest",
   () => [
-    { no: 1, name: "target", kind: "message", T: Coordinate },
-    { no: 2, name: "hit", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "sunk_ship", kind: "message", T: Ship },
-    { no: 4, name: "power_gained", kind: "message", T: Power },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target", kind: "message", T: Coordinate },
   ],
 );
 
 /**
- * @generated from message pirates.v1.CellReveal
+ * @generated from message pirates.v1.PuzzleUsePowerRequest
  */
-export const CellReveal = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.CellReveal",
+export const PuzzleUsePowerRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PuzzleUsePowerRequest",
   () => [
-    { no: 1, name: "position", kind: "message", T: Coordinate },
-    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(CellState) },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "power", kind: "enum", T: proto3.getEnumType(PowerType) },
+    { no: 3, name: "target", kind: "message", T: Coordinate },
+    { no: 4, name: "orientation", kind: "enum", T: proto3.getEnumType(Orientation) },
   ],
 );
 
 /**
- * @generated from message pirates.v1.PowerResult
+ * @generated from message pirates.v1.GetPuzzleLeaderboardRequest
  */
-export const PowerResult = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.PowerResult",
+export const GetPuzzleLeaderboardRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GetPuzzleLeaderboardRequest",
   () => [
-    { no: 1, name: "power_used", kind: "enum", T: proto3.getEnumType(PowerType) },
-    { no: 2, name: "cells_affected", kind: "message", T: CellReveal, repeated: true },
-    { no: 3, name: "sunk_ships", kind: "message", T: Ship, repeated: true },
-    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.OpponentAction
+ * @generated from message pirates.v1.GetPlayerProfileRequest
  */
-export const OpponentAction = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.OpponentAction",
+export const GetPlayerProfileRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GetPlayerProfileRequest",
   () => [
-    { no: 1, name: "attack", kind: "message", T: AttackResult, oneof: "action" },
-    { no: 2, name: "power", kind: "message", T: PowerResult, oneof: "action" },
-    { no: 3, name: "your_grid_updates", kind: "message", T: CellReveal, repeated: true },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.GameOver
+ * @generated from message pirates.v1.GetLeaderboardRequest
  */
-export const GameOver = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.GameOver",
+export const GetLeaderboardRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GetLeaderboardRequest",
   () => [
-    { no: 1, name: "you_won", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scope", kind: "enum", T: proto3.getEnumType(LeaderboardScope) },
+    { no: 3, name: "rule_set", kind: "enum", T: proto3.getEnumType(RuleSet) },
+    { no: 4, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
 /**
- * @generated from message pirates.v1.GameEvent
+ * Either player_id or display_name must be set.
+ *
+ * @generated from message pirates.v1.SendFriendRequestRequest
  */
-export const GameEvent = /*@__PURE__*/ proto3.makeMessageType(
-  "pirates.v1.GameEvent",
+export const SendFriendRequestRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.SendFriendRequestRequest",
   () => [
-    { no: 1, name: "queue_status", kind: "message", T: QueueStatusUpdate, oneof: "event" },
-    { no: 2, name: "player_list", kind: "message", T: PlayerListUpdate, oneof: "event" },
-    { no: 3, name: "match_proposal", kind: "message", T: MatchProposal, oneof: "event" },
-    { no: 4, name: "match_result", kind: "message", T: MatchResult, oneof: "event" },
-    { no: 5, name: "game_started", kind: "message", T: GameStarted, oneof: "event" },
-    { no: 6, name: "turn_started", kind: "message", T: TurnStarted, oneof: "event" },
-    { no: 7, name: "opponent_action", kind: "message", T: OpponentAction, oneof: "event" },
-    { no: 8, name: "game_over", kind: "message", T: GameOver, oneof: "event" },
-    { no: 9, name: "placement_update", kind: "message", T: PlacementResult, oneof: "event" },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.RespondToFriendRequestRequest
+ */
+export const RespondToFriendRequestRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.RespondToFriendRequestRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "accepted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.RemoveFriendRequest
+ */
+export const RemoveFriendRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.RemoveFriendRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.BlockPlayerRequest
+ */
+export const BlockPlayerRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.BlockPlayerRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.UnblockPlayerRequest
+ */
+export const UnblockPlayerRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.UnblockPlayerRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.GetFriendsRequest
+ */
+export const GetFriendsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GetFriendsRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ChallengeFriendRequest
+ */
+export const ChallengeFriendRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ChallengeFriendRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rules", kind: "message", T: GameRules },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ChallengeFriendResponse
+ */
+export const ChallengeFriendResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ChallengeFriendResponse",
+  () => [
+    { no: 1, name: "match_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "queued", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ForfeitRequest
+ */
+export const ForfeitRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ForfeitRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ForfeitResponse
+ */
+export const ForfeitResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ForfeitResponse",
+  [],
+);
+
+/**
+ * @generated from message pirates.v1.PlaceShipsRequest
+ */
+export const PlaceShipsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlaceShipsRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ships", kind: "message", T: Ship, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.AttackRequest
+ */
+export const AttackRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.AttackRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target", kind: "message", T: Coordinate },
+    { no: 3, name: "target_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.AttackSalvoRequest
+ */
+export const AttackSalvoRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.AttackSalvoRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "targets", kind: "message", T: Coordinate, repeated: true },
+    { no: 3, name: "target_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.UsePowerRequest
+ */
+export const UsePowerRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.UsePowerRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "power", kind: "enum", T: proto3.getEnumType(PowerType) },
+    { no: 3, name: "target", kind: "message", T: Coordinate },
+    { no: 4, name: "horizontal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "orientation", kind: "enum", T: proto3.getEnumType(Orientation) },
+    { no: 6, name: "target_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PreviewPowerRequest
+ */
+export const PreviewPowerRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PreviewPowerRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "power", kind: "enum", T: proto3.getEnumType(PowerType) },
+    { no: 3, name: "target", kind: "message", T: Coordinate },
+    { no: 4, name: "horizontal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "orientation", kind: "enum", T: proto3.getEnumType(Orientation) },
+    { no: 6, name: "target_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * PowerPreview lists the cells a power would touch, without their contents.
+ *
+ * @generated from message pirates.v1.PowerPreview
+ */
+export const PowerPreview = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PowerPreview",
+  () => [
+    { no: 1, name: "cells", kind: "message", T: Coordinate, repeated: true },
+    { no: 2, name: "own_grid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * Either text or emote must be set.
+ *
+ * @generated from message pirates.v1.SendChatMessageRequest
+ */
+export const SendChatMessageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.SendChatMessageRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scope", kind: "enum", T: proto3.getEnumType(ChatScope) },
+    { no: 3, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "emote", kind: "enum", T: proto3.getEnumType(QuickEmote) },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.SendChatMessageResponse
+ */
+export const SendChatMessageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.SendChatMessageResponse",
+  () => [
+    { no: 1, name: "message", kind: "message", T: ChatMessage },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.MutePlayerRequest
+ */
+export const MutePlayerRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.MutePlayerRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "muted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.MutePlayerResponse
+ */
+export const MutePlayerResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.MutePlayerResponse",
+  [],
+);
+
+/**
+ * @generated from message pirates.v1.SubscribeEventsRequest
+ */
+export const SubscribeEventsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.SubscribeEventsRequest",
+  () => [
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.QueueStatusUpdate
+ */
+export const QueueStatusUpdate = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.QueueStatusUpdate",
+  () => [
+    { no: 1, name: "in_queue", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "queue_position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "players_in_queue", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "queue", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "queues", kind: "message", T: QueueInfo, repeated: true },
+  ],
+);
+
+/**
+ * QueueInfo describes a matchmaking queue. Its matches are played with its
+ * rules.
+ *
+ * @generated from message pirates.v1.QueueInfo
+ */
+export const QueueInfo = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.QueueInfo",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rules", kind: "message", T: GameRules },
+    { no: 3, name: "rating_matched", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "players", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "estimated_wait_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PlayerListUpdate
+ */
+export const PlayerListUpdate = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlayerListUpdate",
+  () => [
+    { no: 1, name: "available_players", kind: "message", T: Player, repeated: true },
+    { no: 2, name: "changed_players", kind: "message", T: Player, repeated: true },
+    { no: 3, name: "departed_player_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.MatchProposal
+ */
+export const MatchProposal = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.MatchProposal",
+  () => [
+    { no: 1, name: "match_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "opponent", kind: "message", T: Player },
+    { no: 3, name: "you_initiated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "rules", kind: "message", T: GameRules },
+    { no: 6, name: "opponents", kind: "message", T: Player, repeated: true },
+    { no: 7, name: "allies", kind: "message", T: Player, repeated: true },
+    { no: 8, name: "tournament_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.MatchResult
+ */
+export const MatchResult = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.MatchResult",
+  () => [
+    { no: 1, name: "match_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "accepted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "rejection_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.GameStarted
+ */
+export const GameStarted = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GameStarted",
+  () => [
+    { no: 1, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "opponent", kind: "message", T: Player },
+    { no: 3, name: "your_turn_first", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "rules", kind: "message", T: GameRules },
+    { no: 5, name: "terrain", kind: "message", T: Terrain },
+    { no: 6, name: "opponents", kind: "message", T: Player, repeated: true },
+    { no: 7, name: "allies", kind: "message", T: Player, repeated: true },
+    { no: 8, name: "series", kind: "message", T: Series },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PlacementResult
+ */
+export const PlacementResult = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlacementResult",
+  () => [
+    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "waiting_for_opponent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.TurnStarted
+ */
+export const TurnStarted = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.TurnStarted",
+  () => [
+    { no: 1, name: "your_turn", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "available_powers", kind: "message", T: Power, repeated: true },
+    { no: 3, name: "salvo_shots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "bonus_turn", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "view", kind: "message", T: GameView },
+    { no: 6, name: "current_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "eliminated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "deadline_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.AttackResult
+ */
+export const AttackResult = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.AttackResult",
+  () => [
+    { no: 1, name: "target", kind: "message", T: Coordinate },
+    { no: 2, name: "hit", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "sunk_ship", kind: "message", T: Ship },
+    { no: 4, name: "power_gained", kind: "message", T: Power },
+    { no: 5, name: "island", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "mine", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "mine_blast", kind: "message", T: AttackResult },
+    { no: 8, name: "shielded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * SalvoResult is the outcome of every shot of a salvo, resolved together.
+ *
+ * @generated from message pirates.v1.SalvoResult
+ */
+export const SalvoResult = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.SalvoResult",
+  () => [
+    { no: 1, name: "shots", kind: "message", T: AttackResult, repeated: true },
+    { no: 2, name: "sunk_ships", kind: "message", T: Ship, repeated: true },
+    { no: 3, name: "powers_gained", kind: "message", T: Power, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.CellReveal
+ */
+export const CellReveal = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.CellReveal",
+  () => [
+    { no: 1, name: "position", kind: "message", T: Coordinate },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(CellState) },
+  ],
+);
+
+/**
+ * BoardView is one board as a player is allowed to see it. Cells nothing is
+ * known about are left out.
+ *
+ * @generated from message pirates.v1.BoardView
+ */
+export const BoardView = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.BoardView",
+  () => [
+    { no: 1, name: "cells", kind: "message", T: CellReveal, repeated: true },
+    { no: 2, name: "ships", kind: "message", T: Ship, repeated: true },
+  ],
+);
+
+/**
+ * GameView is everything a player may know about the boards.
+ *
+ * @generated from message pirates.v1.GameView
+ */
+export const GameView = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GameView",
+  () => [
+    { no: 1, name: "your_board", kind: "message", T: BoardView },
+    { no: 2, name: "opponent_board", kind: "message", T: BoardView },
+    { no: 3, name: "opponents", kind: "message", T: PlayerBoard, repeated: true },
+    { no: 4, name: "allies", kind: "message", T: PlayerBoard, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PlayerBoard
+ */
+export const PlayerBoard = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlayerBoard",
+  () => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "board", kind: "message", T: BoardView },
+    { no: 3, name: "eliminated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PowerResult
+ */
+export const PowerResult = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PowerResult",
+  () => [
+    { no: 1, name: "power_used", kind: "enum", T: proto3.getEnumType(PowerType) },
+    { no: 2, name: "cells_affected", kind: "message", T: CellReveal, repeated: true },
+    { no: 3, name: "sunk_ships", kind: "message", T: Ship, repeated: true },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "mine_blasts", kind: "message", T: AttackResult, repeated: true },
+    { no: 6, name: "own_grid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "powers_gained", kind: "message", T: Power, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.OpponentAction
+ */
+export const OpponentAction = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.OpponentAction",
+  () => [
+    { no: 1, name: "attack", kind: "message", T: AttackResult, oneof: "action" },
+    { no: 2, name: "power", kind: "message", T: PowerResult, oneof: "action" },
+    { no: 4, name: "salvo", kind: "message", T: SalvoResult, oneof: "action" },
+    { no: 3, name: "your_grid_updates", kind: "message", T: CellReveal, repeated: true },
+    { no: 5, name: "attacker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "target_player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.FleetShip
+ */
+export const FleetShip = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.FleetShip",
+  () => [
+    { no: 1, name: "ship", kind: "message", T: Ship },
+    { no: 2, name: "hits", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "sunk", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PlayerGameStats
+ */
+export const PlayerGameStats = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlayerGameStats",
+  () => [
+    { no: 1, name: "shots_fired", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "hits", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "accuracy", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "powers_used", kind: "enum", T: proto3.getEnumType(PowerType), repeated: true },
+    { no: 5, name: "ships_sunk", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.GameSummary
+ */
+export const GameSummary = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GameSummary",
+  () => [
+    { no: 1, name: "your_fleet", kind: "message", T: FleetShip, repeated: true },
+    { no: 2, name: "opponent_fleet", kind: "message", T: FleetShip, repeated: true },
+    { no: 3, name: "your_stats", kind: "message", T: PlayerGameStats },
+    { no: 4, name: "opponent_stats", kind: "message", T: PlayerGameStats },
+    { no: 5, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "rating_change", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "opponent_decoys", kind: "message", T: Coordinate, repeated: true },
+    { no: 8, name: "opponents", kind: "message", T: PlayerSummary, repeated: true },
+    { no: 9, name: "allies", kind: "message", T: PlayerSummary, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PlayerSummary
+ */
+export const PlayerSummary = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlayerSummary",
+  () => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "fleet", kind: "message", T: FleetShip, repeated: true },
+    { no: 3, name: "stats", kind: "message", T: PlayerGameStats },
+    { no: 4, name: "decoys", kind: "message", T: Coordinate, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.GameOver
+ */
+export const GameOver = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GameOver",
+  () => [
+    { no: 1, name: "you_won", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "reason", kind: "enum", T: proto3.getEnumType(GameOverReason) },
+    { no: 4, name: "summary", kind: "message", T: GameSummary },
+    { no: 5, name: "winner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "series", kind: "message", T: Series },
+  ],
+);
+
+/**
+ * Series is the score of a match played as a series of games. The next
+ * game starts right after the previous one until a side wins most games.
+ *
+ * @generated from message pirates.v1.Series
+ */
+export const Series = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.Series",
+  () => [
+    { no: 1, name: "length", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "game_number", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "scores", kind: "message", T: SeriesScore, repeated: true },
+    { no: 4, name: "over", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "winner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.SeriesScore
+ */
+export const SeriesScore = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.SeriesScore",
+  () => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "wins", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.OpponentDisconnected
+ */
+export const OpponentDisconnected = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.OpponentDisconnected",
+  () => [
+    { no: 1, name: "grace_period_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.OpponentReconnected
+ */
+export const OpponentReconnected = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.OpponentReconnected",
+  [],
+);
+
+/**
+ * PlayerEliminated is sent to every player of a game when one of them is
+ * out. The game goes on while more than one player is left; eliminated
+ * players keep receiving its events as spectators.
+ *
+ * @generated from message pirates.v1.PlayerEliminated
+ */
+export const PlayerEliminated = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlayerEliminated",
+  () => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "enum", T: proto3.getEnumType(GameOverReason) },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.ChatMessage
+ */
+export const ChatMessage = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.ChatMessage",
+  () => [
+    { no: 1, name: "sender_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sender_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "scope", kind: "enum", T: proto3.getEnumType(ChatScope) },
+    { no: 4, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "emote", kind: "enum", T: proto3.getEnumType(QuickEmote) },
+    { no: 6, name: "sent_at_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.GameEvent
+ */
+export const GameEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.GameEvent",
+  () => [
+    { no: 1, name: "queue_status", kind: "message", T: QueueStatusUpdate, oneof: "event" },
+    { no: 2, name: "player_list", kind: "message", T: PlayerListUpdate, oneof: "event" },
+    { no: 3, name: "match_proposal", kind: "message", T: MatchProposal, oneof: "event" },
+    { no: 4, name: "match_result", kind: "message", T: MatchResult, oneof: "event" },
+    { no: 5, name: "game_started", kind: "message", T: GameStarted, oneof: "event" },
+    { no: 6, name: "turn_started", kind: "message", T: TurnStarted, oneof: "event" },
+    { no: 7, name: "opponent_action", kind: "message", T: OpponentAction, oneof: "event" },
+    { no: 8, name: "game_over", kind: "message", T: GameOver, oneof: "event" },
+    { no: 9, name: "placement_update", kind: "message", T: PlacementResult, oneof: "event" },
+    { no: 10, name: "opponent_disconnected", kind: "message", T: OpponentDisconnected, oneof: "event" },
+    { no: 11, name: "opponent_reconnected", kind: "message", T: OpponentReconnected, oneof: "event" },
+    { no: 12, name: "chat_message", kind: "message", T: ChatMessage, oneof: "event" },
+    { no: 13, name: "player_eliminated", kind: "message", T: PlayerEliminated, oneof: "event" },
+    { no: 14, name: "party_invite", kind: "message", T: PartyInvite, oneof: "event" },
+    { no: 15, name: "party_update", kind: "message", T: Party, oneof: "event" },
+    { no: 16, name: "party_disbanded", kind: "message", T: PartyDisbanded, oneof: "event" },
+    { no: 17, name: "tournament_update", kind: "message", T: TournamentBracket, oneof: "event" },
+    { no: 18, name: "achievement_unlocked", kind: "message", T: Achievement, oneof: "event" },
+    { no: 19, name: "friend_request", kind: "message", T: FriendRequest, oneof: "event" },
+    { no: 20, name: "friend_list", kind: "message", T: FriendList, oneof: "event" },
+  ],
+);
+
+/**
+ * Party is a group of players who queue together: as one team for 2v2
+ * games, or never against each other in one-on-one games.
+ *
+ * @generated from message pirates.v1.Party
+ */
+export const Party = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.Party",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "leader_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "members", kind: "message", T: Player, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PartyInvite
+ */
+export const PartyInvite = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PartyInvite",
+  () => [
+    { no: 1, name: "party", kind: "message", T: Party },
+    { no: 2, name: "inviter", kind: "message", T: Player },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PartyDisbanded
+ */
+export const PartyDisbanded = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PartyDisbanded",
+  () => [
+    { no: 1, name: "party_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.Tournament
+ */
+export const Tournament = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.Tournament",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "organizer_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "format", kind: "enum", T: proto3.getEnumType(TournamentFormat) },
+    { no: 5, name: "rules", kind: "message", T: GameRules },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(TournamentStatus) },
+    { no: 7, name: "player_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "current_round", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "winner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * TournamentMatch is a match of a round. A match without a second player
+ * is a bye, won by the first.
+ *
+ * @generated from message pirates.v1.TournamentMatch
+ */
+export const TournamentMatch = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.TournamentMatch",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "round", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "player1_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "player2_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "finished", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "winner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "no_show", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "deadline_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.TournamentStanding
+ */
+export const TournamentStanding = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.TournamentStanding",
+  () => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "wins", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "losses", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "eliminated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.TournamentBracket
+ */
+export const TournamentBracket = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.TournamentBracket",
+  () => [
+    { no: 1, name: "tournament", kind: "message", T: Tournament },
+    { no: 2, name: "matches", kind: "message", T: TournamentMatch, repeated: true },
+    { no: 3, name: "standings", kind: "message", T: TournamentStanding, repeated: true },
+  ],
+);
+
+/**
+ * DailyPuzzle is a player's attempt at the day's puzzle. The hidden fleet
+ * never reaches the client: the board only shows the shots fired and the
+ * ships sunk.
+ *
+ * @generated from message pirates.v1.DailyPuzzle
+ */
+export const DailyPuzzle = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.DailyPuzzle",
+  () => [
+    { no: 1, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "available_powers", kind: "message", T: Power, repeated: true },
+    { no: 3, name: "board", kind: "message", T: BoardView },
+    { no: 4, name: "shots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "solved", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PuzzleShotResult
+ */
+export const PuzzleShotResult = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PuzzleShotResult",
+  () => [
+    { no: 1, name: "attack", kind: "message", T: AttackResult, oneof: "action" },
+    { no: 2, name: "power", kind: "message", T: PowerResult, oneof: "action" },
+    { no: 3, name: "puzzle", kind: "message", T: DailyPuzzle },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PuzzleLeaderboard
+ */
+export const PuzzleLeaderboard = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PuzzleLeaderboard",
+  () => [
+    { no: 1, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scores", kind: "message", T: PuzzleScore, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.PuzzleScore
+ */
+export const PuzzleScore = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PuzzleScore",
+  () => [
+    { no: 1, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "shots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * PlayerProfile sums up every game a player finished. Games that ended
+ * without a winner are not counted.
+ *
+ * @generated from message pirates.v1.PlayerProfile
+ */
+export const PlayerProfile = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.PlayerProfile",
+  () => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "games_played", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "wins", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "losses", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "forfeits", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "average_shots_to_win", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 8, name: "accuracy", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 9, name: "favorite_power", kind: "enum", T: proto3.getEnumType(PowerType) },
+    { no: 10, name: "current_streak", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "rating_history", kind: "message", T: RatingChange, repeated: true },
+    { no: 13, name: "achievements", kind: "message", T: Achievement, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.RatingChange
+ */
+export const RatingChange = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.RatingChange",
+  () => [
+    { no: 1, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "change", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "at_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.Leaderboard
+ */
+export const Leaderboard = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.Leaderboard",
+  () => [
+    { no: 1, name: "scope", kind: "enum", T: proto3.getEnumType(LeaderboardScope) },
+    { no: 2, name: "rule_set", kind: "enum", T: proto3.getEnumType(RuleSet) },
+    { no: 3, name: "entries", kind: "message", T: LeaderboardEntry, repeated: true },
+    { no: 4, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.LeaderboardEntry
+ */
+export const LeaderboardEntry = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.LeaderboardEntry",
+  () => [
+    { no: 1, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "wins", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "losses", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.Achievement
+ */
+export const Achievement = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.Achievement",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "progress", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "goal", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "unlocked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "unlocked_at_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * FriendList is a player's friends, with their presence, and the players
+ * they blocked.
+ *
+ * @generated from message pirates.v1.FriendList
+ */
+export const FriendList = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.FriendList",
+  () => [
+    { no: 1, name: "friends", kind: "message", T: Player, repeated: true },
+    { no: 2, name: "incoming_requests", kind: "message", T: Player, repeated: true },
+    { no: 3, name: "outgoing_requests", kind: "message", T: Player, repeated: true },
+    { no: 4, name: "blocked_player_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message pirates.v1.FriendRequest
+ */
+export const FriendRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "pirates.v1.FriendRequest",
+  () => [
+    { no: 1, name: "from", kind: "message", T: Player },
   ],
 );
 
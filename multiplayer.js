@@ -8,14 +8,27 @@ import {
     ListPlayersRequest,
     ChallengePlayerRequest,
     RespondToMatchRequest,
+    CreatePrivateLobbyRequest,
+    JoinPrivateLobbyRequest,
+    ClosePrivateLobbyRequest,
+    GameRules,
+    RuleSet,
+    BonusTurn,
     PlaceShipsRequest,
     AttackRequest,
+    AttackSalvoRequest,
     UsePowerRequest,
+    PreviewPowerRequest,
     ForfeitRequest,
+    SendChatMessageRequest,
+    MutePlayerRequest,
     SubscribeEventsRequest,
     Ship,
     Coordinate,
     PowerType,
+    Orientation,
+    ChatScope,
+    QuickEmote,
 } from "./gen/pirates/v1/pirates_pb.js";
 
 class MultiplayerClient {
@@ -111,6 +124,15 @@ class MultiplayerClient {
             case 'placementUpdate':
                 this.emit('placementUpdate', e.value);
                 break;
+            case 'opponentDisconnected':
+                this.emit('opponentDisconnected', e.value);
+                break;
+            case 'opponentReconnected':
+                this.emit('opponentReconnected', e.value);
+                break;
+            case 'chatMessage':
+                this.emit('chatMessage', e.value);
+                break;
         }
     }
 
@@ -133,8 +155,8 @@ class MultiplayerClient {
         }
     }
 
-    async joinQueue() {
-        const request = new JoinQueueRequest({ sessionToken: this.sessionToken });
+    async joinQueue(queue = '') {
+        const request = new JoinQueueRequest({ sessionToken: this.sessionToken, queue });
         return await this.client.joinQueue(request);
     }
 
@@ -158,6 +180,24 @@ class MultiplayerClient {
         return await this.client.respondToMatch(request);
     }
 
+    async createPrivateLobby({ ruleSet = RuleSet.CLASSIC, powersDisabled = false, bonusTurn = BonusTurn.UNSPECIFIED, noTouch = false, terrain = false, defensivePowers = false } = {}) {
+        const request = new CreatePrivateLobbyRequest({
+            sessionToken: this.sessionToken,
+            rules: new GameRules({ ruleSet, powersDisabled, bonusTurn, noTouch, terrain, defensivePowers }),
+        });
+        return await this.client.createPrivateLobby(request);
+    }
+
+    async joinPrivateLobby(code) {
+        const request = new JoinPrivateLobbyRequest({ sessionToken: this.sessionToken, code });
+        return await this.client.joinPrivateLobby(request);
+    }
+
+    async closePrivateLobby() {
+        const request = new ClosePrivateLobbyRequest({ sessionToken: this.sessionToken });
+        return await this.client.closePrivateLobby(request);
+    }
+
     async placeShips(ships) {
         const protoShips = ships.map(ship => new Ship({
             id: String(ship.id),
@@ -179,23 +219,55 @@ class MultiplayerClient {
         return await this.client.attack(request);
     }
 
-    async usePower(powerType, x, y, horizontal = true) {
+    async attackSalvo(targets) {
+        const request = new AttackSalvoRequest({
+            sessionToken: this.sessionToken,
+            targets: targets.map(({ x, y }) => new Coordinate({ x, y })),
+        });
+        return await this.client.attackSalvo(request);
+    }
+
+    async usePower(powerType, x, y, direction = 'horizontal') {
         const protoType = this.mapPowerType(powerType);
         const request = new UsePowerRequest({
             sessionToken: this.sessionToken,
             power: protoType,
             target: new Coordinate({ x, y }),
-            horizontal,
+            orientation: this.mapOrientation(direction),
         });
         return await this.client.usePower(request);
     }
 
+    // Returns the cells the power would touch, without using it.
+    async previewPower(powerType, x, y, direction = 'horizontal') {
+        const request = new PreviewPowerRequest({
+            sessionToken: this.sessionToken,
+            power: this.mapPowerType(powerType),
+            target: new Coordinate({ x, y }),
+            orientation: this.mapOrientation(direction),
+        });
+        return await this.client.previewPower(request);
+    }
+
+    mapOrientation(direction) {
+        const mapping = {
+            'horizontal': Orientation.EAST,
+            'vertical': Orientation.SOUTH,
+            'diagonal-up': Orientation.NORTH_EAST,
+            'diagonal-down': Orientation.SOUTH_EAST,
+        };
+        return mapping[direction] || Orientation.EAST;
+    }
+
     mapPowerType(localPower) {
         const mapping = {
             'instakill': PowerType.INSTAKILL,
             'triple': PowerType.TRIPLE,
             'sonar': PowerType.SONAR,
             'kraken': PowerType.KRAKEN,
+            'shield': PowerType.SHIELD,
+            'repair': PowerType.REPAIR,
+            'decoy': PowerType.DECOY,
         };
         return mapping[localPower] || PowerType.UNSPECIFIED;
     }
@@ -215,6 +287,16 @@ class MultiplayerClient {
         return await this.client.forfeit(request);
     }
 
+    async sendChatMessage(scope, text, emote = QuickEmote.UNSPECIFIED) {
+        const request = new SendChatMessageRequest({ sessionToken: this.sessionToken, scope, text, emote });
+        return await this.client.sendChatMessage(request);
+    }
+
+    async mutePlayer(playerId, muted) {
+        const request = new MutePlayerRequest({ sessionToken: this.sessionToken, playerId, muted });
+        return await this.client.mutePlayer(request);
+    }
+
     disconnect() {
         if (this.abortController) {
             this.abortController.abort();
@@ -228,5 +310,7 @@ class MultiplayerClient {
 
 window.MultiplayerClient = MultiplayerClient;
 window.PowerType = PowerType;
+window.ChatScope = ChatScope;
+window.QuickEmote = QuickEmote;
 
-export { MultiplayerClient, PowerType };
+export { MultiplayerClient, PowerType, ChatScope, QuickEmote };
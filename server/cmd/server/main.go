@@ -19,7 +19,11 @@ func main() {
 		port = "8080"
 	}
 
-	server := transport.NewPiratesServer()
+	var opts []transport.Option
+	if secret := os.Getenv("PUZZLE_SECRET"); secret != "" {
+		opts = append(opts, transport.WithPuzzleSecret(secret))
+	}
+	server := transport.NewPiratesServer(opts...)
 
 	mux := http.NewServeMux()
 
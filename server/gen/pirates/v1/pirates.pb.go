@@ -29,6 +29,9 @@ const (
 	PowerType_POWER_TYPE_TRIPLE      PowerType = 2
 	PowerType_POWER_TYPE_SONAR       PowerType = 3
 	PowerType_POWER_TYPE_KRAKEN      PowerType = 4
+	PowerType_POWER_TYPE_SHIELD      PowerType = 5 // Defensive: protects a 3x3 area of your grid
+	PowerType_POWER_TYPE_REPAIR      PowerType = 6 // Defensive: restores one hit cell of your fleet
+	PowerType_POWER_TYPE_DECOY       PowerType = 7 // Defensive: a fake one-cell ship on your grid
 )
 
 // Enum value maps for PowerType.
@@ -39,6 +42,9 @@ var (
 		2: "POWER_TYPE_TRIPLE",
 		3: "POWER_TYPE_SONAR",
 		4: "POWER_TYPE_KRAKEN",
+		5: "POWER_TYPE_SHIELD",
+		6: "POWER_TYPE_REPAIR",
+		7: "POWER_TYPE_DECOY",
 	}
 	PowerType_value = map[string]int32{
 		"POWER_TYPE_UNSPECIFIED": 0,
@@ -46,6 +52,9 @@ var (
 		"POWER_TYPE_TRIPLE":      2,
 		"POWER_TYPE_SONAR":       3,
 		"POWER_TYPE_KRAKEN":      4,
+		"POWER_TYPE_SHIELD":      5,
+		"POWER_TYPE_REPAIR":      6,
+		"POWER_TYPE_DECOY":       7,
 	}
 )
 
@@ -76,6 +85,76 @@ func (PowerType) EnumDescriptor() ([]byte, []int) {
 	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{0}
 }
 
+// Orientation turns a power's pattern, counterclockwise from east in 45°
+// steps. Patterns are defined facing east; diagonal orientations stretch
+// them so that lines stay lines of touching cells.
+type Orientation int32
+
+const (
+	Orientation_ORIENTATION_UNSPECIFIED Orientation = 0 // Follows the legacy horizontal flag
+	Orientation_ORIENTATION_EAST        Orientation = 1 // 0°, horizontal
+	Orientation_ORIENTATION_NORTH_EAST  Orientation = 2 // 45°
+	Orientation_ORIENTATION_NORTH       Orientation = 3 // 90°
+	Orientation_ORIENTATION_NORTH_WEST  Orientation = 4 // 135°
+	Orientation_ORIENTATION_WEST        Orientation = 5 // 180°
+	Orientation_ORIENTATION_SOUTH_WEST  Orientation = 6 // 225°
+	Orientation_ORIENTATION_SOUTH       Orientation = 7 // 270°, vertical
+	Orientation_ORIENTATION_SOUTH_EAST  Orientation = 8 // 315°
+)
+
+// Enum value maps for Orientation.
+var (
+	Orientation_name = map[int32]string{
+		0: "ORIENTATION_UNSPECIFIED",
+		1: "ORIENTATION_EAST",
+		2: "ORIENTATION_NORTH_EAST",
+		3: "ORIENTATION_NORTH",
+		4: "ORIENTATION_NORTH_WEST",
+		5: "ORIENTATION_WEST",
+		6: "ORIENTATION_SOUTH_WEST",
+		7: "ORIENTATION_SOUTH",
+		8: "ORIENTATION_SOUTH_EAST",
+	}
+	Orientation_value = map[string]int32{
+		"ORIENTATION_UNSPECIFIED": 0,
+		"ORIENTATION_EAST":        1,
+		"ORIENTATION_NORTH_EAST":  2,
+		"ORIENTATION_NORTH":       3,
+		"ORIENTATION_NORTH_WEST":  4,
+		"ORIENTATION_WEST":        5,
+		"ORIENTATION_SOUTH_WEST":  6,
+		"ORIENTATION_SOUTH":       7,
+		"ORIENTATION_SOUTH_EAST":  8,
+	}
+)
+
+func (x Orientation) Enum() *Orientation {
+	p := new(Orientation)
+	*p = x
+	return p
+}
+
+func (x Orientation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Orientation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[1].Descriptor()
+}
+
+func (Orientation) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[1]
+}
+
+func (x Orientation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Orientation.Descriptor instead.
+func (Orientation) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{1}
+}
+
 type CellState int32
 
 const (
@@ -85,17 +164,27 @@ const (
 	CellState_CELL_STATE_HIT      CellState = 3
 	CellState_CELL_STATE_SUNK     CellState = 4
 	CellState_CELL_STATE_REVEALED CellState = 5
+	CellState_CELL_STATE_ISLAND   CellState = 6  // Terrain: shots here are wasted
+	CellState_CELL_STATE_MINE     CellState = 7  // Terrain: a mine, spent once fired at
+	CellState_CELL_STATE_SHIELDED CellState = 8  // Protected by a shield, nothing was hit
+	CellState_CELL_STATE_REPAIRED CellState = 9  // No longer hit, after a repair
+	CellState_CELL_STATE_DECOY    CellState = 10 // Your own decoy, not hit yet
 )
 
 // Enum value maps for CellState.
 var (
 	CellState_name = map[int32]string{
-		0: "CELL_STATE_UNKNOWN",
-		1: "CELL_STATE_EMPTY",
-		2: "CELL_STATE_MISS",
-		3: "CELL_STATE_HIT",
-		4: "CELL_STATE_SUNK",
-		5: "CELL_STATE_REVEALED",
+		0:  "CELL_STATE_UNKNOWN",
+		1:  "CELL_STATE_EMPTY",
+		2:  "CELL_STATE_MISS",
+		3:  "CELL_STATE_HIT",
+		4:  "CELL_STATE_SUNK",
+		5:  "CELL_STATE_REVEALED",
+		6:  "CELL_STATE_ISLAND",
+		7:  "CELL_STATE_MINE",
+		8:  "CELL_STATE_SHIELDED",
+		9:  "CELL_STATE_REPAIRED",
+		10: "CELL_STATE_DECOY",
 	}
 	CellState_value = map[string]int32{
 		"CELL_STATE_UNKNOWN":  0,
@@ -104,6 +193,11 @@ var (
 		"CELL_STATE_HIT":      3,
 		"CELL_STATE_SUNK":     4,
 		"CELL_STATE_REVEALED": 5,
+		"CELL_STATE_ISLAND":   6,
+		"CELL_STATE_MINE":     7,
+		"CELL_STATE_SHIELDED": 8,
+		"CELL_STATE_REPAIRED": 9,
+		"CELL_STATE_DECOY":    10,
 	}
 )
 
@@ -118,11 +212,11 @@ func (x CellState) String() string {
 }
 
 func (CellState) Descriptor() protoreflect.EnumDescriptor {
-	return file_pirates_v1_pirates_proto_enumTypes[1].Descriptor()
+	return file_pirates_v1_pirates_proto_enumTypes[2].Descriptor()
 }
 
 func (CellState) Type() protoreflect.EnumType {
-	return &file_pirates_v1_pirates_proto_enumTypes[1]
+	return &file_pirates_v1_pirates_proto_enumTypes[2]
 }
 
 func (x CellState) Number() protoreflect.EnumNumber {
@@ -131,16 +225,17 @@ func (x CellState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CellState.Descriptor instead.
 func (CellState) EnumDescriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{1}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{2}
 }
 
 type PlayerStatus int32
 
 const (
-	PlayerStatus_PLAYER_STATUS_UNSPECIFIED PlayerStatus = 0
-	PlayerStatus_PLAYER_STATUS_ONLINE      PlayerStatus = 1
-	PlayerStatus_PLAYER_STATUS_IN_QUEUE    PlayerStatus = 2
-	PlayerStatus_PLAYER_STATUS_IN_GAME     PlayerStatus = 3
+	PlayerStatus_PLAYER_STATUS_UNSPECIFIED      PlayerStatus = 0
+	PlayerStatus_PLAYER_STATUS_ONLINE           PlayerStatus = 1
+	PlayerStatus_PLAYER_STATUS_IN_QUEUE         PlayerStatus = 2
+	PlayerStatus_PLAYER_STATUS_IN_GAME          PlayerStatus = 3
+	PlayerStatus_PLAYER_STATUS_IN_PRIVATE_LOBBY PlayerStatus = 4 // Hosting a private lobby, hidden from the public list
 )
 
 // Enum value maps for PlayerStatus.
@@ -150,12 +245,14 @@ var (
 		1: "PLAYER_STATUS_ONLINE",
 		2: "PLAYER_STATUS_IN_QUEUE",
 		3: "PLAYER_STATUS_IN_GAME",
+		4: "PLAYER_STATUS_IN_PRIVATE_LOBBY",
 	}
 	PlayerStatus_value = map[string]int32{
-		"PLAYER_STATUS_UNSPECIFIED": 0,
-		"PLAYER_STATUS_ONLINE":      1,
-		"PLAYER_STATUS_IN_QUEUE":    2,
-		"PLAYER_STATUS_IN_GAME":     3,
+		"PLAYER_STATUS_UNSPECIFIED":      0,
+		"PLAYER_STATUS_ONLINE":           1,
+		"PLAYER_STATUS_IN_QUEUE":         2,
+		"PLAYER_STATUS_IN_GAME":          3,
+		"PLAYER_STATUS_IN_PRIVATE_LOBBY": 4,
 	}
 )
 
@@ -170,11 +267,11 @@ func (x PlayerStatus) String() string {
 }
 
 func (PlayerStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pirates_v1_pirates_proto_enumTypes[2].Descriptor()
+	return file_pirates_v1_pirates_proto_enumTypes[3].Descriptor()
 }
 
 func (PlayerStatus) Type() protoreflect.EnumType {
-	return &file_pirates_v1_pirates_proto_enumTypes[2]
+	return &file_pirates_v1_pirates_proto_enumTypes[3]
 }
 
 func (x PlayerStatus) Number() protoreflect.EnumNumber {
@@ -183,7 +280,435 @@ func (x PlayerStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlayerStatus.Descriptor instead.
 func (PlayerStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{2}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{3}
+}
+
+// RuleSet selects the base variant a game is played with.
+type RuleSet int32
+
+const (
+	RuleSet_RULE_SET_UNSPECIFIED RuleSet = 0 // Same as classic
+	RuleSet_RULE_SET_CLASSIC     RuleSet = 1
+	RuleSet_RULE_SET_SALVO       RuleSet = 2 // Each turn fires several shots at once with AttackSalvo
+)
+
+// Enum value maps for RuleSet.
+var (
+	RuleSet_name = map[int32]string{
+		0: "RULE_SET_UNSPECIFIED",
+		1: "RULE_SET_CLASSIC",
+		2: "RULE_SET_SALVO",
+	}
+	RuleSet_value = map[string]int32{
+		"RULE_SET_UNSPECIFIED": 0,
+		"RULE_SET_CLASSIC":     1,
+		"RULE_SET_SALVO":       2,
+	}
+)
+
+func (x RuleSet) Enum() *RuleSet {
+	p := new(RuleSet)
+	*p = x
+	return p
+}
+
+func (x RuleSet) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleSet) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[4].Descriptor()
+}
+
+func (RuleSet) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[4]
+}
+
+func (x RuleSet) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleSet.Descriptor instead.
+func (RuleSet) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{4}
+}
+
+// BonusTurn is the house rule granting the attacker another shot.
+type BonusTurn int32
+
+const (
+	BonusTurn_BONUS_TURN_UNSPECIFIED BonusTurn = 0 // No bonus turns
+	BonusTurn_BONUS_TURN_ON_HIT      BonusTurn = 1 // Any hit plays again
+	BonusTurn_BONUS_TURN_ON_SINK     BonusTurn = 2 // Only sinking a ship plays again
+)
+
+// Enum value maps for BonusTurn.
+var (
+	BonusTurn_name = map[int32]string{
+		0: "BONUS_TURN_UNSPECIFIED",
+		1: "BONUS_TURN_ON_HIT",
+		2: "BONUS_TURN_ON_SINK",
+	}
+	BonusTurn_value = map[string]int32{
+		"BONUS_TURN_UNSPECIFIED": 0,
+		"BONUS_TURN_ON_HIT":      1,
+		"BONUS_TURN_ON_SINK":     2,
+	}
+)
+
+func (x BonusTurn) Enum() *BonusTurn {
+	p := new(BonusTurn)
+	*p = x
+	return p
+}
+
+func (x BonusTurn) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BonusTurn) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[5].Descriptor()
+}
+
+func (BonusTurn) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[5]
+}
+
+func (x BonusTurn) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BonusTurn.Descriptor instead.
+func (BonusTurn) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{5}
+}
+
+type GameOverReason int32
+
+const (
+	GameOverReason_GAME_OVER_REASON_UNSPECIFIED       GameOverReason = 0
+	GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK    GameOverReason = 1
+	GameOverReason_GAME_OVER_REASON_FORFEIT           GameOverReason = 2
+	GameOverReason_GAME_OVER_REASON_TURN_TIMEOUT      GameOverReason = 3
+	GameOverReason_GAME_OVER_REASON_DISCONNECT        GameOverReason = 4
+	GameOverReason_GAME_OVER_REASON_PLACEMENT_TIMEOUT GameOverReason = 5
+)
+
+// Enum value maps for GameOverReason.
+var (
+	GameOverReason_name = map[int32]string{
+		0: "GAME_OVER_REASON_UNSPECIFIED",
+		1: "GAME_OVER_REASON_ALL_SHIPS_SUNK",
+		2: "GAME_OVER_REASON_FORFEIT",
+		3: "GAME_OVER_REASON_TURN_TIMEOUT",
+		4: "GAME_OVER_REASON_DISCONNECT",
+		5: "GAME_OVER_REASON_PLACEMENT_TIMEOUT",
+	}
+	GameOverReason_value = map[string]int32{
+		"GAME_OVER_REASON_UNSPECIFIED":       0,
+		"GAME_OVER_REASON_ALL_SHIPS_SUNK":    1,
+		"GAME_OVER_REASON_FORFEIT":           2,
+		"GAME_OVER_REASON_TURN_TIMEOUT":      3,
+		"GAME_OVER_REASON_DISCONNECT":        4,
+		"GAME_OVER_REASON_PLACEMENT_TIMEOUT": 5,
+	}
+)
+
+func (x GameOverReason) Enum() *GameOverReason {
+	p := new(GameOverReason)
+	*p = x
+	return p
+}
+
+func (x GameOverReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameOverReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[6].Descriptor()
+}
+
+func (GameOverReason) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[6]
+}
+
+func (x GameOverReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameOverReason.Descriptor instead.
+func (GameOverReason) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{6}
+}
+
+type ChatScope int32
+
+const (
+	ChatScope_CHAT_SCOPE_UNSPECIFIED ChatScope = 0
+	ChatScope_CHAT_SCOPE_GAME        ChatScope = 1 // Every player of the sender's current game
+	ChatScope_CHAT_SCOPE_LOBBY       ChatScope = 2 // Every idle player in the lobby
+)
+
+// Enum value maps for ChatScope.
+var (
+	ChatScope_name = map[int32]string{
+		0: "CHAT_SCOPE_UNSPECIFIED",
+		1: "CHAT_SCOPE_GAME",
+		2: "CHAT_SCOPE_LOBBY",
+	}
+	ChatScope_value = map[string]int32{
+		"CHAT_SCOPE_UNSPECIFIED": 0,
+		"CHAT_SCOPE_GAME":        1,
+		"CHAT_SCOPE_LOBBY":       2,
+	}
+)
+
+func (x ChatScope) Enum() *ChatScope {
+	p := new(ChatScope)
+	*p = x
+	return p
+}
+
+func (x ChatScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[7].Descriptor()
+}
+
+func (ChatScope) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[7]
+}
+
+func (x ChatScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatScope.Descriptor instead.
+func (ChatScope) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{7}
+}
+
+// Preset quick emotes, rendered by the client.
+type QuickEmote int32
+
+const (
+	QuickEmote_QUICK_EMOTE_UNSPECIFIED    QuickEmote = 0
+	QuickEmote_QUICK_EMOTE_AHOY           QuickEmote = 1
+	QuickEmote_QUICK_EMOTE_ARRR           QuickEmote = 2
+	QuickEmote_QUICK_EMOTE_NICE_SHOT      QuickEmote = 3
+	QuickEmote_QUICK_EMOTE_BLOW_ME_DOWN   QuickEmote = 4
+	QuickEmote_QUICK_EMOTE_WALK_THE_PLANK QuickEmote = 5
+	QuickEmote_QUICK_EMOTE_GOOD_GAME      QuickEmote = 6
+)
+
+// Enum value maps for QuickEmote.
+var (
+	QuickEmote_name = map[int32]string{
+		0: "QUICK_EMOTE_UNSPECIFIED",
+		1: "QUICK_EMOTE_AHOY",
+		2: "QUICK_EMOTE_ARRR",
+		3: "QUICK_EMOTE_NICE_SHOT",
+		4: "QUICK_EMOTE_BLOW_ME_DOWN",
+		5: "QUICK_EMOTE_WALK_THE_PLANK",
+		6: "QUICK_EMOTE_GOOD_GAME",
+	}
+	QuickEmote_value = map[string]int32{
+		"QUICK_EMOTE_UNSPECIFIED":    0,
+		"QUICK_EMOTE_AHOY":           1,
+		"QUICK_EMOTE_ARRR":           2,
+		"QUICK_EMOTE_NICE_SHOT":      3,
+		"QUICK_EMOTE_BLOW_ME_DOWN":   4,
+		"QUICK_EMOTE_WALK_THE_PLANK": 5,
+		"QUICK_EMOTE_GOOD_GAME":      6,
+	}
+)
+
+func (x QuickEmote) Enum() *QuickEmote {
+	p := new(QuickEmote)
+	*p = x
+	return p
+}
+
+func (x QuickEmote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuickEmote) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[8].Descriptor()
+}
+
+func (QuickEmote) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[8]
+}
+
+func (x QuickEmote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuickEmote.Descriptor instead.
+func (QuickEmote) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{8}
+}
+
+type TournamentFormat int32
+
+const (
+	TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED        TournamentFormat = 0
+	TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION TournamentFormat = 1
+	TournamentFormat_TOURNAMENT_FORMAT_DOUBLE_ELIMINATION TournamentFormat = 2 // Out after a second loss
+	TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN        TournamentFormat = 3
+	TournamentFormat_TOURNAMENT_FORMAT_SWISS              TournamentFormat = 4
+)
+
+// Enum value maps for TournamentFormat.
+var (
+	TournamentFormat_name = map[int32]string{
+		0: "TOURNAMENT_FORMAT_UNSPECIFIED",
+		1: "TOURNAMENT_FORMAT_SINGLE_ELIMINATION",
+		2: "TOURNAMENT_FORMAT_DOUBLE_ELIMINATION",
+		3: "TOURNAMENT_FORMAT_ROUND_ROBIN",
+		4: "TOURNAMENT_FORMAT_SWISS",
+	}
+	TournamentFormat_value = map[string]int32{
+		"TOURNAMENT_FORMAT_UNSPECIFIED":        0,
+		"TOURNAMENT_FORMAT_SINGLE_ELIMINATION": 1,
+		"TOURNAMENT_FORMAT_DOUBLE_ELIMINATION": 2,
+		"TOURNAMENT_FORMAT_ROUND_ROBIN":        3,
+		"TOURNAMENT_FORMAT_SWISS":              4,
+	}
+)
+
+func (x TournamentFormat) Enum() *TournamentFormat {
+	p := new(TournamentFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[9].Descriptor()
+}
+
+func (TournamentFormat) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[9]
+}
+
+func (x TournamentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentFormat.Descriptor instead.
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{9}
+}
+
+type TournamentStatus int32
+
+const (
+	TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED  TournamentStatus = 0
+	TournamentStatus_TOURNAMENT_STATUS_REGISTRATION TournamentStatus = 1
+	TournamentStatus_TOURNAMENT_STATUS_RUNNING      TournamentStatus = 2
+	TournamentStatus_TOURNAMENT_STATUS_FINISHED     TournamentStatus = 3
+)
+
+// Enum value maps for TournamentStatus.
+var (
+	TournamentStatus_name = map[int32]string{
+		0: "TOURNAMENT_STATUS_UNSPECIFIED",
+		1: "TOURNAMENT_STATUS_REGISTRATION",
+		2: "TOURNAMENT_STATUS_RUNNING",
+		3: "TOURNAMENT_STATUS_FINISHED",
+	}
+	TournamentStatus_value = map[string]int32{
+		"TOURNAMENT_STATUS_UNSPECIFIED":  0,
+		"TOURNAMENT_STATUS_REGISTRATION": 1,
+		"TOURNAMENT_STATUS_RUNNING":      2,
+		"TOURNAMENT_STATUS_FINISHED":     3,
+	}
+)
+
+func (x TournamentStatus) Enum() *TournamentStatus {
+	p := new(TournamentStatus)
+	*p = x
+	return p
+}
+
+func (x TournamentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[10].Descriptor()
+}
+
+func (TournamentStatus) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[10]
+}
+
+func (x TournamentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentStatus.Descriptor instead.
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{10}
+}
+
+type LeaderboardScope int32
+
+const (
+	LeaderboardScope_LEADERBOARD_SCOPE_UNSPECIFIED LeaderboardScope = 0 // Same as global
+	LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL      LeaderboardScope = 1 // Every game, ranked by rating
+	LeaderboardScope_LEADERBOARD_SCOPE_WEEKLY      LeaderboardScope = 2 // The last seven days, ranked by rating gained
+	LeaderboardScope_LEADERBOARD_SCOPE_RULE_SET    LeaderboardScope = 3 // One rule set, ranked by rating gained
+)
+
+// Enum value maps for LeaderboardScope.
+var (
+	LeaderboardScope_name = map[int32]string{
+		0: "LEADERBOARD_SCOPE_UNSPECIFIED",
+		1: "LEADERBOARD_SCOPE_GLOBAL",
+		2: "LEADERBOARD_SCOPE_WEEKLY",
+		3: "LEADERBOARD_SCOPE_RULE_SET",
+	}
+	LeaderboardScope_value = map[string]int32{
+		"LEADERBOARD_SCOPE_UNSPECIFIED": 0,
+		"LEADERBOARD_SCOPE_GLOBAL":      1,
+		"LEADERBOARD_SCOPE_WEEKLY":      2,
+		"LEADERBOARD_SCOPE_RULE_SET":    3,
+	}
+)
+
+func (x LeaderboardScope) Enum() *LeaderboardScope {
+	p := new(LeaderboardScope)
+	*p = x
+	return p
+}
+
+func (x LeaderboardScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_pirates_v1_pirates_proto_enumTypes[11].Descriptor()
+}
+
+func (LeaderboardScope) Type() protoreflect.EnumType {
+	return &file_pirates_v1_pirates_proto_enumTypes[11]
+}
+
+func (x LeaderboardScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardScope.Descriptor instead.
+func (LeaderboardScope) EnumDescriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{11}
 }
 
 type Coordinate struct {
@@ -426,28 +951,219 @@ func (x *Player) GetStatus() PlayerStatus {
 	return PlayerStatus_PLAYER_STATUS_UNSPECIFIED
 }
 
-type ConnectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GameRules are the options a game is played with. The zero value is the
+// classic game with powers.
+type GameRules struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RuleSet         RuleSet                `protobuf:"varint,1,opt,name=rule_set,json=ruleSet,proto3,enum=pirates.v1.RuleSet" json:"rule_set,omitempty"`
+	PowersDisabled  bool                   `protobuf:"varint,2,opt,name=powers_disabled,json=powersDisabled,proto3" json:"powers_disabled,omitempty"` // No powers are granted when a ship is sunk
+	SalvoShots      int32                  `protobuf:"varint,3,opt,name=salvo_shots,json=salvoShots,proto3" json:"salvo_shots,omitempty"`             // Salvo only: shots per turn, 0 = one per surviving ship
+	BonusTurn       BonusTurn              `protobuf:"varint,4,opt,name=bonus_turn,json=bonusTurn,proto3,enum=pirates.v1.BonusTurn" json:"bonus_turn,omitempty"`
+	MaxBonusTurns   int32                  `protobuf:"varint,5,opt,name=max_bonus_turns,json=maxBonusTurns,proto3" json:"max_bonus_turns,omitempty"`     // Consecutive bonus turns allowed, 0 = no cap
+	NoTouch         bool                   `protobuf:"varint,6,opt,name=no_touch,json=noTouch,proto3" json:"no_touch,omitempty"`                         // Ships may not touch, even diagonally
+	Terrain         bool                   `protobuf:"varint,7,opt,name=terrain,proto3" json:"terrain,omitempty"`                                        // Generate islands and mines for the game
+	DefensivePowers bool                   `protobuf:"varint,8,opt,name=defensive_powers,json=defensivePowers,proto3" json:"defensive_powers,omitempty"` // Each player starts with a Shield, a Repair and a Decoy
+	Teams           bool                   `protobuf:"varint,9,opt,name=teams,proto3" json:"teams,omitempty"`                                            // 2v2: seats 1 and 3 play against seats 2 and 4
+	TurnSeconds     int32                  `protobuf:"varint,10,opt,name=turn_seconds,json=turnSeconds,proto3" json:"turn_seconds,omitempty"`            // Time per turn, 0 = no limit; otherwise 10 to 300
+	SeriesLength    int32                  `protobuf:"varint,11,opt,name=series_length,json=seriesLength,proto3" json:"series_length,omitempty"`         // Best of that many games, up to 7; 0 = a single game
+	Unranked        bool                   `protobuf:"varint,12,opt,name=unranked,proto3" json:"unranked,omitempty"`                                     // The game leaves ratings and leaderboards alone
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ConnectRequest) Reset() {
-	*x = ConnectRequest{}
+func (x *GameRules) Reset() {
+	*x = GameRules{}
 	mi := &file_pirates_v1_pirates_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectRequest) String() string {
+func (x *GameRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRules) ProtoMessage() {}
+
+func (x *GameRules) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRules.ProtoReflect.Descriptor instead.
+func (*GameRules) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameRules) GetRuleSet() RuleSet {
+	if x != nil {
+		return x.RuleSet
+	}
+	return RuleSet_RULE_SET_UNSPECIFIED
+}
+
+func (x *GameRules) GetPowersDisabled() bool {
+	if x != nil {
+		return x.PowersDisabled
+	}
+	return false
+}
+
+func (x *GameRules) GetSalvoShots() int32 {
+	if x != nil {
+		return x.SalvoShots
+	}
+	return 0
+}
+
+func (x *GameRules) GetBonusTurn() BonusTurn {
+	if x != nil {
+		return x.BonusTurn
+	}
+	return BonusTurn_BONUS_TURN_UNSPECIFIED
+}
+
+func (x *GameRules) GetMaxBonusTurns() int32 {
+	if x != nil {
+		return x.MaxBonusTurns
+	}
+	return 0
+}
+
+func (x *GameRules) GetNoTouch() bool {
+	if x != nil {
+		return x.NoTouch
+	}
+	return false
+}
+
+func (x *GameRules) GetTerrain() bool {
+	if x != nil {
+		return x.Terrain
+	}
+	return false
+}
+
+func (x *GameRules) GetDefensivePowers() bool {
+	if x != nil {
+		return x.DefensivePowers
+	}
+	return false
+}
+
+func (x *GameRules) GetTeams() bool {
+	if x != nil {
+		return x.Teams
+	}
+	return false
+}
+
+func (x *GameRules) GetTurnSeconds() int32 {
+	if x != nil {
+		return x.TurnSeconds
+	}
+	return 0
+}
+
+func (x *GameRules) GetSeriesLength() int32 {
+	if x != nil {
+		return x.SeriesLength
+	}
+	return 0
+}
+
+func (x *GameRules) GetUnranked() bool {
+	if x != nil {
+		return x.Unranked
+	}
+	return false
+}
+
+// Terrain is a terrain game's layout as seen by one player. Islands are the
+// same on both boards; mines stay hidden from the opponent.
+type Terrain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Islands       []*Coordinate          `protobuf:"bytes,1,rep,name=islands,proto3" json:"islands,omitempty"`
+	YourMines     []*Coordinate          `protobuf:"bytes,2,rep,name=your_mines,json=yourMines,proto3" json:"your_mines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Terrain) Reset() {
+	*x = Terrain{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Terrain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terrain) ProtoMessage() {}
+
+func (x *Terrain) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terrain.ProtoReflect.Descriptor instead.
+func (*Terrain) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Terrain) GetIslands() []*Coordinate {
+	if x != nil {
+		return x.Islands
+	}
+	return nil
+}
+
+func (x *Terrain) GetYourMines() []*Coordinate {
+	if x != nil {
+		return x.YourMines
+	}
+	return nil
+}
+
+type ConnectRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The session token of an earlier connection, to come back as the same
+	// player: with their ID, friends, block list and records.
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*ConnectRequest) ProtoMessage() {}
 
 func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[4]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +1176,7 @@ func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
 func (*ConnectRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{4}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ConnectRequest) GetDisplayName() string {
@@ -470,6 +1186,13 @@ func (x *ConnectRequest) GetDisplayName() string {
 	return ""
 }
 
+func (x *ConnectRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 type ConnectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
@@ -480,7 +1203,7 @@ type ConnectResponse struct {
 
 func (x *ConnectResponse) Reset() {
 	*x = ConnectResponse{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[5]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +1215,7 @@ func (x *ConnectResponse) String() string {
 func (*ConnectResponse) ProtoMessage() {}
 
 func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[5]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +1228,7 @@ func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
 func (*ConnectResponse) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{5}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ConnectResponse) GetPlayer() *Player {
@@ -523,15 +1246,22 @@ func (x *ConnectResponse) GetSessionToken() string {
 }
 
 type JoinQueueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// Queue for 2v2 games. A party of two plays as one team; players queued
+	// alone are teamed up in pairs. Only a party's leader queues it.
+	// Shorthand for the "teams" queue when queue is unset.
+	Teams bool `protobuf:"varint,2,opt,name=teams,proto3" json:"teams,omitempty"`
+	// The queue to join, from QueueStatusUpdate.queues. Unset joins the
+	// "ranked" queue. Joining a queue leaves the one you waited in.
+	Queue         string `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinQueueRequest) Reset() {
 	*x = JoinQueueRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[6]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -543,7 +1273,7 @@ func (x *JoinQueueRequest) String() string {
 func (*JoinQueueRequest) ProtoMessage() {}
 
 func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[6]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +1286,7 @@ func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
 func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{6}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *JoinQueueRequest) GetSessionToken() string {
@@ -566,6 +1296,20 @@ func (x *JoinQueueRequest) GetSessionToken() string {
 	return ""
 }
 
+func (x *JoinQueueRequest) GetTeams() bool {
+	if x != nil {
+		return x.Teams
+	}
+	return false
+}
+
+func (x *JoinQueueRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
 type LeaveQueueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
@@ -575,7 +1319,7 @@ type LeaveQueueRequest struct {
 
 func (x *LeaveQueueRequest) Reset() {
 	*x = LeaveQueueRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[7]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +1331,7 @@ func (x *LeaveQueueRequest) String() string {
 func (*LeaveQueueRequest) ProtoMessage() {}
 
 func (x *LeaveQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[7]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +1344,7 @@ func (x *LeaveQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveQueueRequest.ProtoReflect.Descriptor instead.
 func (*LeaveQueueRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{7}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LeaveQueueRequest) GetSessionToken() string {
@@ -618,7 +1362,7 @@ type LeaveQueueResponse struct {
 
 func (x *LeaveQueueResponse) Reset() {
 	*x = LeaveQueueResponse{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[8]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -630,7 +1374,7 @@ func (x *LeaveQueueResponse) String() string {
 func (*LeaveQueueResponse) ProtoMessage() {}
 
 func (x *LeaveQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[8]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,7 +1387,51 @@ func (x *LeaveQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveQueueResponse.ProtoReflect.Descriptor instead.
 func (*LeaveQueueResponse) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{8}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{10}
+}
+
+type GetQueueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueStatusRequest) Reset() {
+	*x = GetQueueStatusRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatusRequest) ProtoMessage() {}
+
+func (x *GetQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetQueueStatusRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
 }
 
 type ListPlayersRequest struct {
@@ -655,7 +1443,7 @@ type ListPlayersRequest struct {
 
 func (x *ListPlayersRequest) Reset() {
 	*x = ListPlayersRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[9]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +1455,7 @@ func (x *ListPlayersRequest) String() string {
 func (*ListPlayersRequest) ProtoMessage() {}
 
 func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[9]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +1468,7 @@ func (x *ListPlayersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPlayersRequest.ProtoReflect.Descriptor instead.
 func (*ListPlayersRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{9}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListPlayersRequest) GetSessionToken() string {
@@ -694,13 +1482,22 @@ type ChallengePlayerRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	TargetPlayerId string                 `protobuf:"bytes,2,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Free-for-all: further players to invite, up to four players in all.
+	// Every invited player must accept.
+	AdditionalPlayerIds []string `protobuf:"bytes,3,rep,name=additional_player_ids,json=additionalPlayerIds,proto3" json:"additional_player_ids,omitempty"`
+	// 2v2: your teammate. The target and a single additional player form the
+	// other team.
+	AllyPlayerId string `protobuf:"bytes,4,opt,name=ally_player_id,json=allyPlayerId,proto3" json:"ally_player_id,omitempty"`
+	// The options of the game, shown to the invited players. Teams are set
+	// by ally_player_id.
+	Rules         *GameRules `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChallengePlayerRequest) Reset() {
 	*x = ChallengePlayerRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[10]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +1509,7 @@ func (x *ChallengePlayerRequest) String() string {
 func (*ChallengePlayerRequest) ProtoMessage() {}
 
 func (x *ChallengePlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[10]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +1522,7 @@ func (x *ChallengePlayerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChallengePlayerRequest.ProtoReflect.Descriptor instead.
 func (*ChallengePlayerRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{10}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ChallengePlayerRequest) GetSessionToken() string {
@@ -742,6 +1539,27 @@ func (x *ChallengePlayerRequest) GetTargetPlayerId() string {
 	return ""
 }
 
+func (x *ChallengePlayerRequest) GetAdditionalPlayerIds() []string {
+	if x != nil {
+		return x.AdditionalPlayerIds
+	}
+	return nil
+}
+
+func (x *ChallengePlayerRequest) GetAllyPlayerId() string {
+	if x != nil {
+		return x.AllyPlayerId
+	}
+	return ""
+}
+
+func (x *ChallengePlayerRequest) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type ChallengePlayerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
@@ -751,7 +1569,7 @@ type ChallengePlayerResponse struct {
 
 func (x *ChallengePlayerResponse) Reset() {
 	*x = ChallengePlayerResponse{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[11]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +1581,7 @@ func (x *ChallengePlayerResponse) String() string {
 func (*ChallengePlayerResponse) ProtoMessage() {}
 
 func (x *ChallengePlayerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[11]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +1594,7 @@ func (x *ChallengePlayerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChallengePlayerResponse.ProtoReflect.Descriptor instead.
 func (*ChallengePlayerResponse) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{11}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ChallengePlayerResponse) GetMatchId() string {
@@ -797,7 +1615,7 @@ type RespondToMatchRequest struct {
 
 func (x *RespondToMatchRequest) Reset() {
 	*x = RespondToMatchRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[12]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1627,7 @@ func (x *RespondToMatchRequest) String() string {
 func (*RespondToMatchRequest) ProtoMessage() {}
 
 func (x *RespondToMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[12]
+	mi := &file_pirates_v1_pirates_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1640,7 @@ func (x *RespondToMatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RespondToMatchRequest.ProtoReflect.Descriptor instead.
 func (*RespondToMatchRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{12}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RespondToMatchRequest) GetSessionToken() string {
@@ -846,28 +1664,29 @@ func (x *RespondToMatchRequest) GetAccepted() bool {
 	return false
 }
 
-type ForfeitRequest struct {
+type CreatePrivateLobbyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Rules         *GameRules             `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForfeitRequest) Reset() {
-	*x = ForfeitRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[13]
+func (x *CreatePrivateLobbyRequest) Reset() {
+	*x = CreatePrivateLobbyRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForfeitRequest) String() string {
+func (x *CreatePrivateLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForfeitRequest) ProtoMessage() {}
+func (*CreatePrivateLobbyRequest) ProtoMessage() {}
 
-func (x *ForfeitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[13]
+func (x *CreatePrivateLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,39 +1697,48 @@ func (x *ForfeitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForfeitRequest.ProtoReflect.Descriptor instead.
-func (*ForfeitRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreatePrivateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrivateLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ForfeitRequest) GetSessionToken() string {
+func (x *CreatePrivateLobbyRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-type ForfeitResponse struct {
+func (x *CreatePrivateLobbyRequest) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PrivateLobby struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Short code to share with the guest
+	Rules         *GameRules             `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForfeitResponse) Reset() {
-	*x = ForfeitResponse{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[14]
+func (x *PrivateLobby) Reset() {
+	*x = PrivateLobby{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForfeitResponse) String() string {
+func (x *PrivateLobby) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForfeitResponse) ProtoMessage() {}
+func (*PrivateLobby) ProtoMessage() {}
 
-func (x *ForfeitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[14]
+func (x *PrivateLobby) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,34 +1749,48 @@ func (x *ForfeitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForfeitResponse.ProtoReflect.Descriptor instead.
-func (*ForfeitResponse) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PrivateLobby.ProtoReflect.Descriptor instead.
+func (*PrivateLobby) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{17}
 }
 
-type PlaceShipsRequest struct {
+func (x *PrivateLobby) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PrivateLobby) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type JoinPrivateLobbyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	Ships         []*Ship                `protobuf:"bytes,2,rep,name=ships,proto3" json:"ships,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlaceShipsRequest) Reset() {
-	*x = PlaceShipsRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[15]
+func (x *JoinPrivateLobbyRequest) Reset() {
+	*x = JoinPrivateLobbyRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlaceShipsRequest) String() string {
+func (x *JoinPrivateLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaceShipsRequest) ProtoMessage() {}
+func (*JoinPrivateLobbyRequest) ProtoMessage() {}
 
-func (x *PlaceShipsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[15]
+func (x *JoinPrivateLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,48 +1801,47 @@ func (x *PlaceShipsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaceShipsRequest.ProtoReflect.Descriptor instead.
-func (*PlaceShipsRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use JoinPrivateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*JoinPrivateLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PlaceShipsRequest) GetSessionToken() string {
+func (x *JoinPrivateLobbyRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *PlaceShipsRequest) GetShips() []*Ship {
+func (x *JoinPrivateLobbyRequest) GetCode() string {
 	if x != nil {
-		return x.Ships
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type AttackRequest struct {
+type JoinPrivateLobbyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	Target        *Coordinate            `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackRequest) Reset() {
-	*x = AttackRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[16]
+func (x *JoinPrivateLobbyResponse) Reset() {
+	*x = JoinPrivateLobbyResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackRequest) String() string {
+func (x *JoinPrivateLobbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackRequest) ProtoMessage() {}
+func (*JoinPrivateLobbyResponse) ProtoMessage() {}
 
-func (x *AttackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[16]
+func (x *JoinPrivateLobbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,50 +1852,40 @@ func (x *AttackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackRequest.ProtoReflect.Descriptor instead.
-func (*AttackRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use JoinPrivateLobbyResponse.ProtoReflect.Descriptor instead.
+func (*JoinPrivateLobbyResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AttackRequest) GetSessionToken() string {
+func (x *JoinPrivateLobbyResponse) GetMatchId() string {
 	if x != nil {
-		return x.SessionToken
+		return x.MatchId
 	}
 	return ""
 }
 
-func (x *AttackRequest) GetTarget() *Coordinate {
-	if x != nil {
-		return x.Target
-	}
-	return nil
-}
-
-type UsePowerRequest struct {
+type ClosePrivateLobbyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	Power         PowerType              `protobuf:"varint,2,opt,name=power,proto3,enum=pirates.v1.PowerType" json:"power,omitempty"`
-	Target        *Coordinate            `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Horizontal    bool                   `protobuf:"varint,4,opt,name=horizontal,proto3" json:"horizontal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsePowerRequest) Reset() {
-	*x = UsePowerRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[17]
+func (x *ClosePrivateLobbyRequest) Reset() {
+	*x = ClosePrivateLobbyRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsePowerRequest) String() string {
+func (x *ClosePrivateLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsePowerRequest) ProtoMessage() {}
+func (*ClosePrivateLobbyRequest) ProtoMessage() {}
 
-func (x *UsePowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[17]
+func (x *ClosePrivateLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,61 +1896,79 @@ func (x *UsePowerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsePowerRequest.ProtoReflect.Descriptor instead.
-func (*UsePowerRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ClosePrivateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*ClosePrivateLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UsePowerRequest) GetSessionToken() string {
+func (x *ClosePrivateLobbyRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *UsePowerRequest) GetPower() PowerType {
-	if x != nil {
-		return x.Power
-	}
-	return PowerType_POWER_TYPE_UNSPECIFIED
+type ClosePrivateLobbyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsePowerRequest) GetTarget() *Coordinate {
-	if x != nil {
-		return x.Target
-	}
-	return nil
+func (x *ClosePrivateLobbyResponse) Reset() {
+	*x = ClosePrivateLobbyResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsePowerRequest) GetHorizontal() bool {
+func (x *ClosePrivateLobbyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePrivateLobbyResponse) ProtoMessage() {}
+
+func (x *ClosePrivateLobbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[21]
 	if x != nil {
-		return x.Horizontal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type SubscribeEventsRequest struct {
+// Deprecated: Use ClosePrivateLobbyResponse.ProtoReflect.Descriptor instead.
+func (*ClosePrivateLobbyResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{21}
+}
+
+// Creates a party led by the inviter if they are not in one yet. Only the
+// party's leader invites.
+type InviteToPartyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeEventsRequest) Reset() {
-	*x = SubscribeEventsRequest{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[18]
+func (x *InviteToPartyRequest) Reset() {
+	*x = InviteToPartyRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeEventsRequest) String() string {
+func (x *InviteToPartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeEventsRequest) ProtoMessage() {}
+func (*InviteToPartyRequest) ProtoMessage() {}
 
-func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[18]
+func (x *InviteToPartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,42 +1979,49 @@ func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use InviteToPartyRequest.ProtoReflect.Descriptor instead.
+func (*InviteToPartyRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SubscribeEventsRequest) GetSessionToken() string {
+func (x *InviteToPartyRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-type QueueStatusUpdate struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	InQueue        bool                   `protobuf:"varint,1,opt,name=in_queue,json=inQueue,proto3" json:"in_queue,omitempty"`
-	QueuePosition  int32                  `protobuf:"varint,2,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
-	PlayersInQueue int32                  `protobuf:"varint,3,opt,name=players_in_queue,json=playersInQueue,proto3" json:"players_in_queue,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *InviteToPartyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
 }
 
-func (x *QueueStatusUpdate) Reset() {
-	*x = QueueStatusUpdate{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[19]
+type RespondToPartyInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PartyId       string                 `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	Accepted      bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToPartyInviteRequest) Reset() {
+	*x = RespondToPartyInviteRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueueStatusUpdate) String() string {
+func (x *RespondToPartyInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueueStatusUpdate) ProtoMessage() {}
+func (*RespondToPartyInviteRequest) ProtoMessage() {}
 
-func (x *QueueStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[19]
+func (x *RespondToPartyInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,54 +2032,54 @@ func (x *QueueStatusUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueueStatusUpdate.ProtoReflect.Descriptor instead.
-func (*QueueStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RespondToPartyInviteRequest.ProtoReflect.Descriptor instead.
+func (*RespondToPartyInviteRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueueStatusUpdate) GetInQueue() bool {
+func (x *RespondToPartyInviteRequest) GetSessionToken() string {
 	if x != nil {
-		return x.InQueue
+		return x.SessionToken
 	}
-	return false
+	return ""
 }
 
-func (x *QueueStatusUpdate) GetQueuePosition() int32 {
+func (x *RespondToPartyInviteRequest) GetPartyId() string {
 	if x != nil {
-		return x.QueuePosition
+		return x.PartyId
 	}
-	return 0
+	return ""
 }
 
-func (x *QueueStatusUpdate) GetPlayersInQueue() int32 {
+func (x *RespondToPartyInviteRequest) GetAccepted() bool {
 	if x != nil {
-		return x.PlayersInQueue
+		return x.Accepted
 	}
-	return 0
+	return false
 }
 
-type PlayerListUpdate struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AvailablePlayers []*Player              `protobuf:"bytes,1,rep,name=available_players,json=availablePlayers,proto3" json:"available_players,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type LeavePartyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerListUpdate) Reset() {
-	*x = PlayerListUpdate{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[20]
+func (x *LeavePartyRequest) Reset() {
+	*x = LeavePartyRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerListUpdate) String() string {
+func (x *LeavePartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerListUpdate) ProtoMessage() {}
+func (*LeavePartyRequest) ProtoMessage() {}
 
-func (x *PlayerListUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[20]
+func (x *LeavePartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,43 +2090,39 @@ func (x *PlayerListUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerListUpdate.ProtoReflect.Descriptor instead.
-func (*PlayerListUpdate) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LeavePartyRequest.ProtoReflect.Descriptor instead.
+func (*LeavePartyRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PlayerListUpdate) GetAvailablePlayers() []*Player {
+func (x *LeavePartyRequest) GetSessionToken() string {
 	if x != nil {
-		return x.AvailablePlayers
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-type MatchProposal struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	MatchId        string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	Opponent       *Player                `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
-	YouInitiated   bool                   `protobuf:"varint,3,opt,name=you_initiated,json=youInitiated,proto3" json:"you_initiated,omitempty"`
-	TimeoutSeconds int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type LeavePartyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchProposal) Reset() {
-	*x = MatchProposal{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[21]
+func (x *LeavePartyResponse) Reset() {
+	*x = LeavePartyResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchProposal) String() string {
+func (x *LeavePartyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchProposal) ProtoMessage() {}
+func (*LeavePartyResponse) ProtoMessage() {}
 
-func (x *MatchProposal) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[21]
+func (x *LeavePartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,63 +2133,37 @@ func (x *MatchProposal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchProposal.ProtoReflect.Descriptor instead.
-func (*MatchProposal) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use LeavePartyResponse.ProtoReflect.Descriptor instead.
+func (*LeavePartyResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MatchProposal) GetMatchId() string {
-	if x != nil {
-		return x.MatchId
-	}
-	return ""
+// The organizer does not play unless they register.
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format        TournamentFormat       `protobuf:"varint,3,opt,name=format,proto3,enum=pirates.v1.TournamentFormat" json:"format,omitempty"`
+	Rules         *GameRules             `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"` // Team rules are ignored: tournament games are one-on-one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchProposal) GetOpponent() *Player {
-	if x != nil {
-		return x.Opponent
-	}
-	return nil
-}
-
-func (x *MatchProposal) GetYouInitiated() bool {
-	if x != nil {
-		return x.YouInitiated
-	}
-	return false
-}
-
-func (x *MatchProposal) GetTimeoutSeconds() int32 {
-	if x != nil {
-		return x.TimeoutSeconds
-	}
-	return 0
-}
-
-type MatchResult struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	MatchId         string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	Accepted        bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
-	RejectionReason string                 `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *MatchResult) Reset() {
-	*x = MatchResult{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[22]
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchResult) String() string {
+func (x *CreateTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchResult) ProtoMessage() {}
+func (*CreateTournamentRequest) ProtoMessage() {}
 
-func (x *MatchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[22]
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,56 +2174,61 @@ func (x *MatchResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
-func (*MatchResult) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MatchResult) GetMatchId() string {
+func (x *CreateTournamentRequest) GetSessionToken() string {
 	if x != nil {
-		return x.MatchId
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *MatchResult) GetAccepted() bool {
+func (x *CreateTournamentRequest) GetName() string {
 	if x != nil {
-		return x.Accepted
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *MatchResult) GetRejectionReason() string {
+func (x *CreateTournamentRequest) GetFormat() TournamentFormat {
 	if x != nil {
-		return x.RejectionReason
+		return x.Format
 	}
-	return ""
+	return TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED
 }
 
-type GameStarted struct {
+func (x *CreateTournamentRequest) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ListTournamentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Opponent      *Player                `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
-	YourTurnFirst bool                   `protobuf:"varint,3,opt,name=your_turn_first,json=yourTurnFirst,proto3" json:"your_turn_first,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameStarted) Reset() {
-	*x = GameStarted{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[23]
+func (x *ListTournamentsRequest) Reset() {
+	*x = ListTournamentsRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameStarted) String() string {
+func (x *ListTournamentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameStarted) ProtoMessage() {}
+func (*ListTournamentsRequest) ProtoMessage() {}
 
-func (x *GameStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[23]
+func (x *ListTournamentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,56 +2239,85 @@ func (x *GameStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameStarted.ProtoReflect.Descriptor instead.
-func (*GameStarted) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListTournamentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTournamentsRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GameStarted) GetGameId() string {
+func (x *ListTournamentsRequest) GetSessionToken() string {
 	if x != nil {
-		return x.GameId
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *GameStarted) GetOpponent() *Player {
+type ListTournamentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournaments   []*Tournament          `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTournamentsResponse) Reset() {
+	*x = ListTournamentsResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTournamentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsResponse) ProtoMessage() {}
+
+func (x *ListTournamentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[28]
 	if x != nil {
-		return x.Opponent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameStarted) GetYourTurnFirst() bool {
+// Deprecated: Use ListTournamentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTournamentsResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTournamentsResponse) GetTournaments() []*Tournament {
 	if x != nil {
-		return x.YourTurnFirst
+		return x.Tournaments
 	}
-	return false
+	return nil
 }
 
-type PlacementResult struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Valid              bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	ErrorMessage       string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	WaitingForOpponent bool                   `protobuf:"varint,3,opt,name=waiting_for_opponent,json=waitingForOpponent,proto3" json:"waiting_for_opponent,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RegisterForTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlacementResult) Reset() {
-	*x = PlacementResult{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[24]
+func (x *RegisterForTournamentRequest) Reset() {
+	*x = RegisterForTournamentRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlacementResult) String() string {
+func (x *RegisterForTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlacementResult) ProtoMessage() {}
+func (*RegisterForTournamentRequest) ProtoMessage() {}
 
-func (x *PlacementResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[24]
+func (x *RegisterForTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,55 +2328,49 @@ func (x *PlacementResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlacementResult.ProtoReflect.Descriptor instead.
-func (*PlacementResult) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *PlacementResult) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
+// Deprecated: Use RegisterForTournamentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterForTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *PlacementResult) GetErrorMessage() string {
+func (x *RegisterForTournamentRequest) GetSessionToken() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *PlacementResult) GetWaitingForOpponent() bool {
+func (x *RegisterForTournamentRequest) GetTournamentId() string {
 	if x != nil {
-		return x.WaitingForOpponent
+		return x.TournamentId
 	}
-	return false
+	return ""
 }
 
-type TurnStarted struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	YourTurn        bool                   `protobuf:"varint,1,opt,name=your_turn,json=yourTurn,proto3" json:"your_turn,omitempty"`
-	AvailablePowers []*Power               `protobuf:"bytes,2,rep,name=available_powers,json=availablePowers,proto3" json:"available_powers,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Only open while the tournament has not started.
+type WithdrawFromTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TurnStarted) Reset() {
-	*x = TurnStarted{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[25]
+func (x *WithdrawFromTournamentRequest) Reset() {
+	*x = WithdrawFromTournamentRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TurnStarted) String() string {
+func (x *WithdrawFromTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TurnStarted) ProtoMessage() {}
+func (*WithdrawFromTournamentRequest) ProtoMessage() {}
 
-func (x *TurnStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[25]
+func (x *WithdrawFromTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1527,50 +2381,49 @@ func (x *TurnStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TurnStarted.ProtoReflect.Descriptor instead.
-func (*TurnStarted) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use WithdrawFromTournamentRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawFromTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *TurnStarted) GetYourTurn() bool {
+func (x *WithdrawFromTournamentRequest) GetSessionToken() string {
 	if x != nil {
-		return x.YourTurn
+		return x.SessionToken
 	}
-	return false
+	return ""
 }
 
-func (x *TurnStarted) GetAvailablePowers() []*Power {
+func (x *WithdrawFromTournamentRequest) GetTournamentId() string {
 	if x != nil {
-		return x.AvailablePowers
+		return x.TournamentId
 	}
-	return nil
+	return ""
 }
 
-type AttackResult struct {
+// Only the organizer starts the tournament, with at least two players.
+type StartTournamentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Target        *Coordinate            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Hit           bool                   `protobuf:"varint,2,opt,name=hit,proto3" json:"hit,omitempty"`
-	SunkShip      *Ship                  `protobuf:"bytes,3,opt,name=sunk_ship,json=sunkShip,proto3" json:"sunk_ship,omitempty"`
-	PowerGained   *Power                 `protobuf:"bytes,4,opt,name=power_gained,json=powerGained,proto3" json:"power_gained,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackResult) Reset() {
-	*x = AttackResult{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[26]
+func (x *StartTournamentRequest) Reset() {
+	*x = StartTournamentRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackResult) String() string {
+func (x *StartTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackResult) ProtoMessage() {}
+func (*StartTournamentRequest) ProtoMessage() {}
 
-func (x *AttackResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[26]
+func (x *StartTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,62 +2434,48 @@ func (x *AttackResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackResult.ProtoReflect.Descriptor instead.
-func (*AttackResult) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *AttackResult) GetTarget() *Coordinate {
-	if x != nil {
-		return x.Target
-	}
-	return nil
-}
-
-func (x *AttackResult) GetHit() bool {
-	if x != nil {
-		return x.Hit
-	}
-	return false
+// Deprecated: Use StartTournamentRequest.ProtoReflect.Descriptor instead.
+func (*StartTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AttackResult) GetSunkShip() *Ship {
+func (x *StartTournamentRequest) GetSessionToken() string {
 	if x != nil {
-		return x.SunkShip
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *AttackResult) GetPowerGained() *Power {
+func (x *StartTournamentRequest) GetTournamentId() string {
 	if x != nil {
-		return x.PowerGained
+		return x.TournamentId
 	}
-	return nil
+	return ""
 }
 
-type CellReveal struct {
+type GetTournamentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Position      *Coordinate            `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	State         CellState              `protobuf:"varint,2,opt,name=state,proto3,enum=pirates.v1.CellState" json:"state,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CellReveal) Reset() {
-	*x = CellReveal{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[27]
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CellReveal) String() string {
+func (x *GetTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CellReveal) ProtoMessage() {}
+func (*GetTournamentRequest) ProtoMessage() {}
 
-func (x *CellReveal) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[27]
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,50 +2486,2602 @@ func (x *CellReveal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CellReveal.ProtoReflect.Descriptor instead.
-func (*CellReveal) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CellReveal) GetPosition() *Coordinate {
+func (x *GetTournamentRequest) GetSessionToken() string {
 	if x != nil {
-		return x.Position
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *CellReveal) GetState() CellState {
+func (x *GetTournamentRequest) GetTournamentId() string {
 	if x != nil {
-		return x.State
+		return x.TournamentId
 	}
-	return CellState_CELL_STATE_UNKNOWN
+	return ""
 }
 
-type PowerResult struct {
+// Starts the day's puzzle, or resumes it: each player gets one attempt a
+// day.
+type StartDailyPuzzleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PowerUsed     PowerType              `protobuf:"varint,1,opt,name=power_used,json=powerUsed,proto3,enum=pirates.v1.PowerType" json:"power_used,omitempty"`
-	CellsAffected []*CellReveal          `protobuf:"bytes,2,rep,name=cells_affected,json=cellsAffected,proto3" json:"cells_affected,omitempty"`
-	SunkShips     []*Ship                `protobuf:"bytes,3,rep,name=sunk_ships,json=sunkShips,proto3" json:"sunk_ships,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PowerResult) Reset() {
-	*x = PowerResult{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[28]
+func (x *StartDailyPuzzleRequest) Reset() {
+	*x = StartDailyPuzzleRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PowerResult) String() string {
+func (x *StartDailyPuzzleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PowerResult) ProtoMessage() {}
+func (*StartDailyPuzzleRequest) ProtoMessage() {}
 
-func (x *PowerResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[28]
+func (x *StartDailyPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDailyPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*StartDailyPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StartDailyPuzzleRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// Fires at the puzzle last started.
+type PuzzleAttackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Target        *Coordinate            `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleAttackRequest) Reset() {
+	*x = PuzzleAttackRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleAttackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleAttackRequest) ProtoMessage() {}
+
+func (x *PuzzleAttackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleAttackRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleAttackRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PuzzleAttackRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *PuzzleAttackRequest) GetTarget() *Coordinate {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type PuzzleUsePowerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Power         PowerType              `protobuf:"varint,2,opt,name=power,proto3,enum=pirates.v1.PowerType" json:"power,omitempty"`
+	Target        *Coordinate            `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Orientation   Orientation            `protobuf:"varint,4,opt,name=orientation,proto3,enum=pirates.v1.Orientation" json:"orientation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleUsePowerRequest) Reset() {
+	*x = PuzzleUsePowerRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleUsePowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleUsePowerRequest) ProtoMessage() {}
+
+func (x *PuzzleUsePowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleUsePowerRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleUsePowerRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PuzzleUsePowerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *PuzzleUsePowerRequest) GetPower() PowerType {
+	if x != nil {
+		return x.Power
+	}
+	return PowerType_POWER_TYPE_UNSPECIFIED
+}
+
+func (x *PuzzleUsePowerRequest) GetTarget() *Coordinate {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *PuzzleUsePowerRequest) GetOrientation() Orientation {
+	if x != nil {
+		return x.Orientation
+	}
+	return Orientation_ORIENTATION_UNSPECIFIED
+}
+
+type GetPuzzleLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, in UTC; empty for today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPuzzleLeaderboardRequest) Reset() {
+	*x = GetPuzzleLeaderboardRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPuzzleLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPuzzleLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetPuzzleLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPuzzleLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetPuzzleLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPuzzleLeaderboardRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetPuzzleLeaderboardRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetPlayerProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Empty for yourself
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerProfileRequest) Reset() {
+	*x = GetPlayerProfileRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerProfileRequest) ProtoMessage() {}
+
+func (x *GetPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetPlayerProfileRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetPlayerProfileRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Scope         LeaderboardScope       `protobuf:"varint,2,opt,name=scope,proto3,enum=pirates.v1.LeaderboardScope" json:"scope,omitempty"`
+	RuleSet       RuleSet                `protobuf:"varint,3,opt,name=rule_set,json=ruleSet,proto3,enum=pirates.v1.RuleSet" json:"rule_set,omitempty"` // With LEADERBOARD_SCOPE_RULE_SET
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                      // 20 when unset, at most 100
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // From the previous page; empty for the first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetLeaderboardRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetScope() LeaderboardScope {
+	if x != nil {
+		return x.Scope
+	}
+	return LeaderboardScope_LEADERBOARD_SCOPE_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetRuleSet() RuleSet {
+	if x != nil {
+		return x.RuleSet
+	}
+	return RuleSet_RULE_SET_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Either player_id or display_name must be set.
+type SendFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Case-insensitive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRequest) ProtoMessage() {}
+
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SendFriendRequestRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SendFriendRequestRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SendFriendRequestRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type RespondToFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Who sent the request
+	Accepted      bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToFriendRequestRequest) Reset() {
+	*x = RespondToFriendRequestRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToFriendRequestRequest) ProtoMessage() {}
+
+func (x *RespondToFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*RespondToFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RespondToFriendRequestRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RespondToFriendRequestRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RespondToFriendRequestRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type RemoveFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequest) ProtoMessage() {}
+
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveFriendRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RemoveFriendRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type BlockPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockPlayerRequest) Reset() {
+	*x = BlockPlayerRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockPlayerRequest) ProtoMessage() {}
+
+func (x *BlockPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockPlayerRequest.ProtoReflect.Descriptor instead.
+func (*BlockPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BlockPlayerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *BlockPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type UnblockPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockPlayerRequest) Reset() {
+	*x = UnblockPlayerRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockPlayerRequest) ProtoMessage() {}
+
+func (x *UnblockPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockPlayerRequest.ProtoReflect.Descriptor instead.
+func (*UnblockPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UnblockPlayerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *UnblockPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendsRequest) Reset() {
+	*x = GetFriendsRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendsRequest) ProtoMessage() {}
+
+func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetFriendsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type ChallengeFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Case-insensitive
+	Rules         *GameRules             `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`                                // As in ChallengePlayerRequest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeFriendRequest) Reset() {
+	*x = ChallengeFriendRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeFriendRequest) ProtoMessage() {}
+
+func (x *ChallengeFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeFriendRequest.ProtoReflect.Descriptor instead.
+func (*ChallengeFriendRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ChallengeFriendRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ChallengeFriendRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ChallengeFriendRequest) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ChallengeFriendResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"` // Empty when queued
+	// The friend is in a game: the challenge is proposed once it is over.
+	Queued        bool `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeFriendResponse) Reset() {
+	*x = ChallengeFriendResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeFriendResponse) ProtoMessage() {}
+
+func (x *ChallengeFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeFriendResponse.ProtoReflect.Descriptor instead.
+func (*ChallengeFriendResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChallengeFriendResponse) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *ChallengeFriendResponse) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+type ForfeitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForfeitRequest) Reset() {
+	*x = ForfeitRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForfeitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForfeitRequest) ProtoMessage() {}
+
+func (x *ForfeitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForfeitRequest.ProtoReflect.Descriptor instead.
+func (*ForfeitRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ForfeitRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type ForfeitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForfeitResponse) Reset() {
+	*x = ForfeitResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForfeitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForfeitResponse) ProtoMessage() {}
+
+func (x *ForfeitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForfeitResponse.ProtoReflect.Descriptor instead.
+func (*ForfeitResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{48}
+}
+
+type PlaceShipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Ships         []*Ship                `protobuf:"bytes,2,rep,name=ships,proto3" json:"ships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceShipsRequest) Reset() {
+	*x = PlaceShipsRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceShipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceShipsRequest) ProtoMessage() {}
+
+func (x *PlaceShipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceShipsRequest.ProtoReflect.Descriptor instead.
+func (*PlaceShipsRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PlaceShipsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *PlaceShipsRequest) GetShips() []*Ship {
+	if x != nil {
+		return x.Ships
+	}
+	return nil
+}
+
+type AttackRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Target       *Coordinate            `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The opponent fired at. Empty targets the next opponent still in the
+	// game, the only one in a two-player game.
+	TargetPlayerId string `protobuf:"bytes,3,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttackRequest) Reset() {
+	*x = AttackRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackRequest) ProtoMessage() {}
+
+func (x *AttackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackRequest.ProtoReflect.Descriptor instead.
+func (*AttackRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AttackRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *AttackRequest) GetTarget() *Coordinate {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *AttackRequest) GetTargetPlayerId() string {
+	if x != nil {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+type AttackSalvoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Targets        []*Coordinate          `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	TargetPlayerId string                 `protobuf:"bytes,3,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"` // As in AttackRequest
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttackSalvoRequest) Reset() {
+	*x = AttackSalvoRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackSalvoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackSalvoRequest) ProtoMessage() {}
+
+func (x *AttackSalvoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackSalvoRequest.ProtoReflect.Descriptor instead.
+func (*AttackSalvoRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AttackSalvoRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *AttackSalvoRequest) GetTargets() []*Coordinate {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *AttackSalvoRequest) GetTargetPlayerId() string {
+	if x != nil {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+type UsePowerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Power          PowerType              `protobuf:"varint,2,opt,name=power,proto3,enum=pirates.v1.PowerType" json:"power,omitempty"`
+	Target         *Coordinate            `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`          // On the user's own grid for defensive powers
+	Horizontal     bool                   `protobuf:"varint,4,opt,name=horizontal,proto3" json:"horizontal,omitempty"` // Deprecated: use orientation
+	Orientation    Orientation            `protobuf:"varint,5,opt,name=orientation,proto3,enum=pirates.v1.Orientation" json:"orientation,omitempty"`
+	TargetPlayerId string                 `protobuf:"bytes,6,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"` // As in AttackRequest; ignored by defensive powers
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UsePowerRequest) Reset() {
+	*x = UsePowerRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsePowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsePowerRequest) ProtoMessage() {}
+
+func (x *UsePowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsePowerRequest.ProtoReflect.Descriptor instead.
+func (*UsePowerRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UsePowerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *UsePowerRequest) GetPower() PowerType {
+	if x != nil {
+		return x.Power
+	}
+	return PowerType_POWER_TYPE_UNSPECIFIED
+}
+
+func (x *UsePowerRequest) GetTarget() *Coordinate {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *UsePowerRequest) GetHorizontal() bool {
+	if x != nil {
+		return x.Horizontal
+	}
+	return false
+}
+
+func (x *UsePowerRequest) GetOrientation() Orientation {
+	if x != nil {
+		return x.Orientation
+	}
+	return Orientation_ORIENTATION_UNSPECIFIED
+}
+
+func (x *UsePowerRequest) GetTargetPlayerId() string {
+	if x != nil {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+type PreviewPowerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Power          PowerType              `protobuf:"varint,2,opt,name=power,proto3,enum=pirates.v1.PowerType" json:"power,omitempty"`
+	Target         *Coordinate            `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Horizontal     bool                   `protobuf:"varint,4,opt,name=horizontal,proto3" json:"horizontal,omitempty"` // Deprecated: use orientation
+	Orientation    Orientation            `protobuf:"varint,5,opt,name=orientation,proto3,enum=pirates.v1.Orientation" json:"orientation,omitempty"`
+	TargetPlayerId string                 `protobuf:"bytes,6,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"` // As in AttackRequest
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewPowerRequest) Reset() {
+	*x = PreviewPowerRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewPowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewPowerRequest) ProtoMessage() {}
+
+func (x *PreviewPowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewPowerRequest.ProtoReflect.Descriptor instead.
+func (*PreviewPowerRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PreviewPowerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *PreviewPowerRequest) GetPower() PowerType {
+	if x != nil {
+		return x.Power
+	}
+	return PowerType_POWER_TYPE_UNSPECIFIED
+}
+
+func (x *PreviewPowerRequest) GetTarget() *Coordinate {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *PreviewPowerRequest) GetHorizontal() bool {
+	if x != nil {
+		return x.Horizontal
+	}
+	return false
+}
+
+func (x *PreviewPowerRequest) GetOrientation() Orientation {
+	if x != nil {
+		return x.Orientation
+	}
+	return Orientation_ORIENTATION_UNSPECIFIED
+}
+
+func (x *PreviewPowerRequest) GetTargetPlayerId() string {
+	if x != nil {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+// PowerPreview lists the cells a power would touch, without their contents.
+type PowerPreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*Coordinate          `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	OwnGrid       bool                   `protobuf:"varint,2,opt,name=own_grid,json=ownGrid,proto3" json:"own_grid,omitempty"` // Defensive powers: the cells are on the user's grid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerPreview) Reset() {
+	*x = PowerPreview{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerPreview) ProtoMessage() {}
+
+func (x *PowerPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerPreview.ProtoReflect.Descriptor instead.
+func (*PowerPreview) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PowerPreview) GetCells() []*Coordinate {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *PowerPreview) GetOwnGrid() bool {
+	if x != nil {
+		return x.OwnGrid
+	}
+	return false
+}
+
+// Either text or emote must be set.
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Scope         ChatScope              `protobuf:"varint,2,opt,name=scope,proto3,enum=pirates.v1.ChatScope" json:"scope,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Emote         QuickEmote             `protobuf:"varint,4,opt,name=emote,proto3,enum=pirates.v1.QuickEmote" json:"emote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SendChatMessageRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetScope() ChatScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ChatScope_CHAT_SCOPE_UNSPECIFIED
+}
+
+func (x *SendChatMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetEmote() QuickEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return QuickEmote_QUICK_EMOTE_UNSPECIFIED
+}
+
+type SendChatMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageResponse) Reset() {
+	*x = SendChatMessageResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageResponse) ProtoMessage() {}
+
+func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SendChatMessageResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MutePlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Muted         bool                   `protobuf:"varint,3,opt,name=muted,proto3" json:"muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutePlayerRequest) Reset() {
+	*x = MutePlayerRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutePlayerRequest) ProtoMessage() {}
+
+func (x *MutePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutePlayerRequest.ProtoReflect.Descriptor instead.
+func (*MutePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MutePlayerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *MutePlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MutePlayerRequest) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+type MutePlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutePlayerResponse) Reset() {
+	*x = MutePlayerResponse{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutePlayerResponse) ProtoMessage() {}
+
+func (x *MutePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutePlayerResponse.ProtoReflect.Descriptor instead.
+func (*MutePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{58}
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SubscribeEventsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type QueueStatusUpdate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InQueue        bool                   `protobuf:"varint,1,opt,name=in_queue,json=inQueue,proto3" json:"in_queue,omitempty"`
+	QueuePosition  int32                  `protobuf:"varint,2,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`      // In your queue
+	PlayersInQueue int32                  `protobuf:"varint,3,opt,name=players_in_queue,json=playersInQueue,proto3" json:"players_in_queue,omitempty"` // In your queue
+	Queue          string                 `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`                                            // The queue you wait in
+	Queues         []*QueueInfo           `protobuf:"bytes,5,rep,name=queues,proto3" json:"queues,omitempty"`                                          // Every queue you may join
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueueStatusUpdate) Reset() {
+	*x = QueueStatusUpdate{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatusUpdate) ProtoMessage() {}
+
+func (x *QueueStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatusUpdate.ProtoReflect.Descriptor instead.
+func (*QueueStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *QueueStatusUpdate) GetInQueue() bool {
+	if x != nil {
+		return x.InQueue
+	}
+	return false
+}
+
+func (x *QueueStatusUpdate) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *QueueStatusUpdate) GetPlayersInQueue() int32 {
+	if x != nil {
+		return x.PlayersInQueue
+	}
+	return 0
+}
+
+func (x *QueueStatusUpdate) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *QueueStatusUpdate) GetQueues() []*QueueInfo {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+// QueueInfo describes a matchmaking queue. Its matches are played with its
+// rules.
+type QueueInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rules                *GameRules             `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
+	RatingMatched        bool                   `protobuf:"varint,3,opt,name=rating_matched,json=ratingMatched,proto3" json:"rating_matched,omitempty"`    // Players are matched with close ratings
+	TimeoutSeconds       int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // How long you wait before dropping out
+	Players              int32                  `protobuf:"varint,5,opt,name=players,proto3" json:"players,omitempty"`
+	EstimatedWaitSeconds int32                  `protobuf:"varint,6,opt,name=estimated_wait_seconds,json=estimatedWaitSeconds,proto3" json:"estimated_wait_seconds,omitempty"` // 0 until the queue matched someone
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QueueInfo) Reset() {
+	*x = QueueInfo{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueInfo) ProtoMessage() {}
+
+func (x *QueueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueInfo.ProtoReflect.Descriptor instead.
+func (*QueueInfo) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QueueInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueueInfo) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *QueueInfo) GetRatingMatched() bool {
+	if x != nil {
+		return x.RatingMatched
+	}
+	return false
+}
+
+func (x *QueueInfo) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *QueueInfo) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *QueueInfo) GetEstimatedWaitSeconds() int32 {
+	if x != nil {
+		return x.EstimatedWaitSeconds
+	}
+	return 0
+}
+
+type PlayerListUpdate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AvailablePlayers []*Player              `protobuf:"bytes,1,rep,name=available_players,json=availablePlayers,proto3" json:"available_players,omitempty"`
+	// Incremental lobby push: players who connected or changed status, and
+	// players who left the server since the previous update.
+	ChangedPlayers    []*Player `protobuf:"bytes,2,rep,name=changed_players,json=changedPlayers,proto3" json:"changed_players,omitempty"`
+	DepartedPlayerIds []string  `protobuf:"bytes,3,rep,name=departed_player_ids,json=departedPlayerIds,proto3" json:"departed_player_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlayerListUpdate) Reset() {
+	*x = PlayerListUpdate{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerListUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerListUpdate) ProtoMessage() {}
+
+func (x *PlayerListUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerListUpdate.ProtoReflect.Descriptor instead.
+func (*PlayerListUpdate) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PlayerListUpdate) GetAvailablePlayers() []*Player {
+	if x != nil {
+		return x.AvailablePlayers
+	}
+	return nil
+}
+
+func (x *PlayerListUpdate) GetChangedPlayers() []*Player {
+	if x != nil {
+		return x.ChangedPlayers
+	}
+	return nil
+}
+
+func (x *PlayerListUpdate) GetDepartedPlayerIds() []string {
+	if x != nil {
+		return x.DepartedPlayerIds
+	}
+	return nil
+}
+
+type MatchProposal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MatchId        string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Opponent       *Player                `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	YouInitiated   bool                   `protobuf:"varint,3,opt,name=you_initiated,json=youInitiated,proto3" json:"you_initiated,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Rules          *GameRules             `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+	Opponents      []*Player              `protobuf:"bytes,6,rep,name=opponents,proto3" json:"opponents,omitempty"`                           // Every opponent of the match
+	Allies         []*Player              `protobuf:"bytes,7,rep,name=allies,proto3" json:"allies,omitempty"`                                 // Team matches: your teammate
+	TournamentId   string                 `protobuf:"bytes,8,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"` // Set for a tournament match
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MatchProposal) Reset() {
+	*x = MatchProposal{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchProposal) ProtoMessage() {}
+
+func (x *MatchProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchProposal.ProtoReflect.Descriptor instead.
+func (*MatchProposal) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MatchProposal) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchProposal) GetOpponent() *Player {
+	if x != nil {
+		return x.Opponent
+	}
+	return nil
+}
+
+func (x *MatchProposal) GetYouInitiated() bool {
+	if x != nil {
+		return x.YouInitiated
+	}
+	return false
+}
+
+func (x *MatchProposal) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *MatchProposal) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *MatchProposal) GetOpponents() []*Player {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *MatchProposal) GetAllies() []*Player {
+	if x != nil {
+		return x.Allies
+	}
+	return nil
+}
+
+func (x *MatchProposal) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type MatchResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MatchId         string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Accepted        bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	RejectionReason string                 `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MatchResult) Reset() {
+	*x = MatchResult{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResult) ProtoMessage() {}
+
+func (x *MatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
+func (*MatchResult) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MatchResult) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchResult) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *MatchResult) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type GameStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Opponent      *Player                `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	YourTurnFirst bool                   `protobuf:"varint,3,opt,name=your_turn_first,json=yourTurnFirst,proto3" json:"your_turn_first,omitempty"`
+	Rules         *GameRules             `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	Terrain       *Terrain               `protobuf:"bytes,5,opt,name=terrain,proto3" json:"terrain,omitempty"`     // Set when the rules enable terrain
+	Opponents     []*Player              `protobuf:"bytes,6,rep,name=opponents,proto3" json:"opponents,omitempty"` // Every opponent, in turn order from you
+	Allies        []*Player              `protobuf:"bytes,7,rep,name=allies,proto3" json:"allies,omitempty"`       // Team games: your teammate
+	Series        *Series                `protobuf:"bytes,8,opt,name=series,proto3" json:"series,omitempty"`       // Set when the match is a series of games
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameStarted) Reset() {
+	*x = GameStarted{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStarted) ProtoMessage() {}
+
+func (x *GameStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStarted.ProtoReflect.Descriptor instead.
+func (*GameStarted) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GameStarted) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameStarted) GetOpponent() *Player {
+	if x != nil {
+		return x.Opponent
+	}
+	return nil
+}
+
+func (x *GameStarted) GetYourTurnFirst() bool {
+	if x != nil {
+		return x.YourTurnFirst
+	}
+	return false
+}
+
+func (x *GameStarted) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GameStarted) GetTerrain() *Terrain {
+	if x != nil {
+		return x.Terrain
+	}
+	return nil
+}
+
+func (x *GameStarted) GetOpponents() []*Player {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *GameStarted) GetAllies() []*Player {
+	if x != nil {
+		return x.Allies
+	}
+	return nil
+}
+
+func (x *GameStarted) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type PlacementResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Valid              bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	ErrorMessage       string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	WaitingForOpponent bool                   `protobuf:"varint,3,opt,name=waiting_for_opponent,json=waitingForOpponent,proto3" json:"waiting_for_opponent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PlacementResult) Reset() {
+	*x = PlacementResult{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementResult) ProtoMessage() {}
+
+func (x *PlacementResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementResult.ProtoReflect.Descriptor instead.
+func (*PlacementResult) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PlacementResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *PlacementResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PlacementResult) GetWaitingForOpponent() bool {
+	if x != nil {
+		return x.WaitingForOpponent
+	}
+	return false
+}
+
+type TurnStarted struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	YourTurn        bool                   `protobuf:"varint,1,opt,name=your_turn,json=yourTurn,proto3" json:"your_turn,omitempty"`
+	AvailablePowers []*Power               `protobuf:"bytes,2,rep,name=available_powers,json=availablePowers,proto3" json:"available_powers,omitempty"`
+	SalvoShots      int32                  `protobuf:"varint,3,opt,name=salvo_shots,json=salvoShots,proto3" json:"salvo_shots,omitempty"` // Salvo games: number of targets the salvo must have
+	BonusTurn       bool                   `protobuf:"varint,4,opt,name=bonus_turn,json=bonusTurn,proto3" json:"bonus_turn,omitempty"`    // The same player plays again after a hit
+	View            *GameView              `protobuf:"bytes,5,opt,name=view,proto3" json:"view,omitempty"`                                // Every board as known to you
+	CurrentPlayerId string                 `protobuf:"bytes,6,opt,name=current_player_id,json=currentPlayerId,proto3" json:"current_player_id,omitempty"`
+	Eliminated      bool                   `protobuf:"varint,7,opt,name=eliminated,proto3" json:"eliminated,omitempty"`                                 // You are out of the game and watch it as a spectator
+	DeadlineUnixMs  int64                  `protobuf:"varint,8,opt,name=deadline_unix_ms,json=deadlineUnixMs,proto3" json:"deadline_unix_ms,omitempty"` // Timed turns: the current player is out past it
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TurnStarted) Reset() {
+	*x = TurnStarted{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnStarted) ProtoMessage() {}
+
+func (x *TurnStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnStarted.ProtoReflect.Descriptor instead.
+func (*TurnStarted) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TurnStarted) GetYourTurn() bool {
+	if x != nil {
+		return x.YourTurn
+	}
+	return false
+}
+
+func (x *TurnStarted) GetAvailablePowers() []*Power {
+	if x != nil {
+		return x.AvailablePowers
+	}
+	return nil
+}
+
+func (x *TurnStarted) GetSalvoShots() int32 {
+	if x != nil {
+		return x.SalvoShots
+	}
+	return 0
+}
+
+func (x *TurnStarted) GetBonusTurn() bool {
+	if x != nil {
+		return x.BonusTurn
+	}
+	return false
+}
+
+func (x *TurnStarted) GetView() *GameView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+func (x *TurnStarted) GetCurrentPlayerId() string {
+	if x != nil {
+		return x.CurrentPlayerId
+	}
+	return ""
+}
+
+func (x *TurnStarted) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+func (x *TurnStarted) GetDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.DeadlineUnixMs
+	}
+	return 0
+}
+
+type AttackResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *Coordinate            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Hit           bool                   `protobuf:"varint,2,opt,name=hit,proto3" json:"hit,omitempty"`
+	SunkShip      *Ship                  `protobuf:"bytes,3,opt,name=sunk_ship,json=sunkShip,proto3" json:"sunk_ship,omitempty"`
+	PowerGained   *Power                 `protobuf:"bytes,4,opt,name=power_gained,json=powerGained,proto3" json:"power_gained,omitempty"`
+	Island        bool                   `protobuf:"varint,5,opt,name=island,proto3" json:"island,omitempty"`                       // The shot landed on an island and was wasted
+	Mine          bool                   `protobuf:"varint,6,opt,name=mine,proto3" json:"mine,omitempty"`                           // The shot set off a mine
+	MineBlast     *AttackResult          `protobuf:"bytes,7,opt,name=mine_blast,json=mineBlast,proto3" json:"mine_blast,omitempty"` // The mine's damage to the attacker's own fleet
+	Shielded      bool                   `protobuf:"varint,8,opt,name=shielded,proto3" json:"shielded,omitempty"`                   // A shield absorbed the shot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackResult) Reset() {
+	*x = AttackResult{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackResult) ProtoMessage() {}
+
+func (x *AttackResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackResult.ProtoReflect.Descriptor instead.
+func (*AttackResult) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AttackResult) GetTarget() *Coordinate {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *AttackResult) GetHit() bool {
+	if x != nil {
+		return x.Hit
+	}
+	return false
+}
+
+func (x *AttackResult) GetSunkShip() *Ship {
+	if x != nil {
+		return x.SunkShip
+	}
+	return nil
+}
+
+func (x *AttackResult) GetPowerGained() *Power {
+	if x != nil {
+		return x.PowerGained
+	}
+	return nil
+}
+
+func (x *AttackResult) GetIsland() bool {
+	if x != nil {
+		return x.Island
+	}
+	return false
+}
+
+func (x *AttackResult) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+func (x *AttackResult) GetMineBlast() *AttackResult {
+	if x != nil {
+		return x.MineBlast
+	}
+	return nil
+}
+
+func (x *AttackResult) GetShielded() bool {
+	if x != nil {
+		return x.Shielded
+	}
+	return false
+}
+
+// SalvoResult is the outcome of every shot of a salvo, resolved together.
+type SalvoResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shots         []*AttackResult        `protobuf:"bytes,1,rep,name=shots,proto3" json:"shots,omitempty"`
+	SunkShips     []*Ship                `protobuf:"bytes,2,rep,name=sunk_ships,json=sunkShips,proto3" json:"sunk_ships,omitempty"`
+	PowersGained  []*Power               `protobuf:"bytes,3,rep,name=powers_gained,json=powersGained,proto3" json:"powers_gained,omitempty"` // Powers granted to the defender
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalvoResult) Reset() {
+	*x = SalvoResult{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalvoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalvoResult) ProtoMessage() {}
+
+func (x *SalvoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalvoResult.ProtoReflect.Descriptor instead.
+func (*SalvoResult) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SalvoResult) GetShots() []*AttackResult {
+	if x != nil {
+		return x.Shots
+	}
+	return nil
+}
+
+func (x *SalvoResult) GetSunkShips() []*Ship {
+	if x != nil {
+		return x.SunkShips
+	}
+	return nil
+}
+
+func (x *SalvoResult) GetPowersGained() []*Power {
+	if x != nil {
+		return x.PowersGained
+	}
+	return nil
+}
+
+type CellReveal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *Coordinate            `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	State         CellState              `protobuf:"varint,2,opt,name=state,proto3,enum=pirates.v1.CellState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CellReveal) Reset() {
+	*x = CellReveal{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellReveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellReveal) ProtoMessage() {}
+
+func (x *CellReveal) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellReveal.ProtoReflect.Descriptor instead.
+func (*CellReveal) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CellReveal) GetPosition() *Coordinate {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *CellReveal) GetState() CellState {
+	if x != nil {
+		return x.State
+	}
+	return CellState_CELL_STATE_UNKNOWN
+}
+
+// BoardView is one board as a player is allowed to see it. Cells nothing is
+// known about are left out.
+type BoardView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*CellReveal          `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	Ships         []*Ship                `protobuf:"bytes,2,rep,name=ships,proto3" json:"ships,omitempty"` // Your whole fleet, or the opponent's sunk ships
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardView) Reset() {
+	*x = BoardView{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardView) ProtoMessage() {}
+
+func (x *BoardView) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardView.ProtoReflect.Descriptor instead.
+func (*BoardView) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *BoardView) GetCells() []*CellReveal {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *BoardView) GetShips() []*Ship {
+	if x != nil {
+		return x.Ships
+	}
+	return nil
+}
+
+// GameView is everything a player may know about the boards.
+type GameView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YourBoard     *BoardView             `protobuf:"bytes,1,opt,name=your_board,json=yourBoard,proto3" json:"your_board,omitempty"`
+	OpponentBoard *BoardView             `protobuf:"bytes,2,opt,name=opponent_board,json=opponentBoard,proto3" json:"opponent_board,omitempty"` // The next opponent still in the game
+	Opponents     []*PlayerBoard         `protobuf:"bytes,3,rep,name=opponents,proto3" json:"opponents,omitempty"`                              // Every opponent, in turn order from you
+	Allies        []*PlayerBoard         `protobuf:"bytes,4,rep,name=allies,proto3" json:"allies,omitempty"`                                    // Team games: your teammate's board, in full
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameView) Reset() {
+	*x = GameView{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameView) ProtoMessage() {}
+
+func (x *GameView) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameView.ProtoReflect.Descriptor instead.
+func (*GameView) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GameView) GetYourBoard() *BoardView {
+	if x != nil {
+		return x.YourBoard
+	}
+	return nil
+}
+
+func (x *GameView) GetOpponentBoard() *BoardView {
+	if x != nil {
+		return x.OpponentBoard
+	}
+	return nil
+}
+
+func (x *GameView) GetOpponents() []*PlayerBoard {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *GameView) GetAllies() []*PlayerBoard {
+	if x != nil {
+		return x.Allies
+	}
+	return nil
+}
+
+type PlayerBoard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Board         *BoardView             `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Eliminated    bool                   `protobuf:"varint,3,opt,name=eliminated,proto3" json:"eliminated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerBoard) Reset() {
+	*x = PlayerBoard{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerBoard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerBoard) ProtoMessage() {}
+
+func (x *PlayerBoard) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerBoard.ProtoReflect.Descriptor instead.
+func (*PlayerBoard) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PlayerBoard) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerBoard) GetBoard() *BoardView {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *PlayerBoard) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+type PowerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PowerUsed     PowerType              `protobuf:"varint,1,opt,name=power_used,json=powerUsed,proto3,enum=pirates.v1.PowerType" json:"power_used,omitempty"`
+	CellsAffected []*CellReveal          `protobuf:"bytes,2,rep,name=cells_affected,json=cellsAffected,proto3" json:"cells_affected,omitempty"`
+	SunkShips     []*Ship                `protobuf:"bytes,3,rep,name=sunk_ships,json=sunkShips,proto3" json:"sunk_ships,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	MineBlasts    []*AttackResult        `protobuf:"bytes,5,rep,name=mine_blasts,json=mineBlasts,proto3" json:"mine_blasts,omitempty"`       // Damage to the attacker's own fleet
+	OwnGrid       bool                   `protobuf:"varint,6,opt,name=own_grid,json=ownGrid,proto3" json:"own_grid,omitempty"`               // Defensive powers: cells_affected are on the user's grid
+	PowersGained  []*Power               `protobuf:"bytes,7,rep,name=powers_gained,json=powersGained,proto3" json:"powers_gained,omitempty"` // Powers granted to the defender
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerResult) Reset() {
+	*x = PowerResult{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerResult) ProtoMessage() {}
+
+func (x *PowerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,64 +5094,2146 @@ func (x *PowerResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerResult.ProtoReflect.Descriptor instead.
 func (*PowerResult) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{28}
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PowerResult) GetPowerUsed() PowerType {
+	if x != nil {
+		return x.PowerUsed
+	}
+	return PowerType_POWER_TYPE_UNSPECIFIED
+}
+
+func (x *PowerResult) GetCellsAffected() []*CellReveal {
+	if x != nil {
+		return x.CellsAffected
+	}
+	return nil
+}
+
+func (x *PowerResult) GetSunkShips() []*Ship {
+	if x != nil {
+		return x.SunkShips
+	}
+	return nil
+}
+
+func (x *PowerResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PowerResult) GetMineBlasts() []*AttackResult {
+	if x != nil {
+		return x.MineBlasts
+	}
+	return nil
+}
+
+func (x *PowerResult) GetOwnGrid() bool {
+	if x != nil {
+		return x.OwnGrid
+	}
+	return false
+}
+
+func (x *PowerResult) GetPowersGained() []*Power {
+	if x != nil {
+		return x.PowersGained
+	}
+	return nil
+}
+
+type OpponentAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*OpponentAction_Attack
+	//	*OpponentAction_Power
+	//	*OpponentAction_Salvo
+	Action isOpponentAction_Action `protobuf_oneof:"action"`
+	// What the action did to your grid. Sonar scans come back as REVEALED,
+	// whatever the cell holds. Empty when another player was targeted.
+	YourGridUpdates []*CellReveal `protobuf:"bytes,3,rep,name=your_grid_updates,json=yourGridUpdates,proto3" json:"your_grid_updates,omitempty"`
+	AttackerId      string        `protobuf:"bytes,5,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"`
+	TargetPlayerId  string        `protobuf:"bytes,6,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"` // Empty for defensive powers
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OpponentAction) Reset() {
+	*x = OpponentAction{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpponentAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentAction) ProtoMessage() {}
+
+func (x *OpponentAction) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentAction.ProtoReflect.Descriptor instead.
+func (*OpponentAction) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *OpponentAction) GetAction() isOpponentAction_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *OpponentAction) GetAttack() *AttackResult {
+	if x != nil {
+		if x, ok := x.Action.(*OpponentAction_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+func (x *OpponentAction) GetPower() *PowerResult {
+	if x != nil {
+		if x, ok := x.Action.(*OpponentAction_Power); ok {
+			return x.Power
+		}
+	}
+	return nil
+}
+
+func (x *OpponentAction) GetSalvo() *SalvoResult {
+	if x != nil {
+		if x, ok := x.Action.(*OpponentAction_Salvo); ok {
+			return x.Salvo
+		}
+	}
+	return nil
+}
+
+func (x *OpponentAction) GetYourGridUpdates() []*CellReveal {
+	if x != nil {
+		return x.YourGridUpdates
+	}
+	return nil
+}
+
+func (x *OpponentAction) GetAttackerId() string {
+	if x != nil {
+		return x.AttackerId
+	}
+	return ""
+}
+
+func (x *OpponentAction) GetTargetPlayerId() string {
+	if x != nil {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+type isOpponentAction_Action interface {
+	isOpponentAction_Action()
+}
+
+type OpponentAction_Attack struct {
+	Attack *AttackResult `protobuf:"bytes,1,opt,name=attack,proto3,oneof"`
+}
+
+type OpponentAction_Power struct {
+	Power *PowerResult `protobuf:"bytes,2,opt,name=power,proto3,oneof"`
+}
+
+type OpponentAction_Salvo struct {
+	Salvo *SalvoResult `protobuf:"bytes,4,opt,name=salvo,proto3,oneof"`
+}
+
+func (*OpponentAction_Attack) isOpponentAction_Action() {}
+
+func (*OpponentAction_Power) isOpponentAction_Action() {}
+
+func (*OpponentAction_Salvo) isOpponentAction_Action() {}
+
+type FleetShip struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ship          *Ship                  `protobuf:"bytes,1,opt,name=ship,proto3" json:"ship,omitempty"`
+	Hits          int32                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Sunk          bool                   `protobuf:"varint,3,opt,name=sunk,proto3" json:"sunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FleetShip) Reset() {
+	*x = FleetShip{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetShip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetShip) ProtoMessage() {}
+
+func (x *FleetShip) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetShip.ProtoReflect.Descriptor instead.
+func (*FleetShip) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *FleetShip) GetShip() *Ship {
+	if x != nil {
+		return x.Ship
+	}
+	return nil
+}
+
+func (x *FleetShip) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *FleetShip) GetSunk() bool {
+	if x != nil {
+		return x.Sunk
+	}
+	return false
+}
+
+type PlayerGameStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShotsFired    int32                  `protobuf:"varint,1,opt,name=shots_fired,json=shotsFired,proto3" json:"shots_fired,omitempty"`
+	Hits          int32                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Accuracy      float32                `protobuf:"fixed32,3,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	PowersUsed    []PowerType            `protobuf:"varint,4,rep,packed,name=powers_used,json=powersUsed,proto3,enum=pirates.v1.PowerType" json:"powers_used,omitempty"`
+	ShipsSunk     int32                  `protobuf:"varint,5,opt,name=ships_sunk,json=shipsSunk,proto3" json:"ships_sunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerGameStats) Reset() {
+	*x = PlayerGameStats{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerGameStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGameStats) ProtoMessage() {}
+
+func (x *PlayerGameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGameStats.ProtoReflect.Descriptor instead.
+func (*PlayerGameStats) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PlayerGameStats) GetShotsFired() int32 {
+	if x != nil {
+		return x.ShotsFired
+	}
+	return 0
+}
+
+func (x *PlayerGameStats) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *PlayerGameStats) GetAccuracy() float32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *PlayerGameStats) GetPowersUsed() []PowerType {
+	if x != nil {
+		return x.PowersUsed
+	}
+	return nil
+}
+
+func (x *PlayerGameStats) GetShipsSunk() int32 {
+	if x != nil {
+		return x.ShipsSunk
+	}
+	return 0
+}
+
+type GameSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	YourFleet       []*FleetShip           `protobuf:"bytes,1,rep,name=your_fleet,json=yourFleet,proto3" json:"your_fleet,omitempty"`
+	OpponentFleet   []*FleetShip           `protobuf:"bytes,2,rep,name=opponent_fleet,json=opponentFleet,proto3" json:"opponent_fleet,omitempty"`
+	YourStats       *PlayerGameStats       `protobuf:"bytes,3,opt,name=your_stats,json=yourStats,proto3" json:"your_stats,omitempty"`
+	OpponentStats   *PlayerGameStats       `protobuf:"bytes,4,opt,name=opponent_stats,json=opponentStats,proto3" json:"opponent_stats,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	RatingChange    int32                  `protobuf:"varint,6,opt,name=rating_change,json=ratingChange,proto3" json:"rating_change,omitempty"`
+	OpponentDecoys  []*Coordinate          `protobuf:"bytes,7,rep,name=opponent_decoys,json=opponentDecoys,proto3" json:"opponent_decoys,omitempty"` // Unmasked at the end of the game
+	// Every opponent, in turn order from you. The opponent_* fields above
+	// describe the winner, or the next opponent if you won.
+	Opponents     []*PlayerSummary `protobuf:"bytes,8,rep,name=opponents,proto3" json:"opponents,omitempty"`
+	Allies        []*PlayerSummary `protobuf:"bytes,9,rep,name=allies,proto3" json:"allies,omitempty"` // Team games: your teammate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GameSummary) GetYourFleet() []*FleetShip {
+	if x != nil {
+		return x.YourFleet
+	}
+	return nil
+}
+
+func (x *GameSummary) GetOpponentFleet() []*FleetShip {
+	if x != nil {
+		return x.OpponentFleet
+	}
+	return nil
+}
+
+func (x *GameSummary) GetYourStats() *PlayerGameStats {
+	if x != nil {
+		return x.YourStats
+	}
+	return nil
+}
+
+func (x *GameSummary) GetOpponentStats() *PlayerGameStats {
+	if x != nil {
+		return x.OpponentStats
+	}
+	return nil
+}
+
+func (x *GameSummary) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *GameSummary) GetRatingChange() int32 {
+	if x != nil {
+		return x.RatingChange
+	}
+	return 0
+}
+
+func (x *GameSummary) GetOpponentDecoys() []*Coordinate {
+	if x != nil {
+		return x.OpponentDecoys
+	}
+	return nil
+}
+
+func (x *GameSummary) GetOpponents() []*PlayerSummary {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *GameSummary) GetAllies() []*PlayerSummary {
+	if x != nil {
+		return x.Allies
+	}
+	return nil
+}
+
+type PlayerSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Fleet         []*FleetShip           `protobuf:"bytes,2,rep,name=fleet,proto3" json:"fleet,omitempty"`
+	Stats         *PlayerGameStats       `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	Decoys        []*Coordinate          `protobuf:"bytes,4,rep,name=decoys,proto3" json:"decoys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSummary) Reset() {
+	*x = PlayerSummary{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSummary) ProtoMessage() {}
+
+func (x *PlayerSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSummary.ProtoReflect.Descriptor instead.
+func (*PlayerSummary) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PlayerSummary) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerSummary) GetFleet() []*FleetShip {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+func (x *PlayerSummary) GetStats() *PlayerGameStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *PlayerSummary) GetDecoys() []*Coordinate {
+	if x != nil {
+		return x.Decoys
+	}
+	return nil
+}
+
+type GameOver struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	YouWon  bool                   `protobuf:"varint,1,opt,name=you_won,json=youWon,proto3" json:"you_won,omitempty"`
+	Reason  GameOverReason         `protobuf:"varint,3,opt,name=reason,proto3,enum=pirates.v1.GameOverReason" json:"reason,omitempty"`
+	Summary *GameSummary           `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Empty when the game ended without a winner. In team games, you_won is
+	// set for the whole winning team.
+	WinnerId      string  `protobuf:"bytes,5,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Series        *Series `protobuf:"bytes,6,opt,name=series,proto3" json:"series,omitempty"` // The series score, this game included
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameOver) Reset() {
+	*x = GameOver{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOver) ProtoMessage() {}
+
+func (x *GameOver) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOver.ProtoReflect.Descriptor instead.
+func (*GameOver) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GameOver) GetYouWon() bool {
+	if x != nil {
+		return x.YouWon
+	}
+	return false
+}
+
+func (x *GameOver) GetReason() GameOverReason {
+	if x != nil {
+		return x.Reason
+	}
+	return GameOverReason_GAME_OVER_REASON_UNSPECIFIED
+}
+
+func (x *GameOver) GetSummary() *GameSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GameOver) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *GameOver) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// Series is the score of a match played as a series of games. The next
+// game starts right after the previous one until a side wins most games.
+type Series struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int32                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`                           // Best of that many games
+	GameNumber    int32                  `protobuf:"varint,2,opt,name=game_number,json=gameNumber,proto3" json:"game_number,omitempty"` // Of the game started or just over, from 1
+	Scores        []*SeriesScore         `protobuf:"bytes,3,rep,name=scores,proto3" json:"scores,omitempty"`                            // In seat order of the first game
+	Over          bool                   `protobuf:"varint,4,opt,name=over,proto3" json:"over,omitempty"`
+	WinnerId      string                 `protobuf:"bytes,5,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // Once over; in team games, either teammate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Series) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Series) GetGameNumber() int32 {
+	if x != nil {
+		return x.GameNumber
+	}
+	return 0
+}
+
+func (x *Series) GetScores() []*SeriesScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Series) GetOver() bool {
+	if x != nil {
+		return x.Over
+	}
+	return false
+}
+
+func (x *Series) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type SeriesScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Wins          int32                  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesScore) Reset() {
+	*x = SeriesScore{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesScore) ProtoMessage() {}
+
+func (x *SeriesScore) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesScore.ProtoReflect.Descriptor instead.
+func (*SeriesScore) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SeriesScore) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SeriesScore) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+type OpponentDisconnected struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	GracePeriodSeconds int32                  `protobuf:"varint,1,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OpponentDisconnected) Reset() {
+	*x = OpponentDisconnected{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpponentDisconnected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentDisconnected) ProtoMessage() {}
+
+func (x *OpponentDisconnected) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentDisconnected.ProtoReflect.Descriptor instead.
+func (*OpponentDisconnected) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *OpponentDisconnected) GetGracePeriodSeconds() int32 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+type OpponentReconnected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpponentReconnected) Reset() {
+	*x = OpponentReconnected{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpponentReconnected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentReconnected) ProtoMessage() {}
+
+func (x *OpponentReconnected) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentReconnected.ProtoReflect.Descriptor instead.
+func (*OpponentReconnected) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{84}
+}
+
+// PlayerEliminated is sent to every player of a game when one of them is
+// out. The game goes on while more than one player is left; eliminated
+// players keep receiving its events as spectators.
+type PlayerEliminated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Reason        GameOverReason         `protobuf:"varint,2,opt,name=reason,proto3,enum=pirates.v1.GameOverReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerEliminated) Reset() {
+	*x = PlayerEliminated{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerEliminated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerEliminated) ProtoMessage() {}
+
+func (x *PlayerEliminated) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerEliminated.ProtoReflect.Descriptor instead.
+func (*PlayerEliminated) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PlayerEliminated) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerEliminated) GetReason() GameOverReason {
+	if x != nil {
+		return x.Reason
+	}
+	return GameOverReason_GAME_OVER_REASON_UNSPECIFIED
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Scope         ChatScope              `protobuf:"varint,3,opt,name=scope,proto3,enum=pirates.v1.ChatScope" json:"scope,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Emote         QuickEmote             `protobuf:"varint,5,opt,name=emote,proto3,enum=pirates.v1.QuickEmote" json:"emote,omitempty"`
+	SentAtUnixMs  int64                  `protobuf:"varint,6,opt,name=sent_at_unix_ms,json=sentAtUnixMs,proto3" json:"sent_at_unix_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ChatMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetScope() ChatScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ChatScope_CHAT_SCOPE_UNSPECIFIED
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetEmote() QuickEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return QuickEmote_QUICK_EMOTE_UNSPECIFIED
+}
+
+func (x *ChatMessage) GetSentAtUnixMs() int64 {
+	if x != nil {
+		return x.SentAtUnixMs
+	}
+	return 0
+}
+
+type GameEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*GameEvent_QueueStatus
+	//	*GameEvent_PlayerList
+	//	*GameEvent_MatchProposal
+	//	*GameEvent_MatchResult
+	//	*GameEvent_GameStarted
+	//	*GameEvent_TurnStarted
+	//	*GameEvent_OpponentAction
+	//	*GameEvent_GameOver
+	//	*GameEvent_PlacementUpdate
+	//	*GameEvent_OpponentDisconnected
+	//	*GameEvent_OpponentReconnected
+	//	*GameEvent_ChatMessage
+	//	*GameEvent_PlayerEliminated
+	//	*GameEvent_PartyInvite
+	//	*GameEvent_PartyUpdate
+	//	*GameEvent_PartyDisbanded
+	//	*GameEvent_TournamentUpdate
+	//	*GameEvent_AchievementUnlocked
+	//	*GameEvent_FriendRequest
+	//	*GameEvent_FriendList
+	Event         isGameEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GameEvent) GetEvent() isGameEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *GameEvent) GetQueueStatus() *QueueStatusUpdate {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_QueueStatus); ok {
+			return x.QueueStatus
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerList() *PlayerListUpdate {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PlayerList); ok {
+			return x.PlayerList
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetMatchProposal() *MatchProposal {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_MatchProposal); ok {
+			return x.MatchProposal
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetMatchResult() *MatchResult {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_MatchResult); ok {
+			return x.MatchResult
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGameStarted() *GameStarted {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_GameStarted); ok {
+			return x.GameStarted
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTurnStarted() *TurnStarted {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_TurnStarted); ok {
+			return x.TurnStarted
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetOpponentAction() *OpponentAction {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_OpponentAction); ok {
+			return x.OpponentAction
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGameOver() *GameOver {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_GameOver); ok {
+			return x.GameOver
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlacementUpdate() *PlacementResult {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PlacementUpdate); ok {
+			return x.PlacementUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetOpponentDisconnected() *OpponentDisconnected {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_OpponentDisconnected); ok {
+			return x.OpponentDisconnected
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetOpponentReconnected() *OpponentReconnected {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_OpponentReconnected); ok {
+			return x.OpponentReconnected
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetChatMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_ChatMessage); ok {
+			return x.ChatMessage
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerEliminated() *PlayerEliminated {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PlayerEliminated); ok {
+			return x.PlayerEliminated
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPartyInvite() *PartyInvite {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PartyInvite); ok {
+			return x.PartyInvite
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPartyUpdate() *Party {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PartyUpdate); ok {
+			return x.PartyUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPartyDisbanded() *PartyDisbanded {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PartyDisbanded); ok {
+			return x.PartyDisbanded
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTournamentUpdate() *TournamentBracket {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_TournamentUpdate); ok {
+			return x.TournamentUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetAchievementUnlocked() *Achievement {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_AchievementUnlocked); ok {
+			return x.AchievementUnlocked
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetFriendRequest() *FriendRequest {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_FriendRequest); ok {
+			return x.FriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetFriendList() *FriendList {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_FriendList); ok {
+			return x.FriendList
+		}
+	}
+	return nil
+}
+
+type isGameEvent_Event interface {
+	isGameEvent_Event()
+}
+
+type GameEvent_QueueStatus struct {
+	QueueStatus *QueueStatusUpdate `protobuf:"bytes,1,opt,name=queue_status,json=queueStatus,proto3,oneof"`
+}
+
+type GameEvent_PlayerList struct {
+	PlayerList *PlayerListUpdate `protobuf:"bytes,2,opt,name=player_list,json=playerList,proto3,oneof"`
+}
+
+type GameEvent_MatchProposal struct {
+	MatchProposal *MatchProposal `protobuf:"bytes,3,opt,name=match_proposal,json=matchProposal,proto3,oneof"`
+}
+
+type GameEvent_MatchResult struct {
+	MatchResult *MatchResult `protobuf:"bytes,4,opt,name=match_result,json=matchResult,proto3,oneof"`
+}
+
+type GameEvent_GameStarted struct {
+	GameStarted *GameStarted `protobuf:"bytes,5,opt,name=game_started,json=gameStarted,proto3,oneof"`
+}
+
+type GameEvent_TurnStarted struct {
+	TurnStarted *TurnStarted `protobuf:"bytes,6,opt,name=turn_started,json=turnStarted,proto3,oneof"`
+}
+
+type GameEvent_OpponentAction struct {
+	OpponentAction *OpponentAction `protobuf:"bytes,7,opt,name=opponent_action,json=opponentAction,proto3,oneof"`
+}
+
+type GameEvent_GameOver struct {
+	GameOver *GameOver `protobuf:"bytes,8,opt,name=game_over,json=gameOver,proto3,oneof"`
+}
+
+type GameEvent_PlacementUpdate struct {
+	PlacementUpdate *PlacementResult `protobuf:"bytes,9,opt,name=placement_update,json=placementUpdate,proto3,oneof"`
+}
+
+type GameEvent_OpponentDisconnected struct {
+	OpponentDisconnected *OpponentDisconnected `protobuf:"bytes,10,opt,name=opponent_disconnected,json=opponentDisconnected,proto3,oneof"`
+}
+
+type GameEvent_OpponentReconnected struct {
+	OpponentReconnected *OpponentReconnected `protobuf:"bytes,11,opt,name=opponent_reconnected,json=opponentReconnected,proto3,oneof"`
+}
+
+type GameEvent_ChatMessage struct {
+	ChatMessage *ChatMessage `protobuf:"bytes,12,opt,name=chat_message,json=chatMessage,proto3,oneof"`
+}
+
+type GameEvent_PlayerEliminated struct {
+	PlayerEliminated *PlayerEliminated `protobuf:"bytes,13,opt,name=player_eliminated,json=playerEliminated,proto3,oneof"`
+}
+
+type GameEvent_PartyInvite struct {
+	PartyInvite *PartyInvite `protobuf:"bytes,14,opt,name=party_invite,json=partyInvite,proto3,oneof"`
+}
+
+type GameEvent_PartyUpdate struct {
+	PartyUpdate *Party `protobuf:"bytes,15,opt,name=party_update,json=partyUpdate,proto3,oneof"` // Membership changed; a player who left is no longer listed
+}
+
+type GameEvent_PartyDisbanded struct {
+	PartyDisbanded *PartyDisbanded `protobuf:"bytes,16,opt,name=party_disbanded,json=partyDisbanded,proto3,oneof"`
+}
+
+type GameEvent_TournamentUpdate struct {
+	TournamentUpdate *TournamentBracket `protobuf:"bytes,17,opt,name=tournament_update,json=tournamentUpdate,proto3,oneof"` // Sent to the organizer and players on every change
+}
+
+type GameEvent_AchievementUnlocked struct {
+	AchievementUnlocked *Achievement `protobuf:"bytes,18,opt,name=achievement_unlocked,json=achievementUnlocked,proto3,oneof"`
+}
+
+type GameEvent_FriendRequest struct {
+	FriendRequest *FriendRequest `protobuf:"bytes,19,opt,name=friend_request,json=friendRequest,proto3,oneof"`
+}
+
+type GameEvent_FriendList struct {
+	FriendList *FriendList `protobuf:"bytes,20,opt,name=friend_list,json=friendList,proto3,oneof"` // Sent when a friend's presence or your friends change
+}
+
+func (*GameEvent_QueueStatus) isGameEvent_Event() {}
+
+func (*GameEvent_PlayerList) isGameEvent_Event() {}
+
+func (*GameEvent_MatchProposal) isGameEvent_Event() {}
+
+func (*GameEvent_MatchResult) isGameEvent_Event() {}
+
+func (*GameEvent_GameStarted) isGameEvent_Event() {}
+
+func (*GameEvent_TurnStarted) isGameEvent_Event() {}
+
+func (*GameEvent_OpponentAction) isGameEvent_Event() {}
+
+func (*GameEvent_GameOver) isGameEvent_Event() {}
+
+func (*GameEvent_PlacementUpdate) isGameEvent_Event() {}
+
+func (*GameEvent_OpponentDisconnected) isGameEvent_Event() {}
+
+func (*GameEvent_OpponentReconnected) isGameEvent_Event() {}
+
+func (*GameEvent_ChatMessage) isGameEvent_Event() {}
+
+func (*GameEvent_PlayerEliminated) isGameEvent_Event() {}
+
+func (*GameEvent_PartyInvite) isGameEvent_Event() {}
+
+func (*GameEvent_PartyUpdate) isGameEvent_Event() {}
+
+func (*GameEvent_PartyDisbanded) isGameEvent_Event() {}
+
+func (*GameEvent_TournamentUpdate) isGameEvent_Event() {}
+
+func (*GameEvent_AchievementUnlocked) isGameEvent_Event() {}
+
+func (*GameEvent_FriendRequest) isGameEvent_Event() {}
+
+func (*GameEvent_FriendList) isGameEvent_Event() {}
+
+// Party is a group of players who queue together: as one team for 2v2
+// games, or never against each other in one-on-one games.
+type Party struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaderId      string                 `protobuf:"bytes,2,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	Members       []*Player              `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"` // In joining order, the leader first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Party) Reset() {
+	*x = Party{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Party) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Party) ProtoMessage() {}
+
+func (x *Party) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Party.ProtoReflect.Descriptor instead.
+func (*Party) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Party) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Party) GetLeaderId() string {
+	if x != nil {
+		return x.LeaderId
+	}
+	return ""
+}
+
+func (x *Party) GetMembers() []*Player {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type PartyInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Party         *Party                 `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	Inviter       *Player                `protobuf:"bytes,2,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyInvite) Reset() {
+	*x = PartyInvite{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyInvite) ProtoMessage() {}
+
+func (x *PartyInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyInvite.ProtoReflect.Descriptor instead.
+func (*PartyInvite) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PartyInvite) GetParty() *Party {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+func (x *PartyInvite) GetInviter() *Player {
+	if x != nil {
+		return x.Inviter
+	}
+	return nil
+}
+
+type PartyDisbanded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       string                 `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyDisbanded) Reset() {
+	*x = PartyDisbanded{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyDisbanded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyDisbanded) ProtoMessage() {}
+
+func (x *PartyDisbanded) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyDisbanded.ProtoReflect.Descriptor instead.
+func (*PartyDisbanded) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PartyDisbanded) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OrganizerId   string                 `protobuf:"bytes,3,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Format        TournamentFormat       `protobuf:"varint,4,opt,name=format,proto3,enum=pirates.v1.TournamentFormat" json:"format,omitempty"`
+	Rules         *GameRules             `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+	Status        TournamentStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=pirates.v1.TournamentStatus" json:"status,omitempty"`
+	PlayerIds     []string               `protobuf:"bytes,7,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`           // In registration order, which seeds them
+	CurrentRound  int32                  `protobuf:"varint,8,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"` // From 1; 0 before the tournament starts
+	WinnerId      string                 `protobuf:"bytes,9,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`              // Set once the tournament is finished
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *Tournament) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Tournament) GetStatus() TournamentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Tournament) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *Tournament) GetCurrentRound() int32 {
+	if x != nil {
+		return x.CurrentRound
+	}
+	return 0
+}
+
+func (x *Tournament) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+// TournamentMatch is a match of a round. A match without a second player
+// is a bye, won by the first.
+type TournamentMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Round          int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Player1Id      string                 `protobuf:"bytes,3,opt,name=player1_id,json=player1Id,proto3" json:"player1_id,omitempty"`
+	Player2Id      string                 `protobuf:"bytes,4,opt,name=player2_id,json=player2Id,proto3" json:"player2_id,omitempty"`
+	GameId         string                 `protobuf:"bytes,5,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Set once the game is created
+	Finished       bool                   `protobuf:"varint,6,opt,name=finished,proto3" json:"finished,omitempty"`
+	WinnerId       string                 `protobuf:"bytes,7,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`                      // Empty for a finished match both players lost
+	NoShow         bool                   `protobuf:"varint,8,opt,name=no_show,json=noShow,proto3" json:"no_show,omitempty"`                           // Settled because the game was not started in time
+	DeadlineUnixMs int64                  `protobuf:"varint,9,opt,name=deadline_unix_ms,json=deadlineUnixMs,proto3" json:"deadline_unix_ms,omitempty"` // The game must start by then
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TournamentMatch) Reset() {
+	*x = TournamentMatch{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentMatch) ProtoMessage() {}
+
+func (x *TournamentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentMatch.ProtoReflect.Descriptor instead.
+func (*TournamentMatch) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *TournamentMatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetPlayer1Id() string {
+	if x != nil {
+		return x.Player1Id
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetPlayer2Id() string {
+	if x != nil {
+		return x.Player2Id
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *TournamentMatch) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetNoShow() bool {
+	if x != nil {
+		return x.NoShow
+	}
+	return false
+}
+
+func (x *TournamentMatch) GetDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.DeadlineUnixMs
+	}
+	return 0
+}
+
+type TournamentStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Wins          int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"` // Byes included
+	Losses        int32                  `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Eliminated    bool                   `protobuf:"varint,5,opt,name=eliminated,proto3" json:"eliminated,omitempty"` // Elimination formats: out of the tournament
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentStanding) Reset() {
+	*x = TournamentStanding{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStanding) ProtoMessage() {}
+
+func (x *TournamentStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStanding.ProtoReflect.Descriptor instead.
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TournamentStanding) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *TournamentStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+type TournamentBracket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Matches       []*TournamentMatch     `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`     // Every round so far, in order
+	Standings     []*TournamentStanding  `protobuf:"bytes,3,rep,name=standings,proto3" json:"standings,omitempty"` // Ranked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentBracket) Reset() {
+	*x = TournamentBracket{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracket) ProtoMessage() {}
+
+func (x *TournamentBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentBracket.ProtoReflect.Descriptor instead.
+func (*TournamentBracket) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TournamentBracket) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+func (x *TournamentBracket) GetMatches() []*TournamentMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *TournamentBracket) GetStandings() []*TournamentStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+// DailyPuzzle is a player's attempt at the day's puzzle. The hidden fleet
+// never reaches the client: the board only shows the shots fired and the
+// ships sunk.
+type DailyPuzzle struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, in UTC
+	AvailablePowers []*Power               `protobuf:"bytes,2,rep,name=available_powers,json=availablePowers,proto3" json:"available_powers,omitempty"`
+	Board           *BoardView             `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"`
+	Shots           int32                  `protobuf:"varint,4,opt,name=shots,proto3" json:"shots,omitempty"`   // Every cell fired at, powers included
+	Solved          bool                   `protobuf:"varint,5,opt,name=solved,proto3" json:"solved,omitempty"` // The whole fleet is sunk
+	Rank            int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`     // On the day's leaderboard, once solved
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DailyPuzzle) Reset() {
+	*x = DailyPuzzle{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzle) ProtoMessage() {}
+
+func (x *DailyPuzzle) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzle.ProtoReflect.Descriptor instead.
+func (*DailyPuzzle) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DailyPuzzle) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPuzzle) GetAvailablePowers() []*Power {
+	if x != nil {
+		return x.AvailablePowers
+	}
+	return nil
+}
+
+func (x *DailyPuzzle) GetBoard() *BoardView {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *DailyPuzzle) GetShots() int32 {
+	if x != nil {
+		return x.Shots
+	}
+	return 0
+}
+
+func (x *DailyPuzzle) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+func (x *DailyPuzzle) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type PuzzleShotResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*PuzzleShotResult_Attack
+	//	*PuzzleShotResult_Power
+	Action        isPuzzleShotResult_Action `protobuf_oneof:"action"`
+	Puzzle        *DailyPuzzle              `protobuf:"bytes,3,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleShotResult) Reset() {
+	*x = PuzzleShotResult{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleShotResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleShotResult) ProtoMessage() {}
+
+func (x *PuzzleShotResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleShotResult.ProtoReflect.Descriptor instead.
+func (*PuzzleShotResult) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PuzzleShotResult) GetAction() isPuzzleShotResult_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *PuzzleShotResult) GetAttack() *AttackResult {
+	if x != nil {
+		if x, ok := x.Action.(*PuzzleShotResult_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+func (x *PuzzleShotResult) GetPower() *PowerResult {
+	if x != nil {
+		if x, ok := x.Action.(*PuzzleShotResult_Power); ok {
+			return x.Power
+		}
+	}
+	return nil
+}
+
+func (x *PuzzleShotResult) GetPuzzle() *DailyPuzzle {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
 }
 
-func (x *PowerResult) GetPowerUsed() PowerType {
+type isPuzzleShotResult_Action interface {
+	isPuzzleShotResult_Action()
+}
+
+type PuzzleShotResult_Attack struct {
+	Attack *AttackResult `protobuf:"bytes,1,opt,name=attack,proto3,oneof"`
+}
+
+type PuzzleShotResult_Power struct {
+	Power *PowerResult `protobuf:"bytes,2,opt,name=power,proto3,oneof"`
+}
+
+func (*PuzzleShotResult_Attack) isPuzzleShotResult_Action() {}
+
+func (*PuzzleShotResult_Power) isPuzzleShotResult_Action() {}
+
+type PuzzleLeaderboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Scores        []*PuzzleScore         `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"` // Fewest shots first, then fastest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleLeaderboard) Reset() {
+	*x = PuzzleLeaderboard{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleLeaderboard) ProtoMessage() {}
+
+func (x *PuzzleLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[97]
 	if x != nil {
-		return x.PowerUsed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return PowerType_POWER_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *PowerResult) GetCellsAffected() []*CellReveal {
+// Deprecated: Use PuzzleLeaderboard.ProtoReflect.Descriptor instead.
+func (*PuzzleLeaderboard) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PuzzleLeaderboard) GetDate() string {
 	if x != nil {
-		return x.CellsAffected
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *PowerResult) GetSunkShips() []*Ship {
+func (x *PuzzleLeaderboard) GetScores() []*PuzzleScore {
 	if x != nil {
-		return x.SunkShips
+		return x.Scores
 	}
 	return nil
 }
 
-func (x *PowerResult) GetMessage() string {
+type PuzzleScore struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Rank            int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerId        string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DisplayName     string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Shots           int32                  `protobuf:"varint,4,opt,name=shots,proto3" json:"shots,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PuzzleScore) Reset() {
+	*x = PuzzleScore{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleScore) ProtoMessage() {}
+
+func (x *PuzzleScore) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[98]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleScore.ProtoReflect.Descriptor instead.
+func (*PuzzleScore) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PuzzleScore) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PuzzleScore) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
 	}
 	return ""
 }
 
-type OpponentAction struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Action:
-	//
-	//	*OpponentAction_Attack
-	//	*OpponentAction_Power
-	Action          isOpponentAction_Action `protobuf_oneof:"action"`
-	YourGridUpdates []*CellReveal           `protobuf:"bytes,3,rep,name=your_grid_updates,json=yourGridUpdates,proto3" json:"your_grid_updates,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PuzzleScore) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
 }
 
-func (x *OpponentAction) Reset() {
-	*x = OpponentAction{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[29]
+func (x *PuzzleScore) GetShots() int32 {
+	if x != nil {
+		return x.Shots
+	}
+	return 0
+}
+
+func (x *PuzzleScore) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// PlayerProfile sums up every game a player finished. Games that ended
+// without a winner are not counted.
+type PlayerProfile struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId          string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	GamesPlayed       int32                  `protobuf:"varint,3,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Wins              int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses            int32                  `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	Forfeits          int32                  `protobuf:"varint,6,opt,name=forfeits,proto3" json:"forfeits,omitempty"`                                                          // Losses by forfeit or disconnection
+	AverageShotsToWin float32                `protobuf:"fixed32,7,opt,name=average_shots_to_win,json=averageShotsToWin,proto3" json:"average_shots_to_win,omitempty"`          // Over the games won
+	Accuracy          float32                `protobuf:"fixed32,8,opt,name=accuracy,proto3" json:"accuracy,omitempty"`                                                         // Hits over shots, across every game
+	FavoritePower     PowerType              `protobuf:"varint,9,opt,name=favorite_power,json=favoritePower,proto3,enum=pirates.v1.PowerType" json:"favorite_power,omitempty"` // The most used; unspecified before any
+	CurrentStreak     int32                  `protobuf:"varint,10,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`                          // Wins in a row, or negative for losses
+	Rating            int32                  `protobuf:"varint,11,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingHistory     []*RatingChange        `protobuf:"bytes,12,rep,name=rating_history,json=ratingHistory,proto3" json:"rating_history,omitempty"` // Oldest first
+	Achievements      []*Achievement         `protobuf:"bytes,13,rep,name=achievements,proto3" json:"achievements,omitempty"`                        // Every achievement, unlocked or not
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlayerProfile) Reset() {
+	*x = PlayerProfile{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpponentAction) String() string {
+func (x *PlayerProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpponentAction) ProtoMessage() {}
+func (*PlayerProfile) ProtoMessage() {}
 
-func (x *OpponentAction) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[29]
+func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,82 +7244,274 @@ func (x *OpponentAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpponentAction.ProtoReflect.Descriptor instead.
-func (*OpponentAction) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
+func (*PlayerProfile) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *OpponentAction) GetAction() isOpponentAction_Action {
+func (x *PlayerProfile) GetPlayerId() string {
 	if x != nil {
-		return x.Action
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetForfeits() int32 {
+	if x != nil {
+		return x.Forfeits
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetAverageShotsToWin() float32 {
+	if x != nil {
+		return x.AverageShotsToWin
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetAccuracy() float32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetFavoritePower() PowerType {
+	if x != nil {
+		return x.FavoritePower
+	}
+	return PowerType_POWER_TYPE_UNSPECIFIED
+}
+
+func (x *PlayerProfile) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetRatingHistory() []*RatingChange {
+	if x != nil {
+		return x.RatingHistory
 	}
 	return nil
 }
 
-func (x *OpponentAction) GetAttack() *AttackResult {
+func (x *PlayerProfile) GetAchievements() []*Achievement {
 	if x != nil {
-		if x, ok := x.Action.(*OpponentAction_Attack); ok {
-			return x.Attack
-		}
+		return x.Achievements
 	}
 	return nil
 }
 
-func (x *OpponentAction) GetPower() *PowerResult {
+type RatingChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // After the game
+	Change        int32                  `protobuf:"varint,3,opt,name=change,proto3" json:"change,omitempty"`
+	AtUnixMs      int64                  `protobuf:"varint,4,opt,name=at_unix_ms,json=atUnixMs,proto3" json:"at_unix_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingChange) Reset() {
+	*x = RatingChange{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingChange) ProtoMessage() {}
+
+func (x *RatingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[100]
 	if x != nil {
-		if x, ok := x.Action.(*OpponentAction_Power); ok {
-			return x.Power
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *OpponentAction) GetYourGridUpdates() []*CellReveal {
+// Deprecated: Use RatingChange.ProtoReflect.Descriptor instead.
+func (*RatingChange) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RatingChange) GetGameId() string {
 	if x != nil {
-		return x.YourGridUpdates
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-type isOpponentAction_Action interface {
-	isOpponentAction_Action()
+func (x *RatingChange) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
 }
 
-type OpponentAction_Attack struct {
-	Attack *AttackResult `protobuf:"bytes,1,opt,name=attack,proto3,oneof"`
+func (x *RatingChange) GetChange() int32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
 }
 
-type OpponentAction_Power struct {
-	Power *PowerResult `protobuf:"bytes,2,opt,name=power,proto3,oneof"`
+func (x *RatingChange) GetAtUnixMs() int64 {
+	if x != nil {
+		return x.AtUnixMs
+	}
+	return 0
 }
 
-func (*OpponentAction_Attack) isOpponentAction_Action() {}
+type Leaderboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         LeaderboardScope       `protobuf:"varint,1,opt,name=scope,proto3,enum=pirates.v1.LeaderboardScope" json:"scope,omitempty"`
+	RuleSet       RuleSet                `protobuf:"varint,2,opt,name=rule_set,json=ruleSet,proto3,enum=pirates.v1.RuleSet" json:"rule_set,omitempty"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`                                       // Players ranked, across every page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Leaderboard) Reset() {
+	*x = Leaderboard{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Leaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard) ProtoMessage() {}
+
+func (x *Leaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard.ProtoReflect.Descriptor instead.
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Leaderboard) GetScope() LeaderboardScope {
+	if x != nil {
+		return x.Scope
+	}
+	return LeaderboardScope_LEADERBOARD_SCOPE_UNSPECIFIED
+}
+
+func (x *Leaderboard) GetRuleSet() RuleSet {
+	if x != nil {
+		return x.RuleSet
+	}
+	return RuleSet_RULE_SET_UNSPECIFIED
+}
+
+func (x *Leaderboard) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Leaderboard) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-func (*OpponentAction_Power) isOpponentAction_Action() {}
+func (x *Leaderboard) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
 
-type GameOver struct {
+type LeaderboardEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	YouWon        bool                   `protobuf:"varint,1,opt,name=you_won,json=youWon,proto3" json:"you_won,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Points        int32                  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"` // Rating gained within the scope
+	Wins          int32                  `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`     // Within the scope
+	Losses        int32                  `protobuf:"varint,7,opt,name=losses,proto3" json:"losses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameOver) Reset() {
-	*x = GameOver{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[30]
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameOver) String() string {
+func (x *LeaderboardEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameOver) ProtoMessage() {}
+func (*LeaderboardEntry) ProtoMessage() {}
 
-func (x *GameOver) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[30]
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,58 +7522,88 @@ func (x *GameOver) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameOver.ProtoReflect.Descriptor instead.
-func (*GameOver) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GameOver) GetYouWon() bool {
+func (x *LeaderboardEntry) GetRank() int32 {
 	if x != nil {
-		return x.YouWon
+		return x.Rank
 	}
-	return false
+	return 0
 }
 
-func (x *GameOver) GetReason() string {
+func (x *LeaderboardEntry) GetPlayerId() string {
 	if x != nil {
-		return x.Reason
+		return x.PlayerId
 	}
 	return ""
 }
 
-type GameEvent struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Event:
-	//
-	//	*GameEvent_QueueStatus
-	//	*GameEvent_PlayerList
-	//	*GameEvent_MatchProposal
-	//	*GameEvent_MatchResult
-	//	*GameEvent_GameStarted
-	//	*GameEvent_TurnStarted
-	//	*GameEvent_OpponentAction
-	//	*GameEvent_GameOver
-	//	*GameEvent_PlacementUpdate
-	Event         isGameEvent_Event `protobuf_oneof:"event"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LeaderboardEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
 }
 
-func (x *GameEvent) Reset() {
-	*x = GameEvent{}
-	mi := &file_pirates_v1_pirates_proto_msgTypes[31]
+func (x *LeaderboardEntry) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+type Achievement struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Progress         int32                  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"` // Matching events so far
+	Goal             int32                  `protobuf:"varint,5,opt,name=goal,proto3" json:"goal,omitempty"`         // The progress that unlocks it
+	Unlocked         bool                   `protobuf:"varint,6,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAtUnixMs int64                  `protobuf:"varint,7,opt,name=unlocked_at_unix_ms,json=unlockedAtUnixMs,proto3" json:"unlocked_at_unix_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameEvent) String() string {
+func (x *Achievement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameEvent) ProtoMessage() {}
+func (*Achievement) ProtoMessage() {}
 
-func (x *GameEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_pirates_v1_pirates_proto_msgTypes[31]
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1919,157 +7614,174 @@ func (x *GameEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
-func (*GameEvent) Descriptor() ([]byte, []int) {
-	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GameEvent) GetEvent() isGameEvent_Event {
+func (x *Achievement) GetId() string {
 	if x != nil {
-		return x.Event
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GameEvent) GetQueueStatus() *QueueStatusUpdate {
+func (x *Achievement) GetName() string {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_QueueStatus); ok {
-			return x.QueueStatus
-		}
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GameEvent) GetPlayerList() *PlayerListUpdate {
+func (x *Achievement) GetDescription() string {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_PlayerList); ok {
-			return x.PlayerList
-		}
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *GameEvent) GetMatchProposal() *MatchProposal {
+func (x *Achievement) GetProgress() int32 {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_MatchProposal); ok {
-			return x.MatchProposal
-		}
+		return x.Progress
 	}
-	return nil
+	return 0
 }
 
-func (x *GameEvent) GetMatchResult() *MatchResult {
+func (x *Achievement) GetGoal() int32 {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_MatchResult); ok {
-			return x.MatchResult
-		}
+		return x.Goal
 	}
-	return nil
+	return 0
 }
 
-func (x *GameEvent) GetGameStarted() *GameStarted {
+func (x *Achievement) GetUnlocked() bool {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_GameStarted); ok {
-			return x.GameStarted
-		}
+		return x.Unlocked
 	}
-	return nil
+	return false
 }
 
-func (x *GameEvent) GetTurnStarted() *TurnStarted {
+func (x *Achievement) GetUnlockedAtUnixMs() int64 {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_TurnStarted); ok {
-			return x.TurnStarted
-		}
+		return x.UnlockedAtUnixMs
 	}
-	return nil
+	return 0
 }
 
-func (x *GameEvent) GetOpponentAction() *OpponentAction {
+// FriendList is a player's friends, with their presence, and the players
+// they blocked.
+type FriendList struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Friends          []*Player              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	IncomingRequests []*Player              `protobuf:"bytes,2,rep,name=incoming_requests,json=incomingRequests,proto3" json:"incoming_requests,omitempty"` // Awaiting your answer
+	OutgoingRequests []*Player              `protobuf:"bytes,3,rep,name=outgoing_requests,json=outgoingRequests,proto3" json:"outgoing_requests,omitempty"` // Awaiting theirs
+	BlockedPlayerIds []string               `protobuf:"bytes,4,rep,name=blocked_player_ids,json=blockedPlayerIds,proto3" json:"blocked_player_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FriendList) Reset() {
+	*x = FriendList{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendList) ProtoMessage() {}
+
+func (x *FriendList) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[104]
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_OpponentAction); ok {
-			return x.OpponentAction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameEvent) GetGameOver() *GameOver {
+// Deprecated: Use FriendList.ProtoReflect.Descriptor instead.
+func (*FriendList) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *FriendList) GetFriends() []*Player {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_GameOver); ok {
-			return x.GameOver
-		}
+		return x.Friends
 	}
 	return nil
 }
 
-func (x *GameEvent) GetPlacementUpdate() *PlacementResult {
+func (x *FriendList) GetIncomingRequests() []*Player {
 	if x != nil {
-		if x, ok := x.Event.(*GameEvent_PlacementUpdate); ok {
-			return x.PlacementUpdate
-		}
+		return x.IncomingRequests
 	}
 	return nil
 }
 
-type isGameEvent_Event interface {
-	isGameEvent_Event()
-}
-
-type GameEvent_QueueStatus struct {
-	QueueStatus *QueueStatusUpdate `protobuf:"bytes,1,opt,name=queue_status,json=queueStatus,proto3,oneof"`
+func (x *FriendList) GetOutgoingRequests() []*Player {
+	if x != nil {
+		return x.OutgoingRequests
+	}
+	return nil
 }
 
-type GameEvent_PlayerList struct {
-	PlayerList *PlayerListUpdate `protobuf:"bytes,2,opt,name=player_list,json=playerList,proto3,oneof"`
+func (x *FriendList) GetBlockedPlayerIds() []string {
+	if x != nil {
+		return x.BlockedPlayerIds
+	}
+	return nil
 }
 
-type GameEvent_MatchProposal struct {
-	MatchProposal *MatchProposal `protobuf:"bytes,3,opt,name=match_proposal,json=matchProposal,proto3,oneof"`
+type FriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *Player                `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GameEvent_MatchResult struct {
-	MatchResult *MatchResult `protobuf:"bytes,4,opt,name=match_result,json=matchResult,proto3,oneof"`
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
+	mi := &file_pirates_v1_pirates_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GameEvent_GameStarted struct {
-	GameStarted *GameStarted `protobuf:"bytes,5,opt,name=game_started,json=gameStarted,proto3,oneof"`
+func (x *FriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GameEvent_TurnStarted struct {
-	TurnStarted *TurnStarted `protobuf:"bytes,6,opt,name=turn_started,json=turnStarted,proto3,oneof"`
-}
+func (*FriendRequest) ProtoMessage() {}
 
-type GameEvent_OpponentAction struct {
-	OpponentAction *OpponentAction `protobuf:"bytes,7,opt,name=opponent_action,json=opponentAction,proto3,oneof"`
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pirates_v1_pirates_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type GameEvent_GameOver struct {
-	GameOver *GameOver `protobuf:"bytes,8,opt,name=game_over,json=gameOver,proto3,oneof"`
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
+	return file_pirates_v1_pirates_proto_rawDescGZIP(), []int{105}
 }
 
-type GameEvent_PlacementUpdate struct {
-	PlacementUpdate *PlacementResult `protobuf:"bytes,9,opt,name=placement_update,json=placementUpdate,proto3,oneof"`
+func (x *FriendRequest) GetFrom() *Player {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (*GameEvent_QueueStatus) isGameEvent_Event() {}
-
-func (*GameEvent_PlayerList) isGameEvent_Event() {}
-
-func (*GameEvent_MatchProposal) isGameEvent_Event() {}
-
-func (*GameEvent_MatchResult) isGameEvent_Event() {}
-
-func (*GameEvent_GameStarted) isGameEvent_Event() {}
-
-func (*GameEvent_TurnStarted) isGameEvent_Event() {}
-
-func (*GameEvent_OpponentAction) isGameEvent_Event() {}
-
-func (*GameEvent_GameOver) isGameEvent_Event() {}
-
-func (*GameEvent_PlacementUpdate) isGameEvent_Event() {}
-
 var File_pirates_v1_pirates_proto protoreflect.FileDescriptor
 
 const file_pirates_v1_pirates_proto_rawDesc = "" +
@@ -2094,96 +7806,370 @@ const file_pirates_v1_pirates_proto_rawDesc = "" +
 	"\x06Player\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x120\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x18.pirates.v1.PlayerStatusR\x06status\"3\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.pirates.v1.PlayerStatusR\x06status\"\xbd\x03\n" +
+	"\tGameRules\x12.\n" +
+	"\brule_set\x18\x01 \x01(\x0e2\x13.pirates.v1.RuleSetR\aruleSet\x12'\n" +
+	"\x0fpowers_disabled\x18\x02 \x01(\bR\x0epowersDisabled\x12\x1f\n" +
+	"\vsalvo_shots\x18\x03 \x01(\x05R\n" +
+	"salvoShots\x124\n" +
+	"\n" +
+	"bonus_turn\x18\x04 \x01(\x0e2\x15.pirates.v1.BonusTurnR\tbonusTurn\x12&\n" +
+	"\x0fmax_bonus_turns\x18\x05 \x01(\x05R\rmaxBonusTurns\x12\x19\n" +
+	"\bno_touch\x18\x06 \x01(\bR\anoTouch\x12\x18\n" +
+	"\aterrain\x18\a \x01(\bR\aterrain\x12)\n" +
+	"\x10defensive_powers\x18\b \x01(\bR\x0fdefensivePowers\x12\x14\n" +
+	"\x05teams\x18\t \x01(\bR\x05teams\x12!\n" +
+	"\fturn_seconds\x18\n" +
+	" \x01(\x05R\vturnSeconds\x12#\n" +
+	"\rseries_length\x18\v \x01(\x05R\fseriesLength\x12\x1a\n" +
+	"\bunranked\x18\f \x01(\bR\bunranked\"r\n" +
+	"\aTerrain\x120\n" +
+	"\aislands\x18\x01 \x03(\v2\x16.pirates.v1.CoordinateR\aislands\x125\n" +
+	"\n" +
+	"your_mines\x18\x02 \x03(\v2\x16.pirates.v1.CoordinateR\tyourMines\"V\n" +
 	"\x0eConnectRequest\x12!\n" +
-	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\"b\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"b\n" +
 	"\x0fConnectResponse\x12*\n" +
 	"\x06player\x18\x01 \x01(\v2\x12.pirates.v1.PlayerR\x06player\x12#\n" +
-	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\"7\n" +
+	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\"c\n" +
 	"\x10JoinQueueRequest\x12#\n" +
-	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"8\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x14\n" +
+	"\x05teams\x18\x02 \x01(\bR\x05teams\x12\x14\n" +
+	"\x05queue\x18\x03 \x01(\tR\x05queue\"8\n" +
 	"\x11LeaveQueueRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x14\n" +
-	"\x12LeaveQueueResponse\"9\n" +
+	"\x12LeaveQueueResponse\"<\n" +
+	"\x15GetQueueStatusRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"9\n" +
 	"\x12ListPlayersRequest\x12#\n" +
-	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"g\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\xee\x01\n" +
 	"\x16ChallengePlayerRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12(\n" +
-	"\x10target_player_id\x18\x02 \x01(\tR\x0etargetPlayerId\"4\n" +
+	"\x10target_player_id\x18\x02 \x01(\tR\x0etargetPlayerId\x122\n" +
+	"\x15additional_player_ids\x18\x03 \x03(\tR\x13additionalPlayerIds\x12$\n" +
+	"\x0eally_player_id\x18\x04 \x01(\tR\fallyPlayerId\x12+\n" +
+	"\x05rules\x18\x05 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\"4\n" +
 	"\x17ChallengePlayerResponse\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"s\n" +
 	"\x15RespondToMatchRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x19\n" +
 	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12\x1a\n" +
-	"\baccepted\x18\x03 \x01(\bR\baccepted\"5\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\"m\n" +
+	"\x19CreatePrivateLobbyRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12+\n" +
+	"\x05rules\x18\x02 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\"O\n" +
+	"\fPrivateLobby\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12+\n" +
+	"\x05rules\x18\x02 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\"R\n" +
+	"\x17JoinPrivateLobbyRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"5\n" +
+	"\x18JoinPrivateLobbyResponse\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"?\n" +
+	"\x18ClosePrivateLobbyRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x1b\n" +
+	"\x19ClosePrivateLobbyResponse\"X\n" +
+	"\x14InviteToPartyRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"y\n" +
+	"\x1bRespondToPartyInviteRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x19\n" +
+	"\bparty_id\x18\x02 \x01(\tR\apartyId\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\"8\n" +
+	"\x11LeavePartyRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x14\n" +
+	"\x12LeavePartyResponse\"\xb5\x01\n" +
+	"\x17CreateTournamentRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1c.pirates.v1.TournamentFormatR\x06format\x12+\n" +
+	"\x05rules\x18\x04 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\"=\n" +
+	"\x16ListTournamentsRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"S\n" +
+	"\x17ListTournamentsResponse\x128\n" +
+	"\vtournaments\x18\x01 \x03(\v2\x16.pirates.v1.TournamentR\vtournaments\"h\n" +
+	"\x1cRegisterForTournamentRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"i\n" +
+	"\x1dWithdrawFromTournamentRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"b\n" +
+	"\x16StartTournamentRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"`\n" +
+	"\x14GetTournamentRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\">\n" +
+	"\x17StartDailyPuzzleRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"j\n" +
+	"\x13PuzzleAttackRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12.\n" +
+	"\x06target\x18\x02 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\"\xd4\x01\n" +
+	"\x15PuzzleUsePowerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12+\n" +
+	"\x05power\x18\x02 \x01(\x0e2\x15.pirates.v1.PowerTypeR\x05power\x12.\n" +
+	"\x06target\x18\x03 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\x129\n" +
+	"\vorientation\x18\x04 \x01(\x0e2\x17.pirates.v1.OrientationR\vorientation\"V\n" +
+	"\x1bGetPuzzleLeaderboardRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"[\n" +
+	"\x17GetPlayerProfileRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\xdc\x01\n" +
+	"\x15GetLeaderboardRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x122\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x1c.pirates.v1.LeaderboardScopeR\x05scope\x12.\n" +
+	"\brule_set\x18\x03 \x01(\x0e2\x13.pirates.v1.RuleSetR\aruleSet\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x18SendFriendRequestRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\"}\n" +
+	"\x1dRespondToFriendRequestRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\"W\n" +
+	"\x13RemoveFriendRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"V\n" +
+	"\x12BlockPlayerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"X\n" +
+	"\x14UnblockPlayerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"8\n" +
+	"\x11GetFriendsRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x8d\x01\n" +
+	"\x16ChallengeFriendRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12+\n" +
+	"\x05rules\x18\x03 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\"L\n" +
+	"\x17ChallengeFriendResponse\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\bR\x06queued\"5\n" +
 	"\x0eForfeitRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x11\n" +
 	"\x0fForfeitResponse\"`\n" +
 	"\x11PlaceShipsRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12&\n" +
-	"\x05ships\x18\x02 \x03(\v2\x10.pirates.v1.ShipR\x05ships\"d\n" +
+	"\x05ships\x18\x02 \x03(\v2\x10.pirates.v1.ShipR\x05ships\"\x8e\x01\n" +
 	"\rAttackRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12.\n" +
-	"\x06target\x18\x02 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\"\xb3\x01\n" +
+	"\x06target\x18\x02 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\x12(\n" +
+	"\x10target_player_id\x18\x03 \x01(\tR\x0etargetPlayerId\"\x95\x01\n" +
+	"\x12AttackSalvoRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x120\n" +
+	"\atargets\x18\x02 \x03(\v2\x16.pirates.v1.CoordinateR\atargets\x12(\n" +
+	"\x10target_player_id\x18\x03 \x01(\tR\x0etargetPlayerId\"\x98\x02\n" +
 	"\x0fUsePowerRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12+\n" +
 	"\x05power\x18\x02 \x01(\x0e2\x15.pirates.v1.PowerTypeR\x05power\x12.\n" +
 	"\x06target\x18\x03 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\x12\x1e\n" +
 	"\n" +
 	"horizontal\x18\x04 \x01(\bR\n" +
-	"horizontal\"=\n" +
+	"horizontal\x129\n" +
+	"\vorientation\x18\x05 \x01(\x0e2\x17.pirates.v1.OrientationR\vorientation\x12(\n" +
+	"\x10target_player_id\x18\x06 \x01(\tR\x0etargetPlayerId\"\x9c\x02\n" +
+	"\x13PreviewPowerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12+\n" +
+	"\x05power\x18\x02 \x01(\x0e2\x15.pirates.v1.PowerTypeR\x05power\x12.\n" +
+	"\x06target\x18\x03 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\x12\x1e\n" +
+	"\n" +
+	"horizontal\x18\x04 \x01(\bR\n" +
+	"horizontal\x129\n" +
+	"\vorientation\x18\x05 \x01(\x0e2\x17.pirates.v1.OrientationR\vorientation\x12(\n" +
+	"\x10target_player_id\x18\x06 \x01(\tR\x0etargetPlayerId\"W\n" +
+	"\fPowerPreview\x12,\n" +
+	"\x05cells\x18\x01 \x03(\v2\x16.pirates.v1.CoordinateR\x05cells\x12\x19\n" +
+	"\bown_grid\x18\x02 \x01(\bR\aownGrid\"\xac\x01\n" +
+	"\x16SendChatMessageRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12+\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x15.pirates.v1.ChatScopeR\x05scope\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12,\n" +
+	"\x05emote\x18\x04 \x01(\x0e2\x16.pirates.v1.QuickEmoteR\x05emote\"L\n" +
+	"\x17SendChatMessageResponse\x121\n" +
+	"\amessage\x18\x01 \x01(\v2\x17.pirates.v1.ChatMessageR\amessage\"k\n" +
+	"\x11MutePlayerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05muted\x18\x03 \x01(\bR\x05muted\"\x14\n" +
+	"\x12MutePlayerResponse\"=\n" +
 	"\x16SubscribeEventsRequest\x12#\n" +
-	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x7f\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\xc4\x01\n" +
 	"\x11QueueStatusUpdate\x12\x19\n" +
 	"\bin_queue\x18\x01 \x01(\bR\ainQueue\x12%\n" +
 	"\x0equeue_position\x18\x02 \x01(\x05R\rqueuePosition\x12(\n" +
-	"\x10players_in_queue\x18\x03 \x01(\x05R\x0eplayersInQueue\"S\n" +
+	"\x10players_in_queue\x18\x03 \x01(\x05R\x0eplayersInQueue\x12\x14\n" +
+	"\x05queue\x18\x04 \x01(\tR\x05queue\x12-\n" +
+	"\x06queues\x18\x05 \x03(\v2\x15.pirates.v1.QueueInfoR\x06queues\"\xec\x01\n" +
+	"\tQueueInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x05rules\x18\x02 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\x12%\n" +
+	"\x0erating_matched\x18\x03 \x01(\bR\rratingMatched\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x12\x18\n" +
+	"\aplayers\x18\x05 \x01(\x05R\aplayers\x124\n" +
+	"\x16estimated_wait_seconds\x18\x06 \x01(\x05R\x14estimatedWaitSeconds\"\xc0\x01\n" +
 	"\x10PlayerListUpdate\x12?\n" +
-	"\x11available_players\x18\x01 \x03(\v2\x12.pirates.v1.PlayerR\x10availablePlayers\"\xa8\x01\n" +
+	"\x11available_players\x18\x01 \x03(\v2\x12.pirates.v1.PlayerR\x10availablePlayers\x12;\n" +
+	"\x0fchanged_players\x18\x02 \x03(\v2\x12.pirates.v1.PlayerR\x0echangedPlayers\x12.\n" +
+	"\x13departed_player_ids\x18\x03 \x03(\tR\x11departedPlayerIds\"\xd8\x02\n" +
 	"\rMatchProposal\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12.\n" +
 	"\bopponent\x18\x02 \x01(\v2\x12.pirates.v1.PlayerR\bopponent\x12#\n" +
 	"\ryou_initiated\x18\x03 \x01(\bR\fyouInitiated\x12'\n" +
-	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\"o\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x12+\n" +
+	"\x05rules\x18\x05 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\x120\n" +
+	"\topponents\x18\x06 \x03(\v2\x12.pirates.v1.PlayerR\topponents\x12*\n" +
+	"\x06allies\x18\a \x03(\v2\x12.pirates.v1.PlayerR\x06allies\x12#\n" +
+	"\rtournament_id\x18\b \x01(\tR\ftournamentId\"o\n" +
 	"\vMatchResult\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x1a\n" +
 	"\baccepted\x18\x02 \x01(\bR\baccepted\x12)\n" +
-	"\x10rejection_reason\x18\x03 \x01(\tR\x0frejectionReason\"~\n" +
+	"\x10rejection_reason\x18\x03 \x01(\tR\x0frejectionReason\"\xe4\x02\n" +
 	"\vGameStarted\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12.\n" +
 	"\bopponent\x18\x02 \x01(\v2\x12.pirates.v1.PlayerR\bopponent\x12&\n" +
-	"\x0fyour_turn_first\x18\x03 \x01(\bR\ryourTurnFirst\"~\n" +
+	"\x0fyour_turn_first\x18\x03 \x01(\bR\ryourTurnFirst\x12+\n" +
+	"\x05rules\x18\x04 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\x12-\n" +
+	"\aterrain\x18\x05 \x01(\v2\x13.pirates.v1.TerrainR\aterrain\x120\n" +
+	"\topponents\x18\x06 \x03(\v2\x12.pirates.v1.PlayerR\topponents\x12*\n" +
+	"\x06allies\x18\a \x03(\v2\x12.pirates.v1.PlayerR\x06allies\x12*\n" +
+	"\x06series\x18\b \x01(\v2\x12.pirates.v1.SeriesR\x06series\"~\n" +
 	"\x0fPlacementResult\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x120\n" +
-	"\x14waiting_for_opponent\x18\x03 \x01(\bR\x12waitingForOpponent\"h\n" +
+	"\x14waiting_for_opponent\x18\x03 \x01(\bR\x12waitingForOpponent\"\xc8\x02\n" +
 	"\vTurnStarted\x12\x1b\n" +
 	"\tyour_turn\x18\x01 \x01(\bR\byourTurn\x12<\n" +
-	"\x10available_powers\x18\x02 \x03(\v2\x11.pirates.v1.PowerR\x0favailablePowers\"\xb5\x01\n" +
+	"\x10available_powers\x18\x02 \x03(\v2\x11.pirates.v1.PowerR\x0favailablePowers\x12\x1f\n" +
+	"\vsalvo_shots\x18\x03 \x01(\x05R\n" +
+	"salvoShots\x12\x1d\n" +
+	"\n" +
+	"bonus_turn\x18\x04 \x01(\bR\tbonusTurn\x12(\n" +
+	"\x04view\x18\x05 \x01(\v2\x14.pirates.v1.GameViewR\x04view\x12*\n" +
+	"\x11current_player_id\x18\x06 \x01(\tR\x0fcurrentPlayerId\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\a \x01(\bR\n" +
+	"eliminated\x12(\n" +
+	"\x10deadline_unix_ms\x18\b \x01(\x03R\x0edeadlineUnixMs\"\xb6\x02\n" +
 	"\fAttackResult\x12.\n" +
 	"\x06target\x18\x01 \x01(\v2\x16.pirates.v1.CoordinateR\x06target\x12\x10\n" +
 	"\x03hit\x18\x02 \x01(\bR\x03hit\x12-\n" +
 	"\tsunk_ship\x18\x03 \x01(\v2\x10.pirates.v1.ShipR\bsunkShip\x124\n" +
-	"\fpower_gained\x18\x04 \x01(\v2\x11.pirates.v1.PowerR\vpowerGained\"m\n" +
+	"\fpower_gained\x18\x04 \x01(\v2\x11.pirates.v1.PowerR\vpowerGained\x12\x16\n" +
+	"\x06island\x18\x05 \x01(\bR\x06island\x12\x12\n" +
+	"\x04mine\x18\x06 \x01(\bR\x04mine\x127\n" +
+	"\n" +
+	"mine_blast\x18\a \x01(\v2\x18.pirates.v1.AttackResultR\tmineBlast\x12\x1a\n" +
+	"\bshielded\x18\b \x01(\bR\bshielded\"\xa6\x01\n" +
+	"\vSalvoResult\x12.\n" +
+	"\x05shots\x18\x01 \x03(\v2\x18.pirates.v1.AttackResultR\x05shots\x12/\n" +
+	"\n" +
+	"sunk_ships\x18\x02 \x03(\v2\x10.pirates.v1.ShipR\tsunkShips\x126\n" +
+	"\rpowers_gained\x18\x03 \x03(\v2\x11.pirates.v1.PowerR\fpowersGained\"m\n" +
 	"\n" +
 	"CellReveal\x122\n" +
 	"\bposition\x18\x01 \x01(\v2\x16.pirates.v1.CoordinateR\bposition\x12+\n" +
-	"\x05state\x18\x02 \x01(\x0e2\x15.pirates.v1.CellStateR\x05state\"\xcd\x01\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x15.pirates.v1.CellStateR\x05state\"a\n" +
+	"\tBoardView\x12,\n" +
+	"\x05cells\x18\x01 \x03(\v2\x16.pirates.v1.CellRevealR\x05cells\x12&\n" +
+	"\x05ships\x18\x02 \x03(\v2\x10.pirates.v1.ShipR\x05ships\"\xe6\x01\n" +
+	"\bGameView\x124\n" +
+	"\n" +
+	"your_board\x18\x01 \x01(\v2\x15.pirates.v1.BoardViewR\tyourBoard\x12<\n" +
+	"\x0eopponent_board\x18\x02 \x01(\v2\x15.pirates.v1.BoardViewR\ropponentBoard\x125\n" +
+	"\topponents\x18\x03 \x03(\v2\x17.pirates.v1.PlayerBoardR\topponents\x12/\n" +
+	"\x06allies\x18\x04 \x03(\v2\x17.pirates.v1.PlayerBoardR\x06allies\"w\n" +
+	"\vPlayerBoard\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12+\n" +
+	"\x05board\x18\x02 \x01(\v2\x15.pirates.v1.BoardViewR\x05board\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x03 \x01(\bR\n" +
+	"eliminated\"\xdb\x02\n" +
 	"\vPowerResult\x124\n" +
 	"\n" +
 	"power_used\x18\x01 \x01(\x0e2\x15.pirates.v1.PowerTypeR\tpowerUsed\x12=\n" +
 	"\x0ecells_affected\x18\x02 \x03(\v2\x16.pirates.v1.CellRevealR\rcellsAffected\x12/\n" +
 	"\n" +
 	"sunk_ships\x18\x03 \x03(\v2\x10.pirates.v1.ShipR\tsunkShips\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\xc3\x01\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\vmine_blasts\x18\x05 \x03(\v2\x18.pirates.v1.AttackResultR\n" +
+	"mineBlasts\x12\x19\n" +
+	"\bown_grid\x18\x06 \x01(\bR\aownGrid\x126\n" +
+	"\rpowers_gained\x18\a \x03(\v2\x11.pirates.v1.PowerR\fpowersGained\"\xbf\x02\n" +
 	"\x0eOpponentAction\x122\n" +
 	"\x06attack\x18\x01 \x01(\v2\x18.pirates.v1.AttackResultH\x00R\x06attack\x12/\n" +
-	"\x05power\x18\x02 \x01(\v2\x17.pirates.v1.PowerResultH\x00R\x05power\x12B\n" +
-	"\x11your_grid_updates\x18\x03 \x03(\v2\x16.pirates.v1.CellRevealR\x0fyourGridUpdatesB\b\n" +
-	"\x06action\";\n" +
+	"\x05power\x18\x02 \x01(\v2\x17.pirates.v1.PowerResultH\x00R\x05power\x12/\n" +
+	"\x05salvo\x18\x04 \x01(\v2\x17.pirates.v1.SalvoResultH\x00R\x05salvo\x12B\n" +
+	"\x11your_grid_updates\x18\x03 \x03(\v2\x16.pirates.v1.CellRevealR\x0fyourGridUpdates\x12\x1f\n" +
+	"\vattacker_id\x18\x05 \x01(\tR\n" +
+	"attackerId\x12(\n" +
+	"\x10target_player_id\x18\x06 \x01(\tR\x0etargetPlayerIdB\b\n" +
+	"\x06action\"Y\n" +
+	"\tFleetShip\x12$\n" +
+	"\x04ship\x18\x01 \x01(\v2\x10.pirates.v1.ShipR\x04ship\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x05R\x04hits\x12\x12\n" +
+	"\x04sunk\x18\x03 \x01(\bR\x04sunk\"\xb9\x01\n" +
+	"\x0fPlayerGameStats\x12\x1f\n" +
+	"\vshots_fired\x18\x01 \x01(\x05R\n" +
+	"shotsFired\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x05R\x04hits\x12\x1a\n" +
+	"\baccuracy\x18\x03 \x01(\x02R\baccuracy\x126\n" +
+	"\vpowers_used\x18\x04 \x03(\x0e2\x15.pirates.v1.PowerTypeR\n" +
+	"powersUsed\x12\x1d\n" +
+	"\n" +
+	"ships_sunk\x18\x05 \x01(\x05R\tshipsSunk\"\xfe\x03\n" +
+	"\vGameSummary\x124\n" +
+	"\n" +
+	"your_fleet\x18\x01 \x03(\v2\x15.pirates.v1.FleetShipR\tyourFleet\x12<\n" +
+	"\x0eopponent_fleet\x18\x02 \x03(\v2\x15.pirates.v1.FleetShipR\ropponentFleet\x12:\n" +
+	"\n" +
+	"your_stats\x18\x03 \x01(\v2\x1b.pirates.v1.PlayerGameStatsR\tyourStats\x12B\n" +
+	"\x0eopponent_stats\x18\x04 \x01(\v2\x1b.pirates.v1.PlayerGameStatsR\ropponentStats\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x05R\x0fdurationSeconds\x12#\n" +
+	"\rrating_change\x18\x06 \x01(\x05R\fratingChange\x12?\n" +
+	"\x0fopponent_decoys\x18\a \x03(\v2\x16.pirates.v1.CoordinateR\x0eopponentDecoys\x127\n" +
+	"\topponents\x18\b \x03(\v2\x19.pirates.v1.PlayerSummaryR\topponents\x121\n" +
+	"\x06allies\x18\t \x03(\v2\x19.pirates.v1.PlayerSummaryR\x06allies\"\xbc\x01\n" +
+	"\rPlayerSummary\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12+\n" +
+	"\x05fleet\x18\x02 \x03(\v2\x15.pirates.v1.FleetShipR\x05fleet\x121\n" +
+	"\x05stats\x18\x03 \x01(\v2\x1b.pirates.v1.PlayerGameStatsR\x05stats\x12.\n" +
+	"\x06decoys\x18\x04 \x03(\v2\x16.pirates.v1.CoordinateR\x06decoys\"\xd9\x01\n" +
 	"\bGameOver\x12\x17\n" +
-	"\ayou_won\x18\x01 \x01(\bR\x06youWon\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xdd\x04\n" +
+	"\ayou_won\x18\x01 \x01(\bR\x06youWon\x122\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1a.pirates.v1.GameOverReasonR\x06reason\x121\n" +
+	"\asummary\x18\x04 \x01(\v2\x17.pirates.v1.GameSummaryR\asummary\x12\x1b\n" +
+	"\twinner_id\x18\x05 \x01(\tR\bwinnerId\x12*\n" +
+	"\x06series\x18\x06 \x01(\v2\x12.pirates.v1.SeriesR\x06seriesJ\x04\b\x02\x10\x03\"\xa3\x01\n" +
+	"\x06Series\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x05R\x06length\x12\x1f\n" +
+	"\vgame_number\x18\x02 \x01(\x05R\n" +
+	"gameNumber\x12/\n" +
+	"\x06scores\x18\x03 \x03(\v2\x17.pirates.v1.SeriesScoreR\x06scores\x12\x12\n" +
+	"\x04over\x18\x04 \x01(\bR\x04over\x12\x1b\n" +
+	"\twinner_id\x18\x05 \x01(\tR\bwinnerId\">\n" +
+	"\vSeriesScore\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04wins\x18\x02 \x01(\x05R\x04wins\"H\n" +
+	"\x14OpponentDisconnected\x120\n" +
+	"\x14grace_period_seconds\x18\x01 \x01(\x05R\x12gracePeriodSeconds\"\x15\n" +
+	"\x13OpponentReconnected\"c\n" +
+	"\x10PlayerEliminated\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x122\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1a.pirates.v1.GameOverReasonR\x06reason\"\xe1\x01\n" +
+	"\vChatMessage\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x02 \x01(\tR\n" +
+	"senderName\x12+\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x15.pirates.v1.ChatScopeR\x05scope\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12,\n" +
+	"\x05emote\x18\x05 \x01(\x0e2\x16.pirates.v1.QuickEmoteR\x05emote\x12%\n" +
+	"\x0fsent_at_unix_ms\x18\x06 \x01(\x03R\fsentAtUnixMs\"\xef\n" +
+	"\n" +
 	"\tGameEvent\x12B\n" +
 	"\fqueue_status\x18\x01 \x01(\v2\x1d.pirates.v1.QueueStatusUpdateH\x00R\vqueueStatus\x12?\n" +
 	"\vplayer_list\x18\x02 \x01(\v2\x1c.pirates.v1.PlayerListUpdateH\x00R\n" +
@@ -2194,39 +8180,268 @@ const file_pirates_v1_pirates_proto_rawDesc = "" +
 	"\fturn_started\x18\x06 \x01(\v2\x17.pirates.v1.TurnStartedH\x00R\vturnStarted\x12E\n" +
 	"\x0fopponent_action\x18\a \x01(\v2\x1a.pirates.v1.OpponentActionH\x00R\x0eopponentAction\x123\n" +
 	"\tgame_over\x18\b \x01(\v2\x14.pirates.v1.GameOverH\x00R\bgameOver\x12H\n" +
-	"\x10placement_update\x18\t \x01(\v2\x1b.pirates.v1.PlacementResultH\x00R\x0fplacementUpdateB\a\n" +
-	"\x05event*\x85\x01\n" +
+	"\x10placement_update\x18\t \x01(\v2\x1b.pirates.v1.PlacementResultH\x00R\x0fplacementUpdate\x12W\n" +
+	"\x15opponent_disconnected\x18\n" +
+	" \x01(\v2 .pirates.v1.OpponentDisconnectedH\x00R\x14opponentDisconnected\x12T\n" +
+	"\x14opponent_reconnected\x18\v \x01(\v2\x1f.pirates.v1.OpponentReconnectedH\x00R\x13opponentReconnected\x12<\n" +
+	"\fchat_message\x18\f \x01(\v2\x17.pirates.v1.ChatMessageH\x00R\vchatMessage\x12K\n" +
+	"\x11player_eliminated\x18\r \x01(\v2\x1c.pirates.v1.PlayerEliminatedH\x00R\x10playerEliminated\x12<\n" +
+	"\fparty_invite\x18\x0e \x01(\v2\x17.pirates.v1.PartyInviteH\x00R\vpartyInvite\x126\n" +
+	"\fparty_update\x18\x0f \x01(\v2\x11.pirates.v1.PartyH\x00R\vpartyUpdate\x12E\n" +
+	"\x0fparty_disbanded\x18\x10 \x01(\v2\x1a.pirates.v1.PartyDisbandedH\x00R\x0epartyDisbanded\x12L\n" +
+	"\x11tournament_update\x18\x11 \x01(\v2\x1d.pirates.v1.TournamentBracketH\x00R\x10tournamentUpdate\x12L\n" +
+	"\x14achievement_unlocked\x18\x12 \x01(\v2\x17.pirates.v1.AchievementH\x00R\x13achievementUnlocked\x12B\n" +
+	"\x0efriend_request\x18\x13 \x01(\v2\x19.pirates.v1.FriendRequestH\x00R\rfriendRequest\x129\n" +
+	"\vfriend_list\x18\x14 \x01(\v2\x16.pirates.v1.FriendListH\x00R\n" +
+	"friendListB\a\n" +
+	"\x05event\"b\n" +
+	"\x05Party\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tleader_id\x18\x02 \x01(\tR\bleaderId\x12,\n" +
+	"\amembers\x18\x03 \x03(\v2\x12.pirates.v1.PlayerR\amembers\"d\n" +
+	"\vPartyInvite\x12'\n" +
+	"\x05party\x18\x01 \x01(\v2\x11.pirates.v1.PartyR\x05party\x12,\n" +
+	"\ainviter\x18\x02 \x01(\v2\x12.pirates.v1.PlayerR\ainviter\"+\n" +
+	"\x0ePartyDisbanded\x12\x19\n" +
+	"\bparty_id\x18\x01 \x01(\tR\apartyId\"\xcd\x02\n" +
+	"\n" +
+	"Tournament\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\forganizer_id\x18\x03 \x01(\tR\vorganizerId\x124\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1c.pirates.v1.TournamentFormatR\x06format\x12+\n" +
+	"\x05rules\x18\x05 \x01(\v2\x15.pirates.v1.GameRulesR\x05rules\x124\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1c.pirates.v1.TournamentStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\a \x03(\tR\tplayerIds\x12#\n" +
+	"\rcurrent_round\x18\b \x01(\x05R\fcurrentRound\x12\x1b\n" +
+	"\twinner_id\x18\t \x01(\tR\bwinnerId\"\x8a\x02\n" +
+	"\x0fTournamentMatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12\x1d\n" +
+	"\n" +
+	"player1_id\x18\x03 \x01(\tR\tplayer1Id\x12\x1d\n" +
+	"\n" +
+	"player2_id\x18\x04 \x01(\tR\tplayer2Id\x12\x17\n" +
+	"\agame_id\x18\x05 \x01(\tR\x06gameId\x12\x1a\n" +
+	"\bfinished\x18\x06 \x01(\bR\bfinished\x12\x1b\n" +
+	"\twinner_id\x18\a \x01(\tR\bwinnerId\x12\x17\n" +
+	"\ano_show\x18\b \x01(\bR\x06noShow\x12(\n" +
+	"\x10deadline_unix_ms\x18\t \x01(\x03R\x0edeadlineUnixMs\"\x91\x01\n" +
+	"\x12TournamentStanding\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x05R\x04rank\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x04 \x01(\x05R\x06losses\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x05 \x01(\bR\n" +
+	"eliminated\"\xc0\x01\n" +
+	"\x11TournamentBracket\x126\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x16.pirates.v1.TournamentR\n" +
+	"tournament\x125\n" +
+	"\amatches\x18\x02 \x03(\v2\x1b.pirates.v1.TournamentMatchR\amatches\x12<\n" +
+	"\tstandings\x18\x03 \x03(\v2\x1e.pirates.v1.TournamentStandingR\tstandings\"\xce\x01\n" +
+	"\vDailyPuzzle\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12<\n" +
+	"\x10available_powers\x18\x02 \x03(\v2\x11.pirates.v1.PowerR\x0favailablePowers\x12+\n" +
+	"\x05board\x18\x03 \x01(\v2\x15.pirates.v1.BoardViewR\x05board\x12\x14\n" +
+	"\x05shots\x18\x04 \x01(\x05R\x05shots\x12\x16\n" +
+	"\x06solved\x18\x05 \x01(\bR\x06solved\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\"\xb2\x01\n" +
+	"\x10PuzzleShotResult\x122\n" +
+	"\x06attack\x18\x01 \x01(\v2\x18.pirates.v1.AttackResultH\x00R\x06attack\x12/\n" +
+	"\x05power\x18\x02 \x01(\v2\x17.pirates.v1.PowerResultH\x00R\x05power\x12/\n" +
+	"\x06puzzle\x18\x03 \x01(\v2\x17.pirates.v1.DailyPuzzleR\x06puzzleB\b\n" +
+	"\x06action\"X\n" +
+	"\x11PuzzleLeaderboard\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12/\n" +
+	"\x06scores\x18\x02 \x03(\v2\x17.pirates.v1.PuzzleScoreR\x06scores\"\xa2\x01\n" +
+	"\vPuzzleScore\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05shots\x18\x04 \x01(\x05R\x05shots\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x05R\x0fdurationSeconds\"\x82\x04\n" +
+	"\rPlayerProfile\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12!\n" +
+	"\fgames_played\x18\x03 \x01(\x05R\vgamesPlayed\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x05 \x01(\x05R\x06losses\x12\x1a\n" +
+	"\bforfeits\x18\x06 \x01(\x05R\bforfeits\x12/\n" +
+	"\x14average_shots_to_win\x18\a \x01(\x02R\x11averageShotsToWin\x12\x1a\n" +
+	"\baccuracy\x18\b \x01(\x02R\baccuracy\x12<\n" +
+	"\x0efavorite_power\x18\t \x01(\x0e2\x15.pirates.v1.PowerTypeR\rfavoritePower\x12%\n" +
+	"\x0ecurrent_streak\x18\n" +
+	" \x01(\x05R\rcurrentStreak\x12\x16\n" +
+	"\x06rating\x18\v \x01(\x05R\x06rating\x12?\n" +
+	"\x0erating_history\x18\f \x03(\v2\x18.pirates.v1.RatingChangeR\rratingHistory\x12;\n" +
+	"\fachievements\x18\r \x03(\v2\x17.pirates.v1.AchievementR\fachievements\"u\n" +
+	"\fRatingChange\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x16\n" +
+	"\x06change\x18\x03 \x01(\x05R\x06change\x12\x1c\n" +
+	"\n" +
+	"at_unix_ms\x18\x04 \x01(\x03R\batUnixMs\"\xe7\x01\n" +
+	"\vLeaderboard\x122\n" +
+	"\x05scope\x18\x01 \x01(\x0e2\x1c.pirates.v1.LeaderboardScopeR\x05scope\x12.\n" +
+	"\brule_set\x18\x02 \x01(\x0e2\x13.pirates.v1.RuleSetR\aruleSet\x126\n" +
+	"\aentries\x18\x03 \x03(\v2\x1c.pirates.v1.LeaderboardEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"\xc2\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x05R\x06points\x12\x12\n" +
+	"\x04wins\x18\x06 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\a \x01(\x05R\x06losses\"\xce\x01\n" +
+	"\vAchievement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x05R\bprogress\x12\x12\n" +
+	"\x04goal\x18\x05 \x01(\x05R\x04goal\x12\x1a\n" +
+	"\bunlocked\x18\x06 \x01(\bR\bunlocked\x12-\n" +
+	"\x13unlocked_at_unix_ms\x18\a \x01(\x03R\x10unlockedAtUnixMs\"\xea\x01\n" +
+	"\n" +
+	"FriendList\x12,\n" +
+	"\afriends\x18\x01 \x03(\v2\x12.pirates.v1.PlayerR\afriends\x12?\n" +
+	"\x11incoming_requests\x18\x02 \x03(\v2\x12.pirates.v1.PlayerR\x10incomingRequests\x12?\n" +
+	"\x11outgoing_requests\x18\x03 \x03(\v2\x12.pirates.v1.PlayerR\x10outgoingRequests\x12,\n" +
+	"\x12blocked_player_ids\x18\x04 \x03(\tR\x10blockedPlayerIds\"7\n" +
+	"\rFriendRequest\x12&\n" +
+	"\x04from\x18\x01 \x01(\v2\x12.pirates.v1.PlayerR\x04from*\xc9\x01\n" +
 	"\tPowerType\x12\x1a\n" +
 	"\x16POWER_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14POWER_TYPE_INSTAKILL\x10\x01\x12\x15\n" +
 	"\x11POWER_TYPE_TRIPLE\x10\x02\x12\x14\n" +
 	"\x10POWER_TYPE_SONAR\x10\x03\x12\x15\n" +
-	"\x11POWER_TYPE_KRAKEN\x10\x04*\x90\x01\n" +
+	"\x11POWER_TYPE_KRAKEN\x10\x04\x12\x15\n" +
+	"\x11POWER_TYPE_SHIELD\x10\x05\x12\x15\n" +
+	"\x11POWER_TYPE_REPAIR\x10\x06\x12\x14\n" +
+	"\x10POWER_TYPE_DECOY\x10\a*\xf4\x01\n" +
+	"\vOrientation\x12\x1b\n" +
+	"\x17ORIENTATION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ORIENTATION_EAST\x10\x01\x12\x1a\n" +
+	"\x16ORIENTATION_NORTH_EAST\x10\x02\x12\x15\n" +
+	"\x11ORIENTATION_NORTH\x10\x03\x12\x1a\n" +
+	"\x16ORIENTATION_NORTH_WEST\x10\x04\x12\x14\n" +
+	"\x10ORIENTATION_WEST\x10\x05\x12\x1a\n" +
+	"\x16ORIENTATION_SOUTH_WEST\x10\x06\x12\x15\n" +
+	"\x11ORIENTATION_SOUTH\x10\a\x12\x1a\n" +
+	"\x16ORIENTATION_SOUTH_EAST\x10\b*\x84\x02\n" +
 	"\tCellState\x12\x16\n" +
 	"\x12CELL_STATE_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10CELL_STATE_EMPTY\x10\x01\x12\x13\n" +
 	"\x0fCELL_STATE_MISS\x10\x02\x12\x12\n" +
 	"\x0eCELL_STATE_HIT\x10\x03\x12\x13\n" +
 	"\x0fCELL_STATE_SUNK\x10\x04\x12\x17\n" +
-	"\x13CELL_STATE_REVEALED\x10\x05*~\n" +
+	"\x13CELL_STATE_REVEALED\x10\x05\x12\x15\n" +
+	"\x11CELL_STATE_ISLAND\x10\x06\x12\x13\n" +
+	"\x0fCELL_STATE_MINE\x10\a\x12\x17\n" +
+	"\x13CELL_STATE_SHIELDED\x10\b\x12\x17\n" +
+	"\x13CELL_STATE_REPAIRED\x10\t\x12\x14\n" +
+	"\x10CELL_STATE_DECOY\x10\n" +
+	"*\xa2\x01\n" +
 	"\fPlayerStatus\x12\x1d\n" +
 	"\x19PLAYER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14PLAYER_STATUS_ONLINE\x10\x01\x12\x1a\n" +
 	"\x16PLAYER_STATUS_IN_QUEUE\x10\x02\x12\x19\n" +
-	"\x15PLAYER_STATUS_IN_GAME\x10\x032\xc1\x06\n" +
+	"\x15PLAYER_STATUS_IN_GAME\x10\x03\x12\"\n" +
+	"\x1ePLAYER_STATUS_IN_PRIVATE_LOBBY\x10\x04*M\n" +
+	"\aRuleSet\x12\x18\n" +
+	"\x14RULE_SET_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10RULE_SET_CLASSIC\x10\x01\x12\x12\n" +
+	"\x0eRULE_SET_SALVO\x10\x02*V\n" +
+	"\tBonusTurn\x12\x1a\n" +
+	"\x16BONUS_TURN_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BONUS_TURN_ON_HIT\x10\x01\x12\x16\n" +
+	"\x12BONUS_TURN_ON_SINK\x10\x02*\xe7\x01\n" +
+	"\x0eGameOverReason\x12 \n" +
+	"\x1cGAME_OVER_REASON_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fGAME_OVER_REASON_ALL_SHIPS_SUNK\x10\x01\x12\x1c\n" +
+	"\x18GAME_OVER_REASON_FORFEIT\x10\x02\x12!\n" +
+	"\x1dGAME_OVER_REASON_TURN_TIMEOUT\x10\x03\x12\x1f\n" +
+	"\x1bGAME_OVER_REASON_DISCONNECT\x10\x04\x12&\n" +
+	"\"GAME_OVER_REASON_PLACEMENT_TIMEOUT\x10\x05\"\x04\b\x06\x10\x06*R\n" +
+	"\tChatScope\x12\x1a\n" +
+	"\x16CHAT_SCOPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCHAT_SCOPE_GAME\x10\x01\x12\x14\n" +
+	"\x10CHAT_SCOPE_LOBBY\x10\x02*\xc9\x01\n" +
+	"\n" +
+	"QuickEmote\x12\x1b\n" +
+	"\x17QUICK_EMOTE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10QUICK_EMOTE_AHOY\x10\x01\x12\x14\n" +
+	"\x10QUICK_EMOTE_ARRR\x10\x02\x12\x19\n" +
+	"\x15QUICK_EMOTE_NICE_SHOT\x10\x03\x12\x1c\n" +
+	"\x18QUICK_EMOTE_BLOW_ME_DOWN\x10\x04\x12\x1e\n" +
+	"\x1aQUICK_EMOTE_WALK_THE_PLANK\x10\x05\x12\x19\n" +
+	"\x15QUICK_EMOTE_GOOD_GAME\x10\x06*\xc9\x01\n" +
+	"\x10TournamentFormat\x12!\n" +
+	"\x1dTOURNAMENT_FORMAT_UNSPECIFIED\x10\x00\x12(\n" +
+	"$TOURNAMENT_FORMAT_SINGLE_ELIMINATION\x10\x01\x12(\n" +
+	"$TOURNAMENT_FORMAT_DOUBLE_ELIMINATION\x10\x02\x12!\n" +
+	"\x1dTOURNAMENT_FORMAT_ROUND_ROBIN\x10\x03\x12\x1b\n" +
+	"\x17TOURNAMENT_FORMAT_SWISS\x10\x04*\x98\x01\n" +
+	"\x10TournamentStatus\x12!\n" +
+	"\x1dTOURNAMENT_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eTOURNAMENT_STATUS_REGISTRATION\x10\x01\x12\x1d\n" +
+	"\x19TOURNAMENT_STATUS_RUNNING\x10\x02\x12\x1e\n" +
+	"\x1aTOURNAMENT_STATUS_FINISHED\x10\x03*\x91\x01\n" +
+	"\x10LeaderboardScope\x12!\n" +
+	"\x1dLEADERBOARD_SCOPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18LEADERBOARD_SCOPE_GLOBAL\x10\x01\x12\x1c\n" +
+	"\x18LEADERBOARD_SCOPE_WEEKLY\x10\x02\x12\x1e\n" +
+	"\x1aLEADERBOARD_SCOPE_RULE_SET\x10\x032\xff\x19\n" +
 	"\x0ePiratesService\x12B\n" +
 	"\aConnect\x12\x1a.pirates.v1.ConnectRequest\x1a\x1b.pirates.v1.ConnectResponse\x12H\n" +
 	"\tJoinQueue\x12\x1c.pirates.v1.JoinQueueRequest\x1a\x1d.pirates.v1.QueueStatusUpdate\x12K\n" +
 	"\n" +
-	"LeaveQueue\x12\x1d.pirates.v1.LeaveQueueRequest\x1a\x1e.pirates.v1.LeaveQueueResponse\x12K\n" +
+	"LeaveQueue\x12\x1d.pirates.v1.LeaveQueueRequest\x1a\x1e.pirates.v1.LeaveQueueResponse\x12R\n" +
+	"\x0eGetQueueStatus\x12!.pirates.v1.GetQueueStatusRequest\x1a\x1d.pirates.v1.QueueStatusUpdate\x12K\n" +
 	"\vListPlayers\x12\x1e.pirates.v1.ListPlayersRequest\x1a\x1c.pirates.v1.PlayerListUpdate\x12Z\n" +
 	"\x0fChallengePlayer\x12\".pirates.v1.ChallengePlayerRequest\x1a#.pirates.v1.ChallengePlayerResponse\x12L\n" +
-	"\x0eRespondToMatch\x12!.pirates.v1.RespondToMatchRequest\x1a\x17.pirates.v1.MatchResult\x12H\n" +
+	"\x0eRespondToMatch\x12!.pirates.v1.RespondToMatchRequest\x1a\x17.pirates.v1.MatchResult\x12U\n" +
+	"\x12CreatePrivateLobby\x12%.pirates.v1.CreatePrivateLobbyRequest\x1a\x18.pirates.v1.PrivateLobby\x12]\n" +
+	"\x10JoinPrivateLobby\x12#.pirates.v1.JoinPrivateLobbyRequest\x1a$.pirates.v1.JoinPrivateLobbyResponse\x12`\n" +
+	"\x11ClosePrivateLobby\x12$.pirates.v1.ClosePrivateLobbyRequest\x1a%.pirates.v1.ClosePrivateLobbyResponse\x12D\n" +
+	"\rInviteToParty\x12 .pirates.v1.InviteToPartyRequest\x1a\x11.pirates.v1.Party\x12R\n" +
+	"\x14RespondToPartyInvite\x12'.pirates.v1.RespondToPartyInviteRequest\x1a\x11.pirates.v1.Party\x12K\n" +
+	"\n" +
+	"LeaveParty\x12\x1d.pirates.v1.LeavePartyRequest\x1a\x1e.pirates.v1.LeavePartyResponse\x12O\n" +
+	"\x10CreateTournament\x12#.pirates.v1.CreateTournamentRequest\x1a\x16.pirates.v1.Tournament\x12Z\n" +
+	"\x0fListTournaments\x12\".pirates.v1.ListTournamentsRequest\x1a#.pirates.v1.ListTournamentsResponse\x12Y\n" +
+	"\x15RegisterForTournament\x12(.pirates.v1.RegisterForTournamentRequest\x1a\x16.pirates.v1.Tournament\x12[\n" +
+	"\x16WithdrawFromTournament\x12).pirates.v1.WithdrawFromTournamentRequest\x1a\x16.pirates.v1.Tournament\x12T\n" +
+	"\x0fStartTournament\x12\".pirates.v1.StartTournamentRequest\x1a\x1d.pirates.v1.TournamentBracket\x12P\n" +
+	"\rGetTournament\x12 .pirates.v1.GetTournamentRequest\x1a\x1d.pirates.v1.TournamentBracket\x12P\n" +
+	"\x10StartDailyPuzzle\x12#.pirates.v1.StartDailyPuzzleRequest\x1a\x17.pirates.v1.DailyPuzzle\x12M\n" +
+	"\fPuzzleAttack\x12\x1f.pirates.v1.PuzzleAttackRequest\x1a\x1c.pirates.v1.PuzzleShotResult\x12Q\n" +
+	"\x0ePuzzleUsePower\x12!.pirates.v1.PuzzleUsePowerRequest\x1a\x1c.pirates.v1.PuzzleShotResult\x12^\n" +
+	"\x14GetPuzzleLeaderboard\x12'.pirates.v1.GetPuzzleLeaderboardRequest\x1a\x1d.pirates.v1.PuzzleLeaderboard\x12R\n" +
+	"\x10GetPlayerProfile\x12#.pirates.v1.GetPlayerProfileRequest\x1a\x19.pirates.v1.PlayerProfile\x12L\n" +
+	"\x0eGetLeaderboard\x12!.pirates.v1.GetLeaderboardRequest\x1a\x17.pirates.v1.Leaderboard\x12Q\n" +
+	"\x11SendFriendRequest\x12$.pirates.v1.SendFriendRequestRequest\x1a\x16.pirates.v1.FriendList\x12[\n" +
+	"\x16RespondToFriendRequest\x12).pirates.v1.RespondToFriendRequestRequest\x1a\x16.pirates.v1.FriendList\x12G\n" +
+	"\fRemoveFriend\x12\x1f.pirates.v1.RemoveFriendRequest\x1a\x16.pirates.v1.FriendList\x12E\n" +
+	"\vBlockPlayer\x12\x1e.pirates.v1.BlockPlayerRequest\x1a\x16.pirates.v1.FriendList\x12I\n" +
+	"\rUnblockPlayer\x12 .pirates.v1.UnblockPlayerRequest\x1a\x16.pirates.v1.FriendList\x12C\n" +
+	"\n" +
+	"GetFriends\x12\x1d.pirates.v1.GetFriendsRequest\x1a\x16.pirates.v1.FriendList\x12Z\n" +
+	"\x0fChallengeFriend\x12\".pirates.v1.ChallengeFriendRequest\x1a#.pirates.v1.ChallengeFriendResponse\x12H\n" +
 	"\n" +
 	"PlaceShips\x12\x1d.pirates.v1.PlaceShipsRequest\x1a\x1b.pirates.v1.PlacementResult\x12=\n" +
-	"\x06Attack\x12\x19.pirates.v1.AttackRequest\x1a\x18.pirates.v1.AttackResult\x12@\n" +
-	"\bUsePower\x12\x1b.pirates.v1.UsePowerRequest\x1a\x17.pirates.v1.PowerResult\x12B\n" +
-	"\aForfeit\x12\x1a.pirates.v1.ForfeitRequest\x1a\x1b.pirates.v1.ForfeitResponse\x12N\n" +
+	"\x06Attack\x12\x19.pirates.v1.AttackRequest\x1a\x18.pirates.v1.AttackResult\x12F\n" +
+	"\vAttackSalvo\x12\x1e.pirates.v1.AttackSalvoRequest\x1a\x17.pirates.v1.SalvoResult\x12@\n" +
+	"\bUsePower\x12\x1b.pirates.v1.UsePowerRequest\x1a\x17.pirates.v1.PowerResult\x12I\n" +
+	"\fPreviewPower\x12\x1f.pirates.v1.PreviewPowerRequest\x1a\x18.pirates.v1.PowerPreview\x12B\n" +
+	"\aForfeit\x12\x1a.pirates.v1.ForfeitRequest\x1a\x1b.pirates.v1.ForfeitResponse\x12Z\n" +
+	"\x0fSendChatMessage\x12\".pirates.v1.SendChatMessageRequest\x1a#.pirates.v1.SendChatMessageResponse\x12K\n" +
+	"\n" +
+	"MutePlayer\x12\x1d.pirates.v1.MutePlayerRequest\x1a\x1e.pirates.v1.MutePlayerResponse\x12N\n" +
 	"\x0fSubscribeEvents\x12\".pirates.v1.SubscribeEventsRequest\x1a\x15.pirates.v1.GameEvent0\x01BFZDgithub.com/trezz/bataille-de-pirates/server/gen/pirates/v1;piratesv1b\x06proto3"
 
 var (
@@ -2241,105 +8456,355 @@ func file_pirates_v1_pirates_proto_rawDescGZIP() []byte {
 	return file_pirates_v1_pirates_proto_rawDescData
 }
 
-var file_pirates_v1_pirates_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_pirates_v1_pirates_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_pirates_v1_pirates_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_pirates_v1_pirates_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_pirates_v1_pirates_proto_goTypes = []any{
-	(PowerType)(0),                  // 0: pirates.v1.PowerType
-	(CellState)(0),                  // 1: pirates.v1.CellState
-	(PlayerStatus)(0),               // 2: pirates.v1.PlayerStatus
-	(*Coordinate)(nil),              // 3: pirates.v1.Coordinate
-	(*Ship)(nil),                    // 4: pirates.v1.Ship
-	(*Power)(nil),                   // 5: pirates.v1.Power
-	(*Player)(nil),                  // 6: pirates.v1.Player
-	(*ConnectRequest)(nil),          // 7: pirates.v1.ConnectRequest
-	(*ConnectResponse)(nil),         // 8: pirates.v1.ConnectResponse
-	(*JoinQueueRequest)(nil),        // 9: pirates.v1.JoinQueueRequest
-	(*LeaveQueueRequest)(nil),       // 10: pirates.v1.LeaveQueueRequest
-	(*LeaveQueueResponse)(nil),      // 11: pirates.v1.LeaveQueueResponse
-	(*ListPlayersRequest)(nil),      // 12: pirates.v1.ListPlayersRequest
-	(*ChallengePlayerRequest)(nil),  // 13: pirates.v1.ChallengePlayerRequest
-	(*ChallengePlayerResponse)(nil), // 14: pirates.v1.ChallengePlayerResponse
-	(*RespondToMatchRequest)(nil),   // 15: pirates.v1.RespondToMatchRequest
-	(*ForfeitRequest)(nil),          // 16: pirates.v1.ForfeitRequest
-	(*ForfeitResponse)(nil),         // 17: pirates.v1.ForfeitResponse
-	(*PlaceShipsRequest)(nil),       // 18: pirates.v1.PlaceShipsRequest
-	(*AttackRequest)(nil),           // 19: pirates.v1.AttackRequest
-	(*UsePowerRequest)(nil),         // 20: pirates.v1.UsePowerRequest
-	(*SubscribeEventsRequest)(nil),  // 21: pirates.v1.SubscribeEventsRequest
-	(*QueueStatusUpdate)(nil),       // 22: pirates.v1.QueueStatusUpdate
-	(*PlayerListUpdate)(nil),        // 23: pirates.v1.PlayerListUpdate
-	(*MatchProposal)(nil),           // 24: pirates.v1.MatchProposal
-	(*MatchResult)(nil),             // 25: pirates.v1.MatchResult
-	(*GameStarted)(nil),             // 26: pirates.v1.GameStarted
-	(*PlacementResult)(nil),         // 27: pirates.v1.PlacementResult
-	(*TurnStarted)(nil),             // 28: pirates.v1.TurnStarted
-	(*AttackResult)(nil),            // 29: pirates.v1.AttackResult
-	(*CellReveal)(nil),              // 30: pirates.v1.CellReveal
-	(*PowerResult)(nil),             // 31: pirates.v1.PowerResult
-	(*OpponentAction)(nil),          // 32: pirates.v1.OpponentAction
-	(*GameOver)(nil),                // 33: pirates.v1.GameOver
-	(*GameEvent)(nil),               // 34: pirates.v1.GameEvent
+	(PowerType)(0),                        // 0: pirates.v1.PowerType
+	(Orientation)(0),                      // 1: pirates.v1.Orientation
+	(CellState)(0),                        // 2: pirates.v1.CellState
+	(PlayerStatus)(0),                     // 3: pirates.v1.PlayerStatus
+	(RuleSet)(0),                          // 4: pirates.v1.RuleSet
+	(BonusTurn)(0),                        // 5: pirates.v1.BonusTurn
+	(GameOverReason)(0),                   // 6: pirates.v1.GameOverReason
+	(ChatScope)(0),                        // 7: pirates.v1.ChatScope
+	(QuickEmote)(0),                       // 8: pirates.v1.QuickEmote
+	(TournamentFormat)(0),                 // 9: pirates.v1.TournamentFormat
+	(TournamentStatus)(0),                 // 10: pirates.v1.TournamentStatus
+	(LeaderboardScope)(0),                 // 11: pirates.v1.LeaderboardScope
+	(*Coordinate)(nil),                    // 12: pirates.v1.Coordinate
+	(*Ship)(nil),                          // 13: pirates.v1.Ship
+	(*Power)(nil),                         // 14: pirates.v1.Power
+	(*Player)(nil),                        // 15: pirates.v1.Player
+	(*GameRules)(nil),                     // 16: pirates.v1.GameRules
+	(*Terrain)(nil),                       // 17: pirates.v1.Terrain
+	(*ConnectRequest)(nil),                // 18: pirates.v1.ConnectRequest
+	(*ConnectResponse)(nil),               // 19: pirates.v1.ConnectResponse
+	(*JoinQueueRequest)(nil),              // 20: pirates.v1.JoinQueueRequest
+	(*LeaveQueueRequest)(nil),             // 21: pirates.v1.LeaveQueueRequest
+	(*LeaveQueueResponse)(nil),            // 22: pirates.v1.LeaveQueueResponse
+	(*GetQueueStatusRequest)(nil),         // 23: pirates.v1.GetQueueStatusRequest
+	(*ListPlayersRequest)(nil),            // 24: pirates.v1.ListPlayersRequest
+	(*ChallengePlayerRequest)(nil),        // 25: pirates.v1.ChallengePlayerRequest
+	(*ChallengePlayerResponse)(nil),       // 26: pirates.v1.ChallengePlayerResponse
+	(*RespondToMatchRequest)(nil),         // 27: pirates.v1.RespondToMatchRequest
+	(*CreatePrivateLobbyRequest)(nil),     // 28: pirates.v1.CreatePrivateLobbyRequest
+	(*PrivateLobby)(nil),                  // 29: pirates.v1.PrivateLobby
+	(*JoinPrivateLobbyRequest)(nil),       // 30: pirates.v1.JoinPrivateLobbyRequest
+	(*JoinPrivateLobbyResponse)(nil),      // 31: pirates.v1.JoinPrivateLobbyResponse
+	(*ClosePrivateLobbyRequest)(nil),      // 32: pirates.v1.ClosePrivateLobbyRequest
+	(*ClosePrivateLobbyResponse)(nil),     // 33: pirates.v1.ClosePrivateLobbyResponse
+	(*InviteToPartyRequest)(nil),          // 34: pirates.v1.InviteToPartyRequest
+	(*RespondToPartyInviteRequest)(nil),   // 35: pirates.v1.RespondToPartyInviteRequest
+	(*LeavePartyRequest)(nil),             // 36: pirates.v1.LeavePartyRequest
+	(*LeavePartyResponse)(nil),            // 37: pirates.v1.LeavePartyResponse
+	(*CreateTournamentRequest)(nil),       // 38: pirates.v1.CreateTournamentRequest
+	(*ListTournamentsRequest)(nil),        // 39: pirates.v1.ListTournamentsRequest
+	(*ListTournamentsResponse)(nil),       // 40: pirates.v1.ListTournamentsResponse
+	(*RegisterForTournamentRequest)(nil),  // 41: pirates.v1.RegisterForTournamentRequest
+	(*WithdrawFromTournamentRequest)(nil), // 42: pirates.v1.WithdrawFromTournamentRequest
+	(*StartTournamentRequest)(nil),        // 43: pirates.v1.StartTournamentRequest
+	(*GetTournamentRequest)(nil),          // 44: pirates.v1.GetTournamentRequest
+	(*StartDailyPuzzleRequest)(nil),       // 45: pirates.v1.StartDailyPuzzleRequest
+	(*PuzzleAttackRequest)(nil),           // 46: pirates.v1.PuzzleAttackRequest
+	(*PuzzleUsePowerRequest)(nil),         // 47: pirates.v1.PuzzleUsePowerRequest
+	(*GetPuzzleLeaderboardRequest)(nil),   // 48: pirates.v1.GetPuzzleLeaderboardRequest
+	(*GetPlayerProfileRequest)(nil),       // 49: pirates.v1.GetPlayerProfileRequest
+	(*GetLeaderboardRequest)(nil),         // 50: pirates.v1.GetLeaderboardRequest
+	(*SendFriendRequestRequest)(nil),      // 51: pirates.v1.SendFriendRequestRequest
+	(*RespondToFriendRequestRequest)(nil), // 52: pirates.v1.RespondToFriendRequestRequest
+	(*RemoveFriendRequest)(nil),           // 53: pirates.v1.RemoveFriendRequest
+	(*BlockPlayerRequest)(nil),            // 54: pirates.v1.BlockPlayerRequest
+	(*UnblockPlayerRequest)(nil),          // 55: pirates.v1.UnblockPlayerRequest
+	(*GetFriendsRequest)(nil),             // 56: pirates.v1.GetFriendsRequest
+	(*ChallengeFriendRequest)(nil),        // 57: pirates.v1.ChallengeFriendRequest
+	(*ChallengeFriendResponse)(nil),       // 58: pirates.v1.ChallengeFriendResponse
+	(*ForfeitRequest)(nil),                // 59: pirates.v1.ForfeitRequest
+	(*ForfeitResponse)(nil),               // 60: pirates.v1.ForfeitResponse
+	(*PlaceShipsRequest)(nil),             // 61: pirates.v1.PlaceShipsRequest
+	(*AttackRequest)(nil),                 // 62: pirates.v1.AttackRequest
+	(*AttackSalvoRequest)(nil),            // 63: pirates.v1.AttackSalvoRequest
+	(*UsePowerRequest)(nil),               // 64: pirates.v1.UsePowerRequest
+	(*PreviewPowerRequest)(nil),           // 65: pirates.v1.PreviewPowerRequest
+	(*PowerPreview)(nil),                  // 66: pirates.v1.PowerPreview
+	(*SendChatMessageRequest)(nil),        // 67: pirates.v1.SendChatMessageRequest
+	(*SendChatMessageResponse)(nil),       // 68: pirates.v1.SendChatMessageResponse
+	(*MutePlayerRequest)(nil),             // 69: pirates.v1.MutePlayerRequest
+	(*MutePlayerResponse)(nil),            // 70: pirates.v1.MutePlayerResponse
+	(*SubscribeEventsRequest)(nil),        // 71: pirates.v1.SubscribeEventsRequest
+	(*QueueStatusUpdate)(nil),             // 72: pirates.v1.QueueStatusUpdate
+	(*QueueInfo)(nil),                     // 73: pirates.v1.QueueInfo
+	(*PlayerListUpdate)(nil),              // 74: pirates.v1.PlayerListUpdate
+	(*MatchProposal)(nil),                 // 75: pirates.v1.MatchProposal
+	(*MatchResult)(nil),                   // 76: pirates.v1.MatchResult
+	(*GameStarted)(nil),                   // 77: pirates.v1.GameStarted
+	(*PlacementResult)(nil),               // 78: pirates.v1.PlacementResult
+	(*TurnStarted)(nil),                   // 79: pirates.v1.TurnStarted
+	(*AttackResult)(nil),                  // 80: pirates.v1.AttackResult
+	(*SalvoResult)(nil),                   // 81: pirates.v1.SalvoResult
+	(*CellReveal)(nil),                    // 82: pirates.v1.CellReveal
+	(*BoardView)(nil),                     // 83: pirates.v1.BoardView
+	(*GameView)(nil),                      // 84: pirates.v1.GameView
+	(*PlayerBoard)(nil),                   // 85: pirates.v1.PlayerBoard
+	(*PowerResult)(nil),                   // 86: pirates.v1.PowerResult
+	(*OpponentAction)(nil),                // 87: pirates.v1.OpponentAction
+	(*FleetShip)(nil),                     // 88: pirates.v1.FleetShip
+	(*PlayerGameStats)(nil),               // 89: pirates.v1.PlayerGameStats
+	(*GameSummary)(nil),                   // 90: pirates.v1.GameSummary
+	(*PlayerSummary)(nil),                 // 91: pirates.v1.PlayerSummary
+	(*GameOver)(nil),                      // 92: pirates.v1.GameOver
+	(*Series)(nil),                        // 93: pirates.v1.Series
+	(*SeriesScore)(nil),                   // 94: pirates.v1.SeriesScore
+	(*OpponentDisconnected)(nil),          // 95: pirates.v1.OpponentDisconnected
+	(*OpponentReconnected)(nil),           // 96: pirates.v1.OpponentReconnected
+	(*PlayerEliminated)(nil),              // 97: pirates.v1.PlayerEliminated
+	(*ChatMessage)(nil),                   // 98: pirates.v1.ChatMessage
+	(*GameEvent)(nil),                     // 99: pirates.v1.GameEvent
+	(*Party)(nil),                         // 100: pirates.v1.Party
+	(*PartyInvite)(nil),                   // 101: pirates.v1.PartyInvite
+	(*PartyDisbanded)(nil),                // 102: pirates.v1.PartyDisbanded
+	(*Tournament)(nil),                    // 103: pirates.v1.Tournament
+	(*TournamentMatch)(nil),               // 104: pirates.v1.TournamentMatch
+	(*TournamentStanding)(nil),            // 105: pirates.v1.TournamentStanding
+	(*TournamentBracket)(nil),             // 106: pirates.v1.TournamentBracket
+	(*DailyPuzzle)(nil),                   // 107: pirates.v1.DailyPuzzle
+	(*PuzzleShotResult)(nil),              // 108: pirates.v1.PuzzleShotResult
+	(*PuzzleLeaderboard)(nil),             // 109: pirates.v1.PuzzleLeaderboard
+	(*PuzzleScore)(nil),                   // 110: pirates.v1.PuzzleScore
+	(*PlayerProfile)(nil),                 // 111: pirates.v1.PlayerProfile
+	(*RatingChange)(nil),                  // 112: pirates.v1.RatingChange
+	(*Leaderboard)(nil),                   // 113: pirates.v1.Leaderboard
+	(*LeaderboardEntry)(nil),              // 114: pirates.v1.LeaderboardEntry
+	(*Achievement)(nil),                   // 115: pirates.v1.Achievement
+	(*FriendList)(nil),                    // 116: pirates.v1.FriendList
+	(*FriendRequest)(nil),                 // 117: pirates.v1.FriendRequest
 }
 var file_pirates_v1_pirates_proto_depIdxs = []int32{
-	3,  // 0: pirates.v1.Ship.start:type_name -> pirates.v1.Coordinate
-	0,  // 1: pirates.v1.Power.type:type_name -> pirates.v1.PowerType
-	2,  // 2: pirates.v1.Player.status:type_name -> pirates.v1.PlayerStatus
-	6,  // 3: pirates.v1.ConnectResponse.player:type_name -> pirates.v1.Player
-	4,  // 4: pirates.v1.PlaceShipsRequest.ships:type_name -> pirates.v1.Ship
-	3,  // 5: pirates.v1.AttackRequest.target:type_name -> pirates.v1.Coordinate
-	0,  // 6: pirates.v1.UsePowerRequest.power:type_name -> pirates.v1.PowerType
-	3,  // 7: pirates.v1.UsePowerRequest.target:type_name -> pirates.v1.Coordinate
-	6,  // 8: pirates.v1.PlayerListUpdate.available_players:type_name -> pirates.v1.Player
-	6,  // 9: pirates.v1.MatchProposal.opponent:type_name -> pirates.v1.Player
-	6,  // 10: pirates.v1.GameStarted.opponent:type_name -> pirates.v1.Player
-	5,  // 11: pirates.v1.TurnStarted.available_powers:type_name -> pirates.v1.Power
-	3,  // 12: pirates.v1.AttackResult.target:type_name -> pirates.v1.Coordinate
-	4,  // 13: pirates.v1.AttackResult.sunk_ship:type_name -> pirates.v1.Ship
-	5,  // 14: pirates.v1.AttackResult.power_gained:type_name -> pirates.v1.Power
-	3,  // 15: pirates.v1.CellReveal.position:type_name -> pirates.v1.Coordinate
-	1,  // 16: pirates.v1.CellReveal.state:type_name -> pirates.v1.CellState
-	0,  // 17: pirates.v1.PowerResult.power_used:type_name -> pirates.v1.PowerType
-	30, // 18: pirates.v1.PowerResult.cells_affected:type_name -> pirates.v1.CellReveal
-	4,  // 19: pirates.v1.PowerResult.sunk_ships:type_name -> pirates.v1.Ship
-	29, // 20: pirates.v1.OpponentAction.attack:type_name -> pirates.v1.AttackResult
-	31, // 21: pirates.v1.OpponentAction.power:type_name -> pirates.v1.PowerResult
-	30, // 22: pirates.v1.OpponentAction.your_grid_updates:type_name -> pirates.v1.CellReveal
-	22, // 23: pirates.v1.GameEvent.queue_status:type_name -> pirates.v1.QueueStatusUpdate
-	23, // 24: pirates.v1.GameEvent.player_list:type_name -> pirates.v1.PlayerListUpdate
-	24, // 25: pirates.v1.GameEvent.match_proposal:type_name -> pirates.v1.MatchProposal
-	25, // 26: pirates.v1.GameEvent.match_result:type_name -> pirates.v1.MatchResult
-	26, // 27: pirates.v1.GameEvent.game_started:type_name -> pirates.v1.GameStarted
-	28, // 28: pirates.v1.GameEvent.turn_started:type_name -> pirates.v1.TurnStarted
-	32, // 29: pirates.v1.GameEvent.opponent_action:type_name -> pirates.v1.OpponentAction
-	33, // 30: pirates.v1.GameEvent.game_over:type_name -> pirates.v1.GameOver
-	27, // 31: pirates.v1.GameEvent.placement_update:type_name -> pirates.v1.PlacementResult
-	7,  // 32: pirates.v1.PiratesService.Connect:input_type -> pirates.v1.ConnectRequest
-	9,  // 33: pirates.v1.PiratesService.JoinQueue:input_type -> pirates.v1.JoinQueueRequest
-	10, // 34: pirates.v1.PiratesService.LeaveQueue:input_type -> pirates.v1.LeaveQueueRequest
-	12, // 35: pirates.v1.PiratesService.ListPlayers:input_type -> pirates.v1.ListPlayersRequest
-	13, // 36: pirates.v1.PiratesService.ChallengePlayer:input_type -> pirates.v1.ChallengePlayerRequest
-	15, // 37: pirates.v1.PiratesService.RespondToMatch:input_type -> pirates.v1.RespondToMatchRequest
-	18, // 38: pirates.v1.PiratesService.PlaceShips:input_type -> pirates.v1.PlaceShipsRequest
-	19, // 39: pirates.v1.PiratesService.Attack:input_type -> pirates.v1.AttackRequest
-	20, // 40: pirates.v1.PiratesService.UsePower:input_type -> pirates.v1.UsePowerRequest
-	16, // 41: pirates.v1.PiratesService.Forfeit:input_type -> pirates.v1.ForfeitRequest
-	21, // 42: pirates.v1.PiratesService.SubscribeEvents:input_type -> pirates.v1.SubscribeEventsRequest
-	8,  // 43: pirates.v1.PiratesService.Connect:output_type -> pirates.v1.ConnectResponse
-	22, // 44: pirates.v1.PiratesService.JoinQueue:output_type -> pirates.v1.QueueStatusUpdate
-	11, // 45: pirates.v1.PiratesService.LeaveQueue:output_type -> pirates.v1.LeaveQueueResponse
-	23, // 46: pirates.v1.PiratesService.ListPlayers:output_type -> pirates.v1.PlayerListUpdate
-	14, // 47: pirates.v1.PiratesService.ChallengePlayer:output_type -> pirates.v1.ChallengePlayerResponse
-	25, // 48: pirates.v1.PiratesService.RespondToMatch:output_type -> pirates.v1.MatchResult
-	27, // 49: pirates.v1.PiratesService.PlaceShips:output_type -> pirates.v1.PlacementResult
-	29, // 50: pirates.v1.PiratesService.Attack:output_type -> pirates.v1.AttackResult
-	31, // 51: pirates.v1.PiratesService.UsePower:output_type -> pirates.v1.PowerResult
-	17, // 52: pirates.v1.PiratesService.Forfeit:output_type -> pirates.v1.ForfeitResponse
-	34, // 53: pirates.v1.PiratesService.SubscribeEvents:output_type -> pirates.v1.GameEvent
-	43, // [43:54] is the sub-list for method output_type
-	32, // [32:43] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	12,  // 0: pirates.v1.Ship.start:type_name -> pirates.v1.Coordinate
+	0,   // 1: pirates.v1.Power.type:type_name -> pirates.v1.PowerType
+	3,   // 2: pirates.v1.Player.status:type_name -> pirates.v1.PlayerStatus
+	4,   // 3: pirates.v1.GameRules.rule_set:type_name -> pirates.v1.RuleSet
+	5,   // 4: pirates.v1.GameRules.bonus_turn:type_name -> pirates.v1.BonusTurn
+	12,  // 5: pirates.v1.Terrain.islands:type_name -> pirates.v1.Coordinate
+	12,  // 6: pirates.v1.Terrain.your_mines:type_name -> pirates.v1.Coordinate
+	15,  // 7: pirates.v1.ConnectResponse.player:type_name -> pirates.v1.Player
+	16,  // 8: pirates.v1.ChallengePlayerRequest.rules:type_name -> pirates.v1.GameRules
+	16,  // 9: pirates.v1.CreatePrivateLobbyRequest.rules:type_name -> pirates.v1.GameRules
+	16,  // 10: pirates.v1.PrivateLobby.rules:type_name -> pirates.v1.GameRules
+	9,   // 11: pirates.v1.CreateTournamentRequest.format:type_name -> pirates.v1.TournamentFormat
+	16,  // 12: pirates.v1.CreateTournamentRequest.rules:type_name -> pirates.v1.GameRules
+	103, // 13: pirates.v1.ListTournamentsResponse.tournaments:type_name -> pirates.v1.Tournament
+	12,  // 14: pirates.v1.PuzzleAttackRequest.target:type_name -> pirates.v1.Coordinate
+	0,   // 15: pirates.v1.PuzzleUsePowerRequest.power:type_name -> pirates.v1.PowerType
+	12,  // 16: pirates.v1.PuzzleUsePowerRequest.target:type_name -> pirates.v1.Coordinate
+	1,   // 17: pirates.v1.PuzzleUsePowerRequest.orientation:type_name -> pirates.v1.Orientation
+	11,  // 18: pirates.v1.GetLeaderboardRequest.scope:type_name -> pirates.v1.LeaderboardScope
+	4,   // 19: pirates.v1.GetLeaderboardRequest.rule_set:type_name -> pirates.v1.RuleSet
+	16,  // 20: pirates.v1.ChallengeFriendRequest.rules:type_name -> pirates.v1.GameRules
+	13,  // 21: pirates.v1.PlaceShipsRequest.ships:type_name -> pirates.v1.Ship
+	12,  // 22: pirates.v1.AttackRequest.target:type_name -> pirates.v1.Coordinate
+	12,  // 23: pirates.v1.AttackSalvoRequest.targets:type_name -> pirates.v1.Coordinate
+	0,   // 24: pirates.v1.UsePowerRequest.power:type_name -> pirates.v1.PowerType
+	12,  // 25: pirates.v1.UsePowerRequest.target:type_name -> pirates.v1.Coordinate
+	1,   // 26: pirates.v1.UsePowerRequest.orientation:type_name -> pirates.v1.Orientation
+	0,   // 27: pirates.v1.PreviewPowerRequest.power:type_name -> pirates.v1.PowerType
+	12,  // 28: pirates.v1.PreviewPowerRequest.target:type_name -> pirates.v1.Coordinate
+	1,   // 29: pirates.v1.PreviewPowerRequest.orientation:type_name -> pirates.v1.Orientation
+	12,  // 30: pirates.v1.PowerPreview.cells:type_name -> pirates.v1.Coordinate
+	7,   // 31: pirates.v1.SendChatMessageRequest.scope:type_name -> pirates.v1.ChatScope
+	8,   // 32: pirates.v1.SendChatMessageRequest.emote:type_name -> pirates.v1.QuickEmote
+	98,  // 33: pirates.v1.SendChatMessageResponse.message:type_name -> pirates.v1.ChatMessage
+	73,  // 34: pirates.v1.QueueStatusUpdate.queues:type_name -> pirates.v1.QueueInfo
+	16,  // 35: pirates.v1.QueueInfo.rules:type_name -> pirates.v1.GameRules
+	15,  // 36: pirates.v1.PlayerListUpdate.available_players:type_name -> pirates.v1.Player
+	15,  // 37: pirates.v1.PlayerListUpdate.changed_players:type_name -> pirates.v1.Player
+	15,  // 38: pirates.v1.MatchProposal.opponent:type_name -> pirates.v1.Player
+	16,  // 39: pirates.v1.MatchProposal.rules:type_name -> pirates.v1.GameRules
+	15,  // 40: pirates.v1.MatchProposal.opponents:type_name -> pirates.v1.Player
+	15,  // 41: pirates.v1.MatchProposal.allies:type_name -> pirates.v1.Player
+	15,  // 42: pirates.v1.GameStarted.opponent:type_name -> pirates.v1.Player
+	16,  // 43: pirates.v1.GameStarted.rules:type_name -> pirates.v1.GameRules
+	17,  // 44: pirates.v1.GameStarted.terrain:type_name -> pirates.v1.Terrain
+	15,  // 45: pirates.v1.GameStarted.opponents:type_name -> pirates.v1.Player
+	15,  // 46: pirates.v1.GameStarted.allies:type_name -> pirates.v1.Player
+	93,  // 47: pirates.v1.GameStarted.series:type_name -> pirates.v1.Series
+	14,  // 48: pirates.v1.TurnStarted.available_powers:type_name -> pirates.v1.Power
+	84,  // 49: pirates.v1.TurnStarted.view:type_name -> pirates.v1.GameView
+	12,  // 50: pirates.v1.AttackResult.target:type_name -> pirates.v1.Coordinate
+	13,  // 51: pirates.v1.AttackResult.sunk_ship:type_name -> pirates.v1.Ship
+	14,  // 52: pirates.v1.AttackResult.power_gained:type_name -> pirates.v1.Power
+	80,  // 53: pirates.v1.AttackResult.mine_blast:type_name -> pirates.v1.AttackResult
+	80,  // 54: pirates.v1.SalvoResult.shots:type_name -> pirates.v1.AttackResult
+	13,  // 55: pirates.v1.SalvoResult.sunk_ships:type_name -> pirates.v1.Ship
+	14,  // 56: pirates.v1.SalvoResult.powers_gained:type_name -> pirates.v1.Power
+	12,  // 57: pirates.v1.CellReveal.position:type_name -> pirates.v1.Coordinate
+	2,   // 58: pirates.v1.CellReveal.state:type_name -> pirates.v1.CellState
+	82,  // 59: pirates.v1.BoardView.cells:type_name -> pirates.v1.CellReveal
+	13,  // 60: pirates.v1.BoardView.ships:type_name -> pirates.v1.Ship
+	83,  // 61: pirates.v1.GameView.your_board:type_name -> pirates.v1.BoardView
+	83,  // 62: pirates.v1.GameView.opponent_board:type_name -> pirates.v1.BoardView
+	85,  // 63: pirates.v1.GameView.opponents:type_name -> pirates.v1.PlayerBoard
+	85,  // 64: pirates.v1.GameView.allies:type_name -> pirates.v1.PlayerBoard
+	83,  // 65: pirates.v1.PlayerBoard.board:type_name -> pirates.v1.BoardView
+	0,   // 66: pirates.v1.PowerResult.power_used:type_name -> pirates.v1.PowerType
+	82,  // 67: pirates.v1.PowerResult.cells_affected:type_name -> pirates.v1.CellReveal
+	13,  // 68: pirates.v1.PowerResult.sunk_ships:type_name -> pirates.v1.Ship
+	80,  // 69: pirates.v1.PowerResult.mine_blasts:type_name -> pirates.v1.AttackResult
+	14,  // 70: pirates.v1.PowerResult.powers_gained:type_name -> pirates.v1.Power
+	80,  // 71: pirates.v1.OpponentAction.attack:type_name -> pirates.v1.AttackResult
+	86,  // 72: pirates.v1.OpponentAction.power:type_name -> pirates.v1.PowerResult
+	81,  // 73: pirates.v1.OpponentAction.salvo:type_name -> pirates.v1.SalvoResult
+	82,  // 74: pirates.v1.OpponentAction.your_grid_updates:type_name -> pirates.v1.CellReveal
+	13,  // 75: pirates.v1.FleetShip.ship:type_name -> pirates.v1.Ship
+	0,   // 76: pirates.v1.PlayerGameStats.powers_used:type_name -> pirates.v1.PowerType
+	88,  // 77: pirates.v1.GameSummary.your_fleet:type_name -> pirates.v1.FleetShip
+	88,  // 78: pirates.v1.GameSummary.opponent_fleet:type_name -> pirates.v1.FleetShip
+	89,  // 79: pirates.v1.GameSummary.your_stats:type_name -> pirates.v1.PlayerGameStats
+	89,  // 80: pirates.v1.GameSummary.opponent_stats:type_name -> pirates.v1.PlayerGameStats
+	12,  // 81: pirates.v1.GameSummary.opponent_decoys:type_name -> pirates.v1.Coordinate
+	91,  // 82: pirates.v1.GameSummary.opponents:type_name -> pirates.v1.PlayerSummary
+	91,  // 83: pirates.v1.GameSummary.allies:type_name -> pirates.v1.PlayerSummary
+	88,  // 84: pirates.v1.PlayerSummary.fleet:type_name -> pirates.v1.FleetShip
+	89,  // 85: pirates.v1.PlayerSummary.stats:type_name -> pirates.v1.PlayerGameStats
+	12,  // 86: pirates.v1.PlayerSummary.decoys:type_name -> pirates.v1.Coordinate
+	6,   // 87: pirates.v1.GameOver.reason:type_name -> pirates.v1.GameOverReason
+	90,  // 88: pirates.v1.GameOver.summary:type_name -> pirates.v1.GameSummary
+	93,  // 89: pirates.v1.GameOver.series:type_name -> pirates.v1.Series
+	94,  // 90: pirates.v1.Series.scores:type_name -> pirates.v1.SeriesScore
+	6,   // 91: pirates.v1.PlayerEliminated.reason:type_name -> pirates.v1.GameOverReason
+	7,   // 92: pirates.v1.ChatMessage.scope:type_name -> pirates.v1.ChatScope
+	8,   // 93: pirates.v1.ChatMessage.emote:type_name -> pirates.v1.QuickEmote
+	72,  // 94: pirates.v1.GameEvent.queue_status:type_name -> pirates.v1.QueueStatusUpdate
+	74,  // 95: pirates.v1.GameEvent.player_list:type_name -> pirates.v1.PlayerListUpdate
+	75,  // 96: pirates.v1.GameEvent.match_proposal:type_name -> pirates.v1.MatchProposal
+	76,  // 97: pirates.v1.GameEvent.match_result:type_name -> pirates.v1.MatchResult
+	77,  // 98: pirates.v1.GameEvent.game_started:type_name -> pirates.v1.GameStarted
+	79,  // 99: pirates.v1.GameEvent.turn_started:type_name -> pirates.v1.TurnStarted
+	87,  // 100: pirates.v1.GameEvent.opponent_action:type_name -> pirates.v1.OpponentAction
+	92,  // 101: pirates.v1.GameEvent.game_over:type_name -> pirates.v1.GameOver
+	78,  // 102: pirates.v1.GameEvent.placement_update:type_name -> pirates.v1.PlacementResult
+	95,  // 103: pirates.v1.GameEvent.opponent_disconnected:type_name -> pirates.v1.OpponentDisconnected
+	96,  // 104: pirates.v1.GameEvent.opponent_reconnected:type_name -> pirates.v1.OpponentReconnected
+	98,  // 105: pirates.v1.GameEvent.chat_message:type_name -> pirates.v1.ChatMessage
+	97,  // 106: pirates.v1.GameEvent.player_eliminated:type_name -> pirates.v1.PlayerEliminated
+	101, // 107: pirates.v1.GameEvent.party_invite:type_name -> pirates.v1.PartyInvite
+	100, // 108: pirates.v1.GameEvent.party_update:type_name -> pirates.v1.Party
+	102, // 109: pirates.v1.GameEvent.party_disbanded:type_name -> pirates.v1.PartyDisbanded
+	106, // 110: pirates.v1.GameEvent.tournament_update:type_name -> pirates.v1.TournamentBracket
+	115, // 111: pirates.v1.GameEvent.achievement_unlocked:type_name -> pirates.v1.Achievement
+	117, // 112: pirates.v1.GameEvent.friend_request:type_name -> pirates.v1.FriendRequest
+	116, // 113: pirates.v1.GameEvent.friend_list:type_name -> pirates.v1.FriendList
+	15,  // 114: pirates.v1.Party.members:type_name -> pirates.v1.Player
+	100, // 115: pirates.v1.PartyInvite.party:type_name -> pirates.v1.Party
+	15,  // 116: pirates.v1.PartyInvite.inviter:type_name -> pirates.v1.Player
+	9,   // 117: pirates.v1.Tournament.format:type_name -> pirates.v1.TournamentFormat
+	16,  // 118: pirates.v1.Tournament.rules:type_name -> pirates.v1.GameRules
+	10,  // 119: pirates.v1.Tournament.status:type_name -> pirates.v1.TournamentStatus
+	103, // 120: pirates.v1.TournamentBracket.tournament:type_name -> pirates.v1.Tournament
+	104, // 121: pirates.v1.TournamentBracket.matches:type_name -> pirates.v1.TournamentMatch
+	105, // 122: pirates.v1.TournamentBracket.standings:type_name -> pirates.v1.TournamentStanding
+	14,  // 123: pirates.v1.DailyPuzzle.available_powers:type_name -> pirates.v1.Power
+	83,  // 124: pirates.v1.DailyPuzzle.board:type_name -> pirates.v1.BoardView
+	80,  // 125: pirates.v1.PuzzleShotResult.attack:type_name -> pirates.v1.AttackResult
+	86,  // 126: pirates.v1.PuzzleShotResult.power:type_name -> pirates.v1.PowerResult
+	107, // 127: pirates.v1.PuzzleShotResult.puzzle:type_name -> pirates.v1.DailyPuzzle
+	110, // 128: pirates.v1.PuzzleLeaderboard.scores:type_name -> pirates.v1.PuzzleScore
+	0,   // 129: pirates.v1.PlayerProfile.favorite_power:type_name -> pirates.v1.PowerType
+	112, // 130: pirates.v1.PlayerProfile.rating_history:type_name -> pirates.v1.RatingChange
+	115, // 131: pirates.v1.PlayerProfile.achievements:type_name -> pirates.v1.Achievement
+	11,  // 132: pirates.v1.Leaderboard.scope:type_name -> pirates.v1.LeaderboardScope
+	4,   // 133: pirates.v1.Leaderboard.rule_set:type_name -> pirates.v1.RuleSet
+	114, // 134: pirates.v1.Leaderboard.entries:type_name -> pirates.v1.LeaderboardEntry
+	15,  // 135: pirates.v1.FriendList.friends:type_name -> pirates.v1.Player
+	15,  // 136: pirates.v1.FriendList.incoming_requests:type_name -> pirates.v1.Player
+	15,  // 137: pirates.v1.FriendList.outgoing_requests:type_name -> pirates.v1.Player
+	15,  // 138: pirates.v1.FriendRequest.from:type_name -> pirates.v1.Player
+	18,  // 139: pirates.v1.PiratesService.Connect:input_type -> pirates.v1.ConnectRequest
+	20,  // 140: pirates.v1.PiratesService.JoinQueue:input_type -> pirates.v1.JoinQueueRequest
+	21,  // 141: pirates.v1.PiratesService.LeaveQueue:input_type -> pirates.v1.LeaveQueueRequest
+	23,  // 142: pirates.v1.PiratesService.GetQueueStatus:input_type -> pirates.v1.GetQueueStatusRequest
+	24,  // 143: pirates.v1.PiratesService.ListPlayers:input_type -> pirates.v1.ListPlayersRequest
+	25,  // 144: pirates.v1.PiratesService.ChallengePlayer:input_type -> pirates.v1.ChallengePlayerRequest
+	27,  // 145: pirates.v1.PiratesService.RespondToMatch:input_type -> pirates.v1.RespondToMatchRequest
+	28,  // 146: pirates.v1.PiratesService.CreatePrivateLobby:input_type -> pirates.v1.CreatePrivateLobbyRequest
+	30,  // 147: pirates.v1.PiratesService.JoinPrivateLobby:input_type -> pirates.v1.JoinPrivateLobbyRequest
+	32,  // 148: pirates.v1.PiratesService.ClosePrivateLobby:input_type -> pirates.v1.ClosePrivateLobbyRequest
+	34,  // 149: pirates.v1.PiratesService.InviteToParty:input_type -> pirates.v1.InviteToPartyRequest
+	35,  // 150: pirates.v1.PiratesService.RespondToPartyInvite:input_type -> pirates.v1.RespondToPartyInviteRequest
+	36,  // 151: pirates.v1.PiratesService.LeaveParty:input_type -> pirates.v1.LeavePartyRequest
+	38,  // 152: pirates.v1.PiratesService.CreateTournament:input_type -> pirates.v1.CreateTournamentRequest
+	39,  // 153: pirates.v1.PiratesService.ListTournaments:input_type -> pirates.v1.ListTournamentsRequest
+	41,  // 154: pirates.v1.PiratesService.RegisterForTournament:input_type -> pirates.v1.RegisterForTournamentRequest
+	42,  // 155: pirates.v1.PiratesService.WithdrawFromTournament:input_type -> pirates.v1.WithdrawFromTournamentRequest
+	43,  // 156: pirates.v1.PiratesService.StartTournament:input_type -> pirates.v1.StartTournamentRequest
+	44,  // 157: pirates.v1.PiratesService.GetTournament:input_type -> pirates.v1.GetTournamentRequest
+	45,  // 158: pirates.v1.PiratesService.StartDailyPuzzle:input_type -> pirates.v1.StartDailyPuzzleRequest
+	46,  // 159: pirates.v1.PiratesService.PuzzleAttack:input_type -> pirates.v1.PuzzleAttackRequest
+	47,  // 160: pirates.v1.PiratesService.PuzzleUsePower:input_type -> pirates.v1.PuzzleUsePowerRequest
+	48,  // 161: pirates.v1.PiratesService.GetPuzzleLeaderboard:input_type -> pirates.v1.GetPuzzleLeaderboardRequest
+	49,  // 162: pirates.v1.PiratesService.GetPlayerProfile:input_type -> pirates.v1.GetPlayerProfileRequest
+	50,  // 163: pirates.v1.PiratesService.GetLeaderboard:input_type -> pirates.v1.GetLeaderboardRequest
+	51,  // 164: pirates.v1.PiratesService.SendFriendRequest:input_type -> pirates.v1.SendFriendRequestRequest
+	52,  // 165: pirates.v1.PiratesService.RespondToFriendRequest:input_type -> pirates.v1.RespondToFriendRequestRequest
+	53,  // 166: pirates.v1.PiratesService.RemoveFriend:input_type -> pirates.v1.RemoveFriendRequest
+	54,  // 167: pirates.v1.PiratesService.BlockPlayer:input_type -> pirates.v1.BlockPlayerRequest
+	55,  // 168: pirates.v1.PiratesService.UnblockPlayer:input_type -> pirates.v1.UnblockPlayerRequest
+	56,  // 169: pirates.v1.PiratesService.GetFriends:input_type -> pirates.v1.GetFriendsRequest
+	57,  // 170: pirates.v1.PiratesService.ChallengeFriend:input_type -> pirates.v1.ChallengeFriendRequest
+	61,  // 171: pirates.v1.PiratesService.PlaceShips:input_type -> pirates.v1.PlaceShipsRequest
+	62,  // 172: pirates.v1.PiratesService.Attack:input_type -> pirates.v1.AttackRequest
+	63,  // 173: pirates.v1.PiratesService.AttackSalvo:input_type -> pirates.v1.AttackSalvoRequest
+	64,  // 174: pirates.v1.PiratesService.UsePower:input_type -> pirates.v1.UsePowerRequest
+	65,  // 175: pirates.v1.PiratesService.PreviewPower:input_type -> pirates.v1.PreviewPowerRequest
+	59,  // 176: pirates.v1.PiratesService.Forfeit:input_type -> pirates.v1.ForfeitRequest
+	67,  // 177: pirates.v1.PiratesService.SendChatMessage:input_type -> pirates.v1.SendChatMessageRequest
+	69,  // 178: pirates.v1.PiratesService.MutePlayer:input_type -> pirates.v1.MutePlayerRequest
+	71,  // 179: pirates.v1.PiratesService.SubscribeEvents:input_type -> pirates.v1.SubscribeEventsRequest
+	19,  // 180: pirates.v1.PiratesService.Connect:output_type -> pirates.v1.ConnectResponse
+	72,  // 181: pirates.v1.PiratesService.JoinQueue:output_type -> pirates.v1.QueueStatusUpdate
+	22,  // 182: pirates.v1.PiratesService.LeaveQueue:output_type -> pirates.v1.LeaveQueueResponse
+	72,  // 183: pirates.v1.PiratesService.GetQueueStatus:output_type -> pirates.v1.QueueStatusUpdate
+	74,  // 184: pirates.v1.PiratesService.ListPlayers:output_type -> pirates.v1.PlayerListUpdate
+	26,  // 185: pirates.v1.PiratesService.ChallengePlayer:output_type -> pirates.v1.ChallengePlayerResponse
+	76,  // 186: pirates.v1.PiratesService.RespondToMatch:output_type -> pirates.v1.MatchResult
+	29,  // 187: pirates.v1.PiratesService.CreatePrivateLobby:output_type -> pirates.v1.PrivateLobby
+	31,  // 188: pirates.v1.PiratesService.JoinPrivateLobby:output_type -> pirates.v1.JoinPrivateLobbyResponse
+	33,  // 189: pirates.v1.PiratesService.ClosePrivateLobby:output_type -> pirates.v1.ClosePrivateLobbyResponse
+	100, // 190: pirates.v1.PiratesService.InviteToParty:output_type -> pirates.v1.Party
+	100, // 191: pirates.v1.PiratesService.RespondToPartyInvite:output_type -> pirates.v1.Party
+	37,  // 192: pirates.v1.PiratesService.LeaveParty:output_type -> pirates.v1.LeavePartyResponse
+	103, // 193: pirates.v1.PiratesService.CreateTournament:output_type -> pirates.v1.Tournament
+	40,  // 194: pirates.v1.PiratesService.ListTournaments:output_type -> pirates.v1.ListTournamentsResponse
+	103, // 195: pirates.v1.PiratesService.RegisterForTournament:output_type -> pirates.v1.Tournament
+	103, // 196: pirates.v1.PiratesService.WithdrawFromTournament:output_type -> pirates.v1.Tournament
+	106, // 197: pirates.v1.PiratesService.StartTournament:output_type -> pirates.v1.TournamentBracket
+	106, // 198: pirates.v1.PiratesService.GetTournament:output_type -> pirates.v1.TournamentBracket
+	107, // 199: pirates.v1.PiratesService.StartDailyPuzzle:output_type -> pirates.v1.DailyPuzzle
+	108, // 200: pirates.v1.PiratesService.PuzzleAttack:output_type -> pirates.v1.PuzzleShotResult
+	108, // 201: pirates.v1.PiratesService.PuzzleUsePower:output_type -> pirates.v1.PuzzleShotResult
+	109, // 202: pirates.v1.PiratesService.GetPuzzleLeaderboard:output_type -> pirates.v1.PuzzleLeaderboard
+	111, // 203: pirates.v1.PiratesService.GetPlayerProfile:output_type -> pirates.v1.PlayerProfile
+	113, // 204: pirates.v1.PiratesService.GetLeaderboard:output_type -> pirates.v1.Leaderboard
+	116, // 205: pirates.v1.PiratesService.SendFriendRequest:output_type -> pirates.v1.FriendList
+	116, // 206: pirates.v1.PiratesService.RespondToFriendRequest:output_type -> pirates.v1.FriendList
+	116, // 207: pirates.v1.PiratesService.RemoveFriend:output_type -> pirates.v1.FriendList
+	116, // 208: pirates.v1.PiratesService.BlockPlayer:output_type -> pirates.v1.FriendList
+	116, // 209: pirates.v1.PiratesService.UnblockPlayer:output_type -> pirates.v1.FriendList
+	116, // 210: pirates.v1.PiratesService.GetFriends:output_type -> pirates.v1.FriendList
+	58,  // 211: pirates.v1.PiratesService.ChallengeFriend:output_type -> pirates.v1.ChallengeFriendResponse
+	78,  // 212: pirates.v1.PiratesService.PlaceShips:output_type -> pirates.v1.PlacementResult
+	80,  // 213: pirates.v1.PiratesService.Attack:output_type -> pirates.v1.AttackResult
+	81,  // 214: pirates.v1.PiratesService.AttackSalvo:output_type -> pirates.v1.SalvoResult
+	86,  // 215: pirates.v1.PiratesService.UsePower:output_type -> pirates.v1.PowerResult
+	66,  // 216: pirates.v1.PiratesService.PreviewPower:output_type -> pirates.v1.PowerPreview
+	60,  // 217: pirates.v1.PiratesService.Forfeit:output_type -> pirates.v1.ForfeitResponse
+	68,  // 218: pirates.v1.PiratesService.SendChatMessage:output_type -> pirates.v1.SendChatMessageResponse
+	70,  // 219: pirates.v1.PiratesService.MutePlayer:output_type -> pirates.v1.MutePlayerResponse
+	99,  // 220: pirates.v1.PiratesService.SubscribeEvents:output_type -> pirates.v1.GameEvent
+	180, // [180:221] is the sub-list for method output_type
+	139, // [139:180] is the sub-list for method input_type
+	139, // [139:139] is the sub-list for extension type_name
+	139, // [139:139] is the sub-list for extension extendee
+	0,   // [0:139] is the sub-list for field type_name
 }
 
 func init() { file_pirates_v1_pirates_proto_init() }
@@ -2347,11 +8812,12 @@ func file_pirates_v1_pirates_proto_init() {
 	if File_pirates_v1_pirates_proto != nil {
 		return
 	}
-	file_pirates_v1_pirates_proto_msgTypes[29].OneofWrappers = []any{
+	file_pirates_v1_pirates_proto_msgTypes[75].OneofWrappers = []any{
 		(*OpponentAction_Attack)(nil),
 		(*OpponentAction_Power)(nil),
+		(*OpponentAction_Salvo)(nil),
 	}
-	file_pirates_v1_pirates_proto_msgTypes[31].OneofWrappers = []any{
+	file_pirates_v1_pirates_proto_msgTypes[87].OneofWrappers = []any{
 		(*GameEvent_QueueStatus)(nil),
 		(*GameEvent_PlayerList)(nil),
 		(*GameEvent_MatchProposal)(nil),
@@ -2361,14 +8827,29 @@ func file_pirates_v1_pirates_proto_init() {
 		(*GameEvent_OpponentAction)(nil),
 		(*GameEvent_GameOver)(nil),
 		(*GameEvent_PlacementUpdate)(nil),
+		(*GameEvent_OpponentDisconnected)(nil),
+		(*GameEvent_OpponentReconnected)(nil),
+		(*GameEvent_ChatMessage)(nil),
+		(*GameEvent_PlayerEliminated)(nil),
+		(*GameEvent_PartyInvite)(nil),
+		(*GameEvent_PartyUpdate)(nil),
+		(*GameEvent_PartyDisbanded)(nil),
+		(*GameEvent_TournamentUpdate)(nil),
+		(*GameEvent_AchievementUnlocked)(nil),
+		(*GameEvent_FriendRequest)(nil),
+		(*GameEvent_FriendList)(nil),
+	}
+	file_pirates_v1_pirates_proto_msgTypes[96].OneofWrappers = []any{
+		(*PuzzleShotResult_Attack)(nil),
+		(*PuzzleShotResult_Power)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pirates_v1_pirates_proto_rawDesc), len(file_pirates_v1_pirates_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   32,
+			NumEnums:      12,
+			NumMessages:   106,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -41,6 +41,9 @@ const (
 	// PiratesServiceLeaveQueueProcedure is the fully-qualified name of the PiratesService's LeaveQueue
 	// RPC.
 	PiratesServiceLeaveQueueProcedure = "/pirates.v1.PiratesService/LeaveQueue"
+	// PiratesServiceGetQueueStatusProcedure is the fully-qualified name of the PiratesService's
+	// GetQueueStatus RPC.
+	PiratesServiceGetQueueStatusProcedure = "/pirates.v1.PiratesService/GetQueueStatus"
 	// PiratesServiceListPlayersProcedure is the fully-qualified name of the PiratesService's
 	// ListPlayers RPC.
 	PiratesServiceListPlayersProcedure = "/pirates.v1.PiratesService/ListPlayers"
@@ -50,15 +53,102 @@ const (
 	// PiratesServiceRespondToMatchProcedure is the fully-qualified name of the PiratesService's
 	// RespondToMatch RPC.
 	PiratesServiceRespondToMatchProcedure = "/pirates.v1.PiratesService/RespondToMatch"
+	// PiratesServiceCreatePrivateLobbyProcedure is the fully-qualified name of the PiratesService's
+	// CreatePrivateLobby RPC.
+	PiratesServiceCreatePrivateLobbyProcedure = "/pirates.v1.PiratesService/CreatePrivateLobby"
+	// PiratesServiceJoinPrivateLobbyProcedure is the fully-qualified name of the PiratesService's
+	// JoinPrivateLobby RPC.
+	PiratesServiceJoinPrivateLobbyProcedure = "/pirates.v1.PiratesService/JoinPrivateLobby"
+	// PiratesServiceClosePrivateLobbyProcedure is the fully-qualified name of the PiratesService's
+	// ClosePrivateLobby RPC.
+	PiratesServiceClosePrivateLobbyProcedure = "/pirates.v1.PiratesService/ClosePrivateLobby"
+	// PiratesServiceInviteToPartyProcedure is the fully-qualified name of the PiratesService's
+	// InviteToParty RPC.
+	PiratesServiceInviteToPartyProcedure = "/pirates.v1.PiratesService/InviteToParty"
+	// PiratesServiceRespondToPartyInviteProcedure is the fully-qualified name of the PiratesService's
+	// RespondToPartyInvite RPC.
+	PiratesServiceRespondToPartyInviteProcedure = "/pirates.v1.PiratesService/RespondToPartyInvite"
+	// PiratesServiceLeavePartyProcedure is the fully-qualified name of the PiratesService's LeaveParty
+	// RPC.
+	PiratesServiceLeavePartyProcedure = "/pirates.v1.PiratesService/LeaveParty"
+	// PiratesServiceCreateTournamentProcedure is the fully-qualified name of the PiratesService's
+	// CreateTournament RPC.
+	PiratesServiceCreateTournamentProcedure = "/pirates.v1.PiratesService/CreateTournament"
+	// PiratesServiceListTournamentsProcedure is the fully-qualified name of the PiratesService's
+	// ListTournaments RPC.
+	PiratesServiceListTournamentsProcedure = "/pirates.v1.PiratesService/ListTournaments"
+	// PiratesServiceRegisterForTournamentProcedure is the fully-qualified name of the PiratesService's
+	// RegisterForTournament RPC.
+	PiratesServiceRegisterForTournamentProcedure = "/pirates.v1.PiratesService/RegisterForTournament"
+	// PiratesServiceWithdrawFromTournamentProcedure is the fully-qualified name of the PiratesService's
+	// WithdrawFromTournament RPC.
+	PiratesServiceWithdrawFromTournamentProcedure = "/pirates.v1.PiratesService/WithdrawFromTournament"
+	// PiratesServiceStartTournamentProcedure is the fully-qualified name of the PiratesService's
+	// StartTournament RPC.
+	PiratesServiceStartTournamentProcedure = "/pirates.v1.PiratesService/StartTournament"
+	// PiratesServiceGetTournamentProcedure is the fully-qualified name of the PiratesService's
+	// GetTournament RPC.
+	PiratesServiceGetTournamentProcedure = "/pirates.v1.PiratesService/GetTournament"
+	// PiratesServiceStartDailyPuzzleProcedure is the fully-qualified name of the PiratesService's
+	// StartDailyPuzzle RPC.
+	PiratesServiceStartDailyPuzzleProcedure = "/pirates.v1.PiratesService/StartDailyPuzzle"
+	// PiratesServicePuzzleAttackProcedure is the fully-qualified name of the PiratesService's
+	// PuzzleAttack RPC.
+	PiratesServicePuzzleAttackProcedure = "/pirates.v1.PiratesService/PuzzleAttack"
+	// PiratesServicePuzzleUsePowerProcedure is the fully-qualified name of the PiratesService's
+	// PuzzleUsePower RPC.
+	PiratesServicePuzzleUsePowerProcedure = "/pirates.v1.PiratesService/PuzzleUsePower"
+	// PiratesServiceGetPuzzleLeaderboardProcedure is the fully-qualified name of the PiratesService's
+	// GetPuzzleLeaderboard RPC.
+	PiratesServiceGetPuzzleLeaderboardProcedure = "/pirates.v1.PiratesService/GetPuzzleLeaderboard"
+	// PiratesServiceGetPlayerProfileProcedure is the fully-qualified name of the PiratesService's
+	// GetPlayerProfile RPC.
+	PiratesServiceGetPlayerProfileProcedure = "/pirates.v1.PiratesService/GetPlayerProfile"
+	// PiratesServiceGetLeaderboardProcedure is the fully-qualified name of the PiratesService's
+	// GetLeaderboard RPC.
+	PiratesServiceGetLeaderboardProcedure = "/pirates.v1.PiratesService/GetLeaderboard"
+	// PiratesServiceSendFriendRequestProcedure is the fully-qualified name of the PiratesService's
+	// SendFriendRequest RPC.
+	PiratesServiceSendFriendRequestProcedure = "/pirates.v1.PiratesService/SendFriendRequest"
+	// PiratesServiceRespondToFriendRequestProcedure is the fully-qualified name of the PiratesService's
+	// RespondToFriendRequest RPC.
+	PiratesServiceRespondToFriendRequestProcedure = "/pirates.v1.PiratesService/RespondToFriendRequest"
+	// PiratesServiceRemoveFriendProcedure is the fully-qualified name of the PiratesService's
+	// RemoveFriend RPC.
+	PiratesServiceRemoveFriendProcedure = "/pirates.v1.PiratesService/RemoveFriend"
+	// PiratesServiceBlockPlayerProcedure is the fully-qualified name of the PiratesService's
+	// BlockPlayer RPC.
+	PiratesServiceBlockPlayerProcedure = "/pirates.v1.PiratesService/BlockPlayer"
+	// PiratesServiceUnblockPlayerProcedure is the fully-qualified name of the PiratesService's
+	// UnblockPlayer RPC.
+	PiratesServiceUnblockPlayerProcedure = "/pirates.v1.PiratesService/UnblockPlayer"
+	// PiratesServiceGetFriendsProcedure is the fully-qualified name of the PiratesService's GetFriends
+	// RPC.
+	PiratesServiceGetFriendsProcedure = "/pirates.v1.PiratesService/GetFriends"
+	// PiratesServiceChallengeFriendProcedure is the fully-qualified name of the PiratesService's
+	// ChallengeFriend RPC.
+	PiratesServiceChallengeFriendProcedure = "/pirates.v1.PiratesService/ChallengeFriend"
 	// PiratesServicePlaceShipsProcedure is the fully-qualified name of the PiratesService's PlaceShips
 	// RPC.
 	PiratesServicePlaceShipsProcedure = "/pirates.v1.PiratesService/PlaceShips"
 	// PiratesServiceAttackProcedure is the fully-qualified name of the PiratesService's Attack RPC.
 	PiratesServiceAttackProcedure = "/pirates.v1.PiratesService/Attack"
+	// PiratesServiceAttackSalvoProcedure is the fully-qualified name of the PiratesService's
+	// AttackSalvo RPC.
+	PiratesServiceAttackSalvoProcedure = "/pirates.v1.PiratesService/AttackSalvo"
 	// PiratesServiceUsePowerProcedure is the fully-qualified name of the PiratesService's UsePower RPC.
 	PiratesServiceUsePowerProcedure = "/pirates.v1.PiratesService/UsePower"
+	// PiratesServicePreviewPowerProcedure is the fully-qualified name of the PiratesService's
+	// PreviewPower RPC.
+	PiratesServicePreviewPowerProcedure = "/pirates.v1.PiratesService/PreviewPower"
 	// PiratesServiceForfeitProcedure is the fully-qualified name of the PiratesService's Forfeit RPC.
 	PiratesServiceForfeitProcedure = "/pirates.v1.PiratesService/Forfeit"
+	// PiratesServiceSendChatMessageProcedure is the fully-qualified name of the PiratesService's
+	// SendChatMessage RPC.
+	PiratesServiceSendChatMessageProcedure = "/pirates.v1.PiratesService/SendChatMessage"
+	// PiratesServiceMutePlayerProcedure is the fully-qualified name of the PiratesService's MutePlayer
+	// RPC.
+	PiratesServiceMutePlayerProcedure = "/pirates.v1.PiratesService/MutePlayer"
 	// PiratesServiceSubscribeEventsProcedure is the fully-qualified name of the PiratesService's
 	// SubscribeEvents RPC.
 	PiratesServiceSubscribeEventsProcedure = "/pirates.v1.PiratesService/SubscribeEvents"
@@ -71,14 +161,51 @@ type PiratesServiceClient interface {
 	// Matchmaking
 	JoinQueue(context.Context, *connect.Request[v1.JoinQueueRequest]) (*connect.Response[v1.QueueStatusUpdate], error)
 	LeaveQueue(context.Context, *connect.Request[v1.LeaveQueueRequest]) (*connect.Response[v1.LeaveQueueResponse], error)
+	GetQueueStatus(context.Context, *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.QueueStatusUpdate], error)
 	ListPlayers(context.Context, *connect.Request[v1.ListPlayersRequest]) (*connect.Response[v1.PlayerListUpdate], error)
 	ChallengePlayer(context.Context, *connect.Request[v1.ChallengePlayerRequest]) (*connect.Response[v1.ChallengePlayerResponse], error)
 	RespondToMatch(context.Context, *connect.Request[v1.RespondToMatchRequest]) (*connect.Response[v1.MatchResult], error)
+	// Private lobbies
+	CreatePrivateLobby(context.Context, *connect.Request[v1.CreatePrivateLobbyRequest]) (*connect.Response[v1.PrivateLobby], error)
+	JoinPrivateLobby(context.Context, *connect.Request[v1.JoinPrivateLobbyRequest]) (*connect.Response[v1.JoinPrivateLobbyResponse], error)
+	ClosePrivateLobby(context.Context, *connect.Request[v1.ClosePrivateLobbyRequest]) (*connect.Response[v1.ClosePrivateLobbyResponse], error)
+	// Parties
+	InviteToParty(context.Context, *connect.Request[v1.InviteToPartyRequest]) (*connect.Response[v1.Party], error)
+	RespondToPartyInvite(context.Context, *connect.Request[v1.RespondToPartyInviteRequest]) (*connect.Response[v1.Party], error)
+	LeaveParty(context.Context, *connect.Request[v1.LeavePartyRequest]) (*connect.Response[v1.LeavePartyResponse], error)
+	// Tournaments
+	CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.Tournament], error)
+	ListTournaments(context.Context, *connect.Request[v1.ListTournamentsRequest]) (*connect.Response[v1.ListTournamentsResponse], error)
+	RegisterForTournament(context.Context, *connect.Request[v1.RegisterForTournamentRequest]) (*connect.Response[v1.Tournament], error)
+	WithdrawFromTournament(context.Context, *connect.Request[v1.WithdrawFromTournamentRequest]) (*connect.Response[v1.Tournament], error)
+	StartTournament(context.Context, *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.TournamentBracket], error)
+	GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.TournamentBracket], error)
+	// Daily puzzle
+	StartDailyPuzzle(context.Context, *connect.Request[v1.StartDailyPuzzleRequest]) (*connect.Response[v1.DailyPuzzle], error)
+	PuzzleAttack(context.Context, *connect.Request[v1.PuzzleAttackRequest]) (*connect.Response[v1.PuzzleShotResult], error)
+	PuzzleUsePower(context.Context, *connect.Request[v1.PuzzleUsePowerRequest]) (*connect.Response[v1.PuzzleShotResult], error)
+	GetPuzzleLeaderboard(context.Context, *connect.Request[v1.GetPuzzleLeaderboardRequest]) (*connect.Response[v1.PuzzleLeaderboard], error)
+	// Statistics
+	GetPlayerProfile(context.Context, *connect.Request[v1.GetPlayerProfileRequest]) (*connect.Response[v1.PlayerProfile], error)
+	GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.Leaderboard], error)
+	// Friends
+	SendFriendRequest(context.Context, *connect.Request[v1.SendFriendRequestRequest]) (*connect.Response[v1.FriendList], error)
+	RespondToFriendRequest(context.Context, *connect.Request[v1.RespondToFriendRequestRequest]) (*connect.Response[v1.FriendList], error)
+	RemoveFriend(context.Context, *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.FriendList], error)
+	BlockPlayer(context.Context, *connect.Request[v1.BlockPlayerRequest]) (*connect.Response[v1.FriendList], error)
+	UnblockPlayer(context.Context, *connect.Request[v1.UnblockPlayerRequest]) (*connect.Response[v1.FriendList], error)
+	GetFriends(context.Context, *connect.Request[v1.GetFriendsRequest]) (*connect.Response[v1.FriendList], error)
+	ChallengeFriend(context.Context, *connect.Request[v1.ChallengeFriendRequest]) (*connect.Response[v1.ChallengeFriendResponse], error)
 	// Game actions
 	PlaceShips(context.Context, *connect.Request[v1.PlaceShipsRequest]) (*connect.Response[v1.PlacementResult], error)
 	Attack(context.Context, *connect.Request[v1.AttackRequest]) (*connect.Response[v1.AttackResult], error)
+	AttackSalvo(context.Context, *connect.Request[v1.AttackSalvoRequest]) (*connect.Response[v1.SalvoResult], error)
 	UsePower(context.Context, *connect.Request[v1.UsePowerRequest]) (*connect.Response[v1.PowerResult], error)
+	PreviewPower(context.Context, *connect.Request[v1.PreviewPowerRequest]) (*connect.Response[v1.PowerPreview], error)
 	Forfeit(context.Context, *connect.Request[v1.ForfeitRequest]) (*connect.Response[v1.ForfeitResponse], error)
+	// Chat
+	SendChatMessage(context.Context, *connect.Request[v1.SendChatMessageRequest]) (*connect.Response[v1.SendChatMessageResponse], error)
+	MutePlayer(context.Context, *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error)
 	// Server-streaming RPC for real-time events
 	SubscribeEvents(context.Context, *connect.Request[v1.SubscribeEventsRequest]) (*connect.ServerStreamForClient[v1.GameEvent], error)
 }
@@ -112,6 +239,12 @@ func NewPiratesServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(piratesServiceMethods.ByName("LeaveQueue")),
 			connect.WithClientOptions(opts...),
 		),
+		getQueueStatus: connect.NewClient[v1.GetQueueStatusRequest, v1.QueueStatusUpdate](
+			httpClient,
+			baseURL+PiratesServiceGetQueueStatusProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("GetQueueStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		listPlayers: connect.NewClient[v1.ListPlayersRequest, v1.PlayerListUpdate](
 			httpClient,
 			baseURL+PiratesServiceListPlayersProcedure,
@@ -130,6 +263,156 @@ func NewPiratesServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(piratesServiceMethods.ByName("RespondToMatch")),
 			connect.WithClientOptions(opts...),
 		),
+		createPrivateLobby: connect.NewClient[v1.CreatePrivateLobbyRequest, v1.PrivateLobby](
+			httpClient,
+			baseURL+PiratesServiceCreatePrivateLobbyProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("CreatePrivateLobby")),
+			connect.WithClientOptions(opts...),
+		),
+		joinPrivateLobby: connect.NewClient[v1.JoinPrivateLobbyRequest, v1.JoinPrivateLobbyResponse](
+			httpClient,
+			baseURL+PiratesServiceJoinPrivateLobbyProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("JoinPrivateLobby")),
+			connect.WithClientOptions(opts...),
+		),
+		closePrivateLobby: connect.NewClient[v1.ClosePrivateLobbyRequest, v1.ClosePrivateLobbyResponse](
+			httpClient,
+			baseURL+PiratesServiceClosePrivateLobbyProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("ClosePrivateLobby")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteToParty: connect.NewClient[v1.InviteToPartyRequest, v1.Party](
+			httpClient,
+			baseURL+PiratesServiceInviteToPartyProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("InviteToParty")),
+			connect.WithClientOptions(opts...),
+		),
+		respondToPartyInvite: connect.NewClient[v1.RespondToPartyInviteRequest, v1.Party](
+			httpClient,
+			baseURL+PiratesServiceRespondToPartyInviteProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("RespondToPartyInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveParty: connect.NewClient[v1.LeavePartyRequest, v1.LeavePartyResponse](
+			httpClient,
+			baseURL+PiratesServiceLeavePartyProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("LeaveParty")),
+			connect.WithClientOptions(opts...),
+		),
+		createTournament: connect.NewClient[v1.CreateTournamentRequest, v1.Tournament](
+			httpClient,
+			baseURL+PiratesServiceCreateTournamentProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("CreateTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		listTournaments: connect.NewClient[v1.ListTournamentsRequest, v1.ListTournamentsResponse](
+			httpClient,
+			baseURL+PiratesServiceListTournamentsProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("ListTournaments")),
+			connect.WithClientOptions(opts...),
+		),
+		registerForTournament: connect.NewClient[v1.RegisterForTournamentRequest, v1.Tournament](
+			httpClient,
+			baseURL+PiratesServiceRegisterForTournamentProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("RegisterForTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		withdrawFromTournament: connect.NewClient[v1.WithdrawFromTournamentRequest, v1.Tournament](
+			httpClient,
+			baseURL+PiratesServiceWithdrawFromTournamentProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("WithdrawFromTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		startTournament: connect.NewClient[v1.StartTournamentRequest, v1.TournamentBracket](
+			httpClient,
+			baseURL+PiratesServiceStartTournamentProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("StartTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		getTournament: connect.NewClient[v1.GetTournamentRequest, v1.TournamentBracket](
+			httpClient,
+			baseURL+PiratesServiceGetTournamentProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("GetTournament")),
+			connect.WithClientOptions(opts...),
+		),
+		startDailyPuzzle: connect.NewClient[v1.StartDailyPuzzleRequest, v1.DailyPuzzle](
+			httpClient,
+			baseURL+PiratesServiceStartDailyPuzzleProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("StartDailyPuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		puzzleAttack: connect.NewClient[v1.PuzzleAttackRequest, v1.PuzzleShotResult](
+			httpClient,
+			baseURL+PiratesServicePuzzleAttackProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("PuzzleAttack")),
+			connect.WithClientOptions(opts...),
+		),
+		puzzleUsePower: connect.NewClient[v1.PuzzleUsePowerRequest, v1.PuzzleShotResult](
+			httpClient,
+			baseURL+PiratesServicePuzzleUsePowerProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("PuzzleUsePower")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzleLeaderboard: connect.NewClient[v1.GetPuzzleLeaderboardRequest, v1.PuzzleLeaderboard](
+			httpClient,
+			baseURL+PiratesServiceGetPuzzleLeaderboardProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("GetPuzzleLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlayerProfile: connect.NewClient[v1.GetPlayerProfileRequest, v1.PlayerProfile](
+			httpClient,
+			baseURL+PiratesServiceGetPlayerProfileProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("GetPlayerProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[v1.GetLeaderboardRequest, v1.Leaderboard](
+			httpClient,
+			baseURL+PiratesServiceGetLeaderboardProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		sendFriendRequest: connect.NewClient[v1.SendFriendRequestRequest, v1.FriendList](
+			httpClient,
+			baseURL+PiratesServiceSendFriendRequestProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("SendFriendRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		respondToFriendRequest: connect.NewClient[v1.RespondToFriendRequestRequest, v1.FriendList](
+			httpClient,
+			baseURL+PiratesServiceRespondToFriendRequestProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("RespondToFriendRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		removeFriend: connect.NewClient[v1.RemoveFriendRequest, v1.FriendList](
+			httpClient,
+			baseURL+PiratesServiceRemoveFriendProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("RemoveFriend")),
+			connect.WithClientOptions(opts...),
+		),
+		blockPlayer: connect.NewClient[v1.BlockPlayerRequest, v1.FriendList](
+			httpClient,
+			baseURL+PiratesServiceBlockPlayerProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("BlockPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		unblockPlayer: connect.NewClient[v1.UnblockPlayerRequest, v1.FriendList](
+			httpClient,
+			baseURL+PiratesServiceUnblockPlayerProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("UnblockPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		getFriends: connect.NewClient[v1.GetFriendsRequest, v1.FriendList](
+			httpClient,
+			baseURL+PiratesServiceGetFriendsProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("GetFriends")),
+			connect.WithClientOptions(opts...),
+		),
+		challengeFriend: connect.NewClient[v1.ChallengeFriendRequest, v1.ChallengeFriendResponse](
+			httpClient,
+			baseURL+PiratesServiceChallengeFriendProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("ChallengeFriend")),
+			connect.WithClientOptions(opts...),
+		),
 		placeShips: connect.NewClient[v1.PlaceShipsRequest, v1.PlacementResult](
 			httpClient,
 			baseURL+PiratesServicePlaceShipsProcedure,
@@ -142,18 +425,42 @@ func NewPiratesServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(piratesServiceMethods.ByName("Attack")),
 			connect.WithClientOptions(opts...),
 		),
+		attackSalvo: connect.NewClient[v1.AttackSalvoRequest, v1.SalvoResult](
+			httpClient,
+			baseURL+PiratesServiceAttackSalvoProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("AttackSalvo")),
+			connect.WithClientOptions(opts...),
+		),
 		usePower: connect.NewClient[v1.UsePowerRequest, v1.PowerResult](
 			httpClient,
 			baseURL+PiratesServiceUsePowerProcedure,
 			connect.WithSchema(piratesServiceMethods.ByName("UsePower")),
 			connect.WithClientOptions(opts...),
 		),
+		previewPower: connect.NewClient[v1.PreviewPowerRequest, v1.PowerPreview](
+			httpClient,
+			baseURL+PiratesServicePreviewPowerProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("PreviewPower")),
+			connect.WithClientOptions(opts...),
+		),
 		forfeit: connect.NewClient[v1.ForfeitRequest, v1.ForfeitResponse](
 			httpClient,
 			baseURL+PiratesServiceForfeitProcedure,
 			connect.WithSchema(piratesServiceMethods.ByName("Forfeit")),
 			connect.WithClientOptions(opts...),
 		),
+		sendChatMessage: connect.NewClient[v1.SendChatMessageRequest, v1.SendChatMessageResponse](
+			httpClient,
+			baseURL+PiratesServiceSendChatMessageProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("SendChatMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		mutePlayer: connect.NewClient[v1.MutePlayerRequest, v1.MutePlayerResponse](
+			httpClient,
+			baseURL+PiratesServiceMutePlayerProcedure,
+			connect.WithSchema(piratesServiceMethods.ByName("MutePlayer")),
+			connect.WithClientOptions(opts...),
+		),
 		subscribeEvents: connect.NewClient[v1.SubscribeEventsRequest, v1.GameEvent](
 			httpClient,
 			baseURL+PiratesServiceSubscribeEventsProcedure,
@@ -165,17 +472,47 @@ func NewPiratesServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // piratesServiceClient implements PiratesServiceClient.
 type piratesServiceClient struct {
-	connect         *connect.Client[v1.ConnectRequest, v1.ConnectResponse]
-	joinQueue       *connect.Client[v1.JoinQueueRequest, v1.QueueStatusUpdate]
-	leaveQueue      *connect.Client[v1.LeaveQueueRequest, v1.LeaveQueueResponse]
-	listPlayers     *connect.Client[v1.ListPlayersRequest, v1.PlayerListUpdate]
-	challengePlayer *connect.Client[v1.ChallengePlayerRequest, v1.ChallengePlayerResponse]
-	respondToMatch  *connect.Client[v1.RespondToMatchRequest, v1.MatchResult]
-	placeShips      *connect.Client[v1.PlaceShipsRequest, v1.PlacementResult]
-	attack          *connect.Client[v1.AttackRequest, v1.AttackResult]
-	usePower        *connect.Client[v1.UsePowerRequest, v1.PowerResult]
-	forfeit         *connect.Client[v1.ForfeitRequest, v1.ForfeitResponse]
-	subscribeEvents *connect.Client[v1.SubscribeEventsRequest, v1.GameEvent]
+	connect                *connect.Client[v1.ConnectRequest, v1.ConnectResponse]
+	joinQueue              *connect.Client[v1.JoinQueueRequest, v1.QueueStatusUpdate]
+	leaveQueue             *connect.Client[v1.LeaveQueueRequest, v1.LeaveQueueResponse]
+	getQueueStatus         *connect.Client[v1.GetQueueStatusRequest, v1.QueueStatusUpdate]
+	listPlayers            *connect.Client[v1.ListPlayersRequest, v1.PlayerListUpdate]
+	challengePlayer        *connect.Client[v1.ChallengePlayerRequest, v1.ChallengePlayerResponse]
+	respondToMatch         *connect.Client[v1.RespondToMatchRequest, v1.MatchResult]
+	createPrivateLobby     *connect.Client[v1.CreatePrivateLobbyRequest, v1.PrivateLobby]
+	joinPrivateLobby       *connect.Client[v1.JoinPrivateLobbyRequest, v1.JoinPrivateLobbyResponse]
+	closePrivateLobby      *connect.Client[v1.ClosePrivateLobbyRequest, v1.ClosePrivateLobbyResponse]
+	inviteToParty          *connect.Client[v1.InviteToPartyRequest, v1.Party]
+	respondToPartyInvite   *connect.Client[v1.RespondToPartyInviteRequest, v1.Party]
+	leaveParty             *connect.Client[v1.LeavePartyRequest, v1.LeavePartyResponse]
+	createTournament       *connect.Client[v1.CreateTournamentRequest, v1.Tournament]
+	listTournaments        *connect.Client[v1.ListTournamentsRequest, v1.ListTournamentsResponse]
+	registerForTournament  *connect.Client[v1.RegisterForTournamentRequest, v1.Tournament]
+	withdrawFromTournament *connect.Client[v1.WithdrawFromTournamentRequest, v1.Tournament]
+	startTournament        *connect.Client[v1.StartTournamentRequest, v1.TournamentBracket]
+	getTournament          *connect.Client[v1.GetTournamentRequest, v1.TournamentBracket]
+	startDailyPuzzle       *connect.Client[v1.StartDailyPuzzleRequest, v1.DailyPuzzle]
+	puzzleAttack           *connect.Client[v1.PuzzleAttackRequest, v1.PuzzleShotResult]
+	puzzleUsePower         *connect.Client[v1.PuzzleUsePowerRequest, v1.PuzzleShotResult]
+	getPuzzleLeaderboard   *connect.Client[v1.GetPuzzleLeaderboardRequest, v1.PuzzleLeaderboard]
+	getPlayerProfile       *connect.Client[v1.GetPlayerProfileRequest, v1.PlayerProfile]
+	getLeaderboard         *connect.Client[v1.GetLeaderboardRequest, v1.Leaderboard]
+	sendFriendRequest      *connect.Client[v1.SendFriendRequestRequest, v1.FriendList]
+	respondToFriendRequest *connect.Client[v1.RespondToFriendRequestRequest, v1.FriendList]
+	removeFriend           *connect.Client[v1.RemoveFriendRequest, v1.FriendList]
+	blockPlayer            *connect.Client[v1.BlockPlayerRequest, v1.FriendList]
+	unblockPlayer          *connect.Client[v1.UnblockPlayerRequest, v1.FriendList]
+	getFriends             *connect.Client[v1.GetFriendsRequest, v1.FriendList]
+	challengeFriend        *connect.Client[v1.ChallengeFriendRequest, v1.ChallengeFriendResponse]
+	placeShips             *connect.Client[v1.PlaceShipsRequest, v1.PlacementResult]
+	attack                 *connect.Client[v1.AttackRequest, v1.AttackResult]
+	attackSalvo            *connect.Client[v1.AttackSalvoRequest, v1.SalvoResult]
+	usePower               *connect.Client[v1.UsePowerRequest, v1.PowerResult]
+	previewPower           *connect.Client[v1.PreviewPowerRequest, v1.PowerPreview]
+	forfeit                *connect.Client[v1.ForfeitRequest, v1.ForfeitResponse]
+	sendChatMessage        *connect.Client[v1.SendChatMessageRequest, v1.SendChatMessageResponse]
+	mutePlayer             *connect.Client[v1.MutePlayerRequest, v1.MutePlayerResponse]
+	subscribeEvents        *connect.Client[v1.SubscribeEventsRequest, v1.GameEvent]
 }
 
 // Connect calls pirates.v1.PiratesService.Connect.
@@ -193,6 +530,11 @@ func (c *piratesServiceClient) LeaveQueue(ctx context.Context, req *connect.Requ
 	return c.leaveQueue.CallUnary(ctx, req)
 }
 
+// GetQueueStatus calls pirates.v1.PiratesService.GetQueueStatus.
+func (c *piratesServiceClient) GetQueueStatus(ctx context.Context, req *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.QueueStatusUpdate], error) {
+	return c.getQueueStatus.CallUnary(ctx, req)
+}
+
 // ListPlayers calls pirates.v1.PiratesService.ListPlayers.
 func (c *piratesServiceClient) ListPlayers(ctx context.Context, req *connect.Request[v1.ListPlayersRequest]) (*connect.Response[v1.PlayerListUpdate], error) {
 	return c.listPlayers.CallUnary(ctx, req)
@@ -208,6 +550,131 @@ func (c *piratesServiceClient) RespondToMatch(ctx context.Context, req *connect.
 	return c.respondToMatch.CallUnary(ctx, req)
 }
 
+// CreatePrivateLobby calls pirates.v1.PiratesService.CreatePrivateLobby.
+func (c *piratesServiceClient) CreatePrivateLobby(ctx context.Context, req *connect.Request[v1.CreatePrivateLobbyRequest]) (*connect.Response[v1.PrivateLobby], error) {
+	return c.createPrivateLobby.CallUnary(ctx, req)
+}
+
+// JoinPrivateLobby calls pirates.v1.PiratesService.JoinPrivateLobby.
+func (c *piratesServiceClient) JoinPrivateLobby(ctx context.Context, req *connect.Request[v1.JoinPrivateLobbyRequest]) (*connect.Response[v1.JoinPrivateLobbyResponse], error) {
+	return c.joinPrivateLobby.CallUnary(ctx, req)
+}
+
+// ClosePrivateLobby calls pirates.v1.PiratesService.ClosePrivateLobby.
+func (c *piratesServiceClient) ClosePrivateLobby(ctx context.Context, req *connect.Request[v1.ClosePrivateLobbyRequest]) (*connect.Response[v1.ClosePrivateLobbyResponse], error) {
+	return c.closePrivateLobby.CallUnary(ctx, req)
+}
+
+// InviteToParty calls pirates.v1.PiratesService.InviteToParty.
+func (c *piratesServiceClient) InviteToParty(ctx context.Context, req *connect.Request[v1.InviteToPartyRequest]) (*connect.Response[v1.Party], error) {
+	return c.inviteToParty.CallUnary(ctx, req)
+}
+
+// RespondToPartyInvite calls pirates.v1.PiratesService.RespondToPartyInvite.
+func (c *piratesServiceClient) RespondToPartyInvite(ctx context.Context, req *connect.Request[v1.RespondToPartyInviteRequest]) (*connect.Response[v1.Party], error) {
+	return c.respondToPartyInvite.CallUnary(ctx, req)
+}
+
+// LeaveParty calls pirates.v1.PiratesService.LeaveParty.
+func (c *piratesServiceClient) LeaveParty(ctx context.Context, req *connect.Request[v1.LeavePartyRequest]) (*connect.Response[v1.LeavePartyResponse], error) {
+	return c.leaveParty.CallUnary(ctx, req)
+}
+
+// CreateTournament calls pirates.v1.PiratesService.CreateTournament.
+func (c *piratesServiceClient) CreateTournament(ctx context.Context, req *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.Tournament], error) {
+	return c.createTournament.CallUnary(ctx, req)
+}
+
+// ListTournaments calls pirates.v1.PiratesService.ListTournaments.
+func (c *piratesServiceClient) ListTournaments(ctx context.Context, req *connect.Request[v1.ListTournamentsRequest]) (*connect.Response[v1.ListTournamentsResponse], error) {
+	return c.listTournaments.CallUnary(ctx, req)
+}
+
+// RegisterForTournament calls pirates.v1.PiratesService.RegisterForTournament.
+func (c *piratesServiceClient) RegisterForTournament(ctx context.Context, req *connect.Request[v1.RegisterForTournamentRequest]) (*connect.Response[v1.Tournament], error) {
+	return c.registerForTournament.CallUnary(ctx, req)
+}
+
+// WithdrawFromTournament calls pirates.v1.PiratesService.WithdrawFromTournament.
+func (c *piratesServiceClient) WithdrawFromTournament(ctx context.Context, req *connect.Request[v1.WithdrawFromTournamentRequest]) (*connect.Response[v1.Tournament], error) {
+	return c.withdrawFromTournament.CallUnary(ctx, req)
+}
+
+// StartTournament calls pirates.v1.PiratesService.StartTournament.
+func (c *piratesServiceClient) StartTournament(ctx context.Context, req *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.TournamentBracket], error) {
+	return c.startTournament.CallUnary(ctx, req)
+}
+
+// GetTournament calls pirates.v1.PiratesService.GetTournament.
+func (c *piratesServiceClient) GetTournament(ctx context.Context, req *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.TournamentBracket], error) {
+	return c.getTournament.CallUnary(ctx, req)
+}
+
+// StartDailyPuzzle calls pirates.v1.PiratesService.StartDailyPuzzle.
+func (c *piratesServiceClient) StartDailyPuzzle(ctx context.Context, req *connect.Request[v1.StartDailyPuzzleRequest]) (*connect.Response[v1.DailyPuzzle], error) {
+	return c.startDailyPuzzle.CallUnary(ctx, req)
+}
+
+// PuzzleAttack calls pirates.v1.PiratesService.PuzzleAttack.
+func (c *piratesServiceClient) PuzzleAttack(ctx context.Context, req *connect.Request[v1.PuzzleAttackRequest]) (*connect.Response[v1.PuzzleShotResult], error) {
+	return c.puzzleAttack.CallUnary(ctx, req)
+}
+
+// PuzzleUsePower calls pirates.v1.PiratesService.PuzzleUsePower.
+func (c *piratesServiceClient) PuzzleUsePower(ctx context.Context, req *connect.Request[v1.PuzzleUsePowerRequest]) (*connect.Response[v1.PuzzleShotResult], error) {
+	return c.puzzleUsePower.CallUnary(ctx, req)
+}
+
+// GetPuzzleLeaderboard calls pirates.v1.PiratesService.GetPuzzleLeaderboard.
+func (c *piratesServiceClient) GetPuzzleLeaderboard(ctx context.Context, req *connect.Request[v1.GetPuzzleLeaderboardRequest]) (*connect.Response[v1.PuzzleLeaderboard], error) {
+	return c.getPuzzleLeaderboard.CallUnary(ctx, req)
+}
+
+// GetPlayerProfile calls pirates.v1.PiratesService.GetPlayerProfile.
+func (c *piratesServiceClient) GetPlayerProfile(ctx context.Context, req *connect.Request[v1.GetPlayerProfileRequest]) (*connect.Response[v1.PlayerProfile], error) {
+	return c.getPlayerProfile.CallUnary(ctx, req)
+}
+
+// GetLeaderboard calls pirates.v1.PiratesService.GetLeaderboard.
+func (c *piratesServiceClient) GetLeaderboard(ctx context.Context, req *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.Leaderboard], error) {
+	return c.getLeaderboard.CallUnary(ctx, req)
+}
+
+// SendFriendRequest calls pirates.v1.PiratesService.SendFriendRequest.
+func (c *piratesServiceClient) SendFriendRequest(ctx context.Context, req *connect.Request[v1.SendFriendRequestRequest]) (*connect.Response[v1.FriendList], error) {
+	return c.sendFriendRequest.CallUnary(ctx, req)
+}
+
+// RespondToFriendRequest calls pirates.v1.PiratesService.RespondToFriendRequest.
+func (c *piratesServiceClient) RespondToFriendRequest(ctx context.Context, req *connect.Request[v1.RespondToFriendRequestRequest]) (*connect.Response[v1.FriendList], error) {
+	return c.respondToFriendRequest.CallUnary(ctx, req)
+}
+
+// RemoveFriend calls pirates.v1.PiratesService.RemoveFriend.
+func (c *piratesServiceClient) RemoveFriend(ctx context.Context, req *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.FriendList], error) {
+	return c.removeFriend.CallUnary(ctx, req)
+}
+
+// BlockPlayer calls pirates.v1.PiratesService.BlockPlayer.
+func (c *piratesServiceClient) BlockPlayer(ctx context.Context, req *connect.Request[v1.BlockPlayerRequest]) (*connect.Response[v1.FriendList], error) {
+	return c.blockPlayer.CallUnary(ctx, req)
+}
+
+// UnblockPlayer calls pirates.v1.PiratesService.UnblockPlayer.
+func (c *piratesServiceClient) UnblockPlayer(ctx context.Context, req *connect.Request[v1.UnblockPlayerRequest]) (*connect.Response[v1.FriendList], error) {
+	return c.unblockPlayer.CallUnary(ctx, req)
+}
+
+// GetFriends calls pirates.v1.PiratesService.GetFriends.
+func (c *piratesServiceClient) GetFriends(ctx context.Context, req *connect.Request[v1.GetFriendsRequest]) (*connect.Response[v1.FriendList], error) {
+	return c.getFriends.CallUnary(ctx, req)
+}
+
+// ChallengeFriend calls pirates.v1.PiratesService.ChallengeFriend.
+func (c *piratesServiceClient) ChallengeFriend(ctx context.Context, req *connect.Request[v1.ChallengeFriendRequest]) (*connect.Response[v1.ChallengeFriendResponse], error) {
+	return c.challengeFriend.CallUnary(ctx, req)
+}
+
 // PlaceShips calls pirates.v1.PiratesService.PlaceShips.
 func (c *piratesServiceClient) PlaceShips(ctx context.Context, req *connect.Request[v1.PlaceShipsRequest]) (*connect.Response[v1.PlacementResult], error) {
 	return c.placeShips.CallUnary(ctx, req)
@@ -218,16 +685,36 @@ func (c *piratesServiceClient) Attack(ctx context.Context, req *connect.Request[
 	return c.attack.CallUnary(ctx, req)
 }
 
+// AttackSalvo calls pirates.v1.PiratesService.AttackSalvo.
+func (c *piratesServiceClient) AttackSalvo(ctx context.Context, req *connect.Request[v1.AttackSalvoRequest]) (*connect.Response[v1.SalvoResult], error) {
+	return c.attackSalvo.CallUnary(ctx, req)
+}
+
 // UsePower calls pirates.v1.PiratesService.UsePower.
 func (c *piratesServiceClient) UsePower(ctx context.Context, req *connect.Request[v1.UsePowerRequest]) (*connect.Response[v1.PowerResult], error) {
 	return c.usePower.CallUnary(ctx, req)
 }
 
+// PreviewPower calls pirates.v1.PiratesService.PreviewPower.
+func (c *piratesServiceClient) PreviewPower(ctx context.Context, req *connect.Request[v1.PreviewPowerRequest]) (*connect.Response[v1.PowerPreview], error) {
+	return c.previewPower.CallUnary(ctx, req)
+}
+
 // Forfeit calls pirates.v1.PiratesService.Forfeit.
 func (c *piratesServiceClient) Forfeit(ctx context.Context, req *connect.Request[v1.ForfeitRequest]) (*connect.Response[v1.ForfeitResponse], error) {
 	return c.forfeit.CallUnary(ctx, req)
 }
 
+// SendChatMessage calls pirates.v1.PiratesService.SendChatMessage.
+func (c *piratesServiceClient) SendChatMessage(ctx context.Context, req *connect.Request[v1.SendChatMessageRequest]) (*connect.Response[v1.SendChatMessageResponse], error) {
+	return c.sendChatMessage.CallUnary(ctx, req)
+}
+
+// MutePlayer calls pirates.v1.PiratesService.MutePlayer.
+func (c *piratesServiceClient) MutePlayer(ctx context.Context, req *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error) {
+	return c.mutePlayer.CallUnary(ctx, req)
+}
+
 // SubscribeEvents calls pirates.v1.PiratesService.SubscribeEvents.
 func (c *piratesServiceClient) SubscribeEvents(ctx context.Context, req *connect.Request[v1.SubscribeEventsRequest]) (*connect.ServerStreamForClient[v1.GameEvent], error) {
 	return c.subscribeEvents.CallServerStream(ctx, req)
@@ -240,14 +727,51 @@ type PiratesServiceHandler interface {
 	// Matchmaking
 	JoinQueue(context.Context, *connect.Request[v1.JoinQueueRequest]) (*connect.Response[v1.QueueStatusUpdate], error)
 	LeaveQueue(context.Context, *connect.Request[v1.LeaveQueueRequest]) (*connect.Response[v1.LeaveQueueResponse], error)
+	GetQueueStatus(context.Context, *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.QueueStatusUpdate], error)
 	ListPlayers(context.Context, *connect.Request[v1.ListPlayersRequest]) (*connect.Response[v1.PlayerListUpdate], error)
 	ChallengePlayer(context.Context, *connect.Request[v1.ChallengePlayerRequest]) (*connect.Response[v1.ChallengePlayerResponse], error)
 	RespondToMatch(context.Context, *connect.Request[v1.RespondToMatchRequest]) (*connect.Response[v1.MatchResult], error)
+	// Private lobbies
+	CreatePrivateLobby(context.Context, *connect.Request[v1.CreatePrivateLobbyRequest]) (*connect.Response[v1.PrivateLobby], error)
+	JoinPrivateLobby(context.Context, *connect.Request[v1.JoinPrivateLobbyRequest]) (*connect.Response[v1.JoinPrivateLobbyResponse], error)
+	ClosePrivateLobby(context.Context, *connect.Request[v1.ClosePrivateLobbyRequest]) (*connect.Response[v1.ClosePrivateLobbyResponse], error)
+	// Parties
+	InviteToParty(context.Context, *connect.Request[v1.InviteToPartyRequest]) (*connect.Response[v1.Party], error)
+	RespondToPartyInvite(context.Context, *connect.Request[v1.RespondToPartyInviteRequest]) (*connect.Response[v1.Party], error)
+	LeaveParty(context.Context, *connect.Request[v1.LeavePartyRequest]) (*connect.Response[v1.LeavePartyResponse], error)
+	// Tournaments
+	CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.Tournament], error)
+	ListTournaments(context.Context, *connect.Request[v1.ListTournamentsRequest]) (*connect.Response[v1.ListTournamentsResponse], error)
+	RegisterForTournament(context.Context, *connect.Request[v1.RegisterForTournamentRequest]) (*connect.Response[v1.Tournament], error)
+	WithdrawFromTournament(context.Context, *connect.Request[v1.WithdrawFromTournamentRequest]) (*connect.Response[v1.Tournament], error)
+	StartTournament(context.Context, *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.TournamentBracket], error)
+	GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.TournamentBracket], error)
+	// Daily puzzle
+	StartDailyPuzzle(context.Context, *connect.Request[v1.StartDailyPuzzleRequest]) (*connect.Response[v1.DailyPuzzle], error)
+	PuzzleAttack(context.Context, *connect.Request[v1.PuzzleAttackRequest]) (*connect.Response[v1.PuzzleShotResult], error)
+	PuzzleUsePower(context.Context, *connect.Request[v1.PuzzleUsePowerRequest]) (*connect.Response[v1.PuzzleShotResult], error)
+	GetPuzzleLeaderboard(context.Context, *connect.Request[v1.GetPuzzleLeaderboardRequest]) (*connect.Response[v1.PuzzleLeaderboard], error)
+	// Statistics
+	GetPlayerProfile(context.Context, *connect.Request[v1.GetPlayerProfileRequest]) (*connect.Response[v1.PlayerProfile], error)
+	GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.Leaderboard], error)
+	// Friends
+	SendFriendRequest(context.Context, *connect.Request[v1.SendFriendRequestRequest]) (*connect.Response[v1.FriendList], error)
+	RespondToFriendRequest(context.Context, *connect.Request[v1.RespondToFriendRequestRequest]) (*connect.Response[v1.FriendList], error)
+	RemoveFriend(context.Context, *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.FriendList], error)
+	BlockPlayer(context.Context, *connect.Request[v1.BlockPlayerRequest]) (*connect.Response[v1.FriendList], error)
+	UnblockPlayer(context.Context, *connect.Request[v1.UnblockPlayerRequest]) (*connect.Response[v1.FriendList], error)
+	GetFriends(context.Context, *connect.Request[v1.GetFriendsRequest]) (*connect.Response[v1.FriendList], error)
+	ChallengeFriend(context.Context, *connect.Request[v1.ChallengeFriendRequest]) (*connect.Response[v1.ChallengeFriendResponse], error)
 	// Game actions
 	PlaceShips(context.Context, *connect.Request[v1.PlaceShipsRequest]) (*connect.Response[v1.PlacementResult], error)
 	Attack(context.Context, *connect.Request[v1.AttackRequest]) (*connect.Response[v1.AttackResult], error)
+	AttackSalvo(context.Context, *connect.Request[v1.AttackSalvoRequest]) (*connect.Response[v1.SalvoResult], error)
 	UsePower(context.Context, *connect.Request[v1.UsePowerRequest]) (*connect.Response[v1.PowerResult], error)
+	PreviewPower(context.Context, *connect.Request[v1.PreviewPowerRequest]) (*connect.Response[v1.PowerPreview], error)
 	Forfeit(context.Context, *connect.Request[v1.ForfeitRequest]) (*connect.Response[v1.ForfeitResponse], error)
+	// Chat
+	SendChatMessage(context.Context, *connect.Request[v1.SendChatMessageRequest]) (*connect.Response[v1.SendChatMessageResponse], error)
+	MutePlayer(context.Context, *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error)
 	// Server-streaming RPC for real-time events
 	SubscribeEvents(context.Context, *connect.Request[v1.SubscribeEventsRequest], *connect.ServerStream[v1.GameEvent]) error
 }
@@ -277,6 +801,12 @@ func NewPiratesServiceHandler(svc PiratesServiceHandler, opts ...connect.Handler
 		connect.WithSchema(piratesServiceMethods.ByName("LeaveQueue")),
 		connect.WithHandlerOptions(opts...),
 	)
+	piratesServiceGetQueueStatusHandler := connect.NewUnaryHandler(
+		PiratesServiceGetQueueStatusProcedure,
+		svc.GetQueueStatus,
+		connect.WithSchema(piratesServiceMethods.ByName("GetQueueStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	piratesServiceListPlayersHandler := connect.NewUnaryHandler(
 		PiratesServiceListPlayersProcedure,
 		svc.ListPlayers,
@@ -295,6 +825,156 @@ func NewPiratesServiceHandler(svc PiratesServiceHandler, opts ...connect.Handler
 		connect.WithSchema(piratesServiceMethods.ByName("RespondToMatch")),
 		connect.WithHandlerOptions(opts...),
 	)
+	piratesServiceCreatePrivateLobbyHandler := connect.NewUnaryHandler(
+		PiratesServiceCreatePrivateLobbyProcedure,
+		svc.CreatePrivateLobby,
+		connect.WithSchema(piratesServiceMethods.ByName("CreatePrivateLobby")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceJoinPrivateLobbyHandler := connect.NewUnaryHandler(
+		PiratesServiceJoinPrivateLobbyProcedure,
+		svc.JoinPrivateLobby,
+		connect.WithSchema(piratesServiceMethods.ByName("JoinPrivateLobby")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceClosePrivateLobbyHandler := connect.NewUnaryHandler(
+		PiratesServiceClosePrivateLobbyProcedure,
+		svc.ClosePrivateLobby,
+		connect.WithSchema(piratesServiceMethods.ByName("ClosePrivateLobby")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceInviteToPartyHandler := connect.NewUnaryHandler(
+		PiratesServiceInviteToPartyProcedure,
+		svc.InviteToParty,
+		connect.WithSchema(piratesServiceMethods.ByName("InviteToParty")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceRespondToPartyInviteHandler := connect.NewUnaryHandler(
+		PiratesServiceRespondToPartyInviteProcedure,
+		svc.RespondToPartyInvite,
+		connect.WithSchema(piratesServiceMethods.ByName("RespondToPartyInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceLeavePartyHandler := connect.NewUnaryHandler(
+		PiratesServiceLeavePartyProcedure,
+		svc.LeaveParty,
+		connect.WithSchema(piratesServiceMethods.ByName("LeaveParty")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceCreateTournamentHandler := connect.NewUnaryHandler(
+		PiratesServiceCreateTournamentProcedure,
+		svc.CreateTournament,
+		connect.WithSchema(piratesServiceMethods.ByName("CreateTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceListTournamentsHandler := connect.NewUnaryHandler(
+		PiratesServiceListTournamentsProcedure,
+		svc.ListTournaments,
+		connect.WithSchema(piratesServiceMethods.ByName("ListTournaments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceRegisterForTournamentHandler := connect.NewUnaryHandler(
+		PiratesServiceRegisterForTournamentProcedure,
+		svc.RegisterForTournament,
+		connect.WithSchema(piratesServiceMethods.ByName("RegisterForTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceWithdrawFromTournamentHandler := connect.NewUnaryHandler(
+		PiratesServiceWithdrawFromTournamentProcedure,
+		svc.WithdrawFromTournament,
+		connect.WithSchema(piratesServiceMethods.ByName("WithdrawFromTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceStartTournamentHandler := connect.NewUnaryHandler(
+		PiratesServiceStartTournamentProcedure,
+		svc.StartTournament,
+		connect.WithSchema(piratesServiceMethods.ByName("StartTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceGetTournamentHandler := connect.NewUnaryHandler(
+		PiratesServiceGetTournamentProcedure,
+		svc.GetTournament,
+		connect.WithSchema(piratesServiceMethods.ByName("GetTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceStartDailyPuzzleHandler := connect.NewUnaryHandler(
+		PiratesServiceStartDailyPuzzleProcedure,
+		svc.StartDailyPuzzle,
+		connect.WithSchema(piratesServiceMethods.ByName("StartDailyPuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServicePuzzleAttackHandler := connect.NewUnaryHandler(
+		PiratesServicePuzzleAttackProcedure,
+		svc.PuzzleAttack,
+		connect.WithSchema(piratesServiceMethods.ByName("PuzzleAttack")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServicePuzzleUsePowerHandler := connect.NewUnaryHandler(
+		PiratesServicePuzzleUsePowerProcedure,
+		svc.PuzzleUsePower,
+		connect.WithSchema(piratesServiceMethods.ByName("PuzzleUsePower")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceGetPuzzleLeaderboardHandler := connect.NewUnaryHandler(
+		PiratesServiceGetPuzzleLeaderboardProcedure,
+		svc.GetPuzzleLeaderboard,
+		connect.WithSchema(piratesServiceMethods.ByName("GetPuzzleLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceGetPlayerProfileHandler := connect.NewUnaryHandler(
+		PiratesServiceGetPlayerProfileProcedure,
+		svc.GetPlayerProfile,
+		connect.WithSchema(piratesServiceMethods.ByName("GetPlayerProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceGetLeaderboardHandler := connect.NewUnaryHandler(
+		PiratesServiceGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(piratesServiceMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceSendFriendRequestHandler := connect.NewUnaryHandler(
+		PiratesServiceSendFriendRequestProcedure,
+		svc.SendFriendRequest,
+		connect.WithSchema(piratesServiceMethods.ByName("SendFriendRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceRespondToFriendRequestHandler := connect.NewUnaryHandler(
+		PiratesServiceRespondToFriendRequestProcedure,
+		svc.RespondToFriendRequest,
+		connect.WithSchema(piratesServiceMethods.ByName("RespondToFriendRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceRemoveFriendHandler := connect.NewUnaryHandler(
+		PiratesServiceRemoveFriendProcedure,
+		svc.RemoveFriend,
+		connect.WithSchema(piratesServiceMethods.ByName("RemoveFriend")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceBlockPlayerHandler := connect.NewUnaryHandler(
+		PiratesServiceBlockPlayerProcedure,
+		svc.BlockPlayer,
+		connect.WithSchema(piratesServiceMethods.ByName("BlockPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceUnblockPlayerHandler := connect.NewUnaryHandler(
+		PiratesServiceUnblockPlayerProcedure,
+		svc.UnblockPlayer,
+		connect.WithSchema(piratesServiceMethods.ByName("UnblockPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceGetFriendsHandler := connect.NewUnaryHandler(
+		PiratesServiceGetFriendsProcedure,
+		svc.GetFriends,
+		connect.WithSchema(piratesServiceMethods.ByName("GetFriends")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceChallengeFriendHandler := connect.NewUnaryHandler(
+		PiratesServiceChallengeFriendProcedure,
+		svc.ChallengeFriend,
+		connect.WithSchema(piratesServiceMethods.ByName("ChallengeFriend")),
+		connect.WithHandlerOptions(opts...),
+	)
 	piratesServicePlaceShipsHandler := connect.NewUnaryHandler(
 		PiratesServicePlaceShipsProcedure,
 		svc.PlaceShips,
@@ -307,18 +987,42 @@ func NewPiratesServiceHandler(svc PiratesServiceHandler, opts ...connect.Handler
 		connect.WithSchema(piratesServiceMethods.ByName("Attack")),
 		connect.WithHandlerOptions(opts...),
 	)
+	piratesServiceAttackSalvoHandler := connect.NewUnaryHandler(
+		PiratesServiceAttackSalvoProcedure,
+		svc.AttackSalvo,
+		connect.WithSchema(piratesServiceMethods.ByName("AttackSalvo")),
+		connect.WithHandlerOptions(opts...),
+	)
 	piratesServiceUsePowerHandler := connect.NewUnaryHandler(
 		PiratesServiceUsePowerProcedure,
 		svc.UsePower,
 		connect.WithSchema(piratesServiceMethods.ByName("UsePower")),
 		connect.WithHandlerOptions(opts...),
 	)
+	piratesServicePreviewPowerHandler := connect.NewUnaryHandler(
+		PiratesServicePreviewPowerProcedure,
+		svc.PreviewPower,
+		connect.WithSchema(piratesServiceMethods.ByName("PreviewPower")),
+		connect.WithHandlerOptions(opts...),
+	)
 	piratesServiceForfeitHandler := connect.NewUnaryHandler(
 		PiratesServiceForfeitProcedure,
 		svc.Forfeit,
 		connect.WithSchema(piratesServiceMethods.ByName("Forfeit")),
 		connect.WithHandlerOptions(opts...),
 	)
+	piratesServiceSendChatMessageHandler := connect.NewUnaryHandler(
+		PiratesServiceSendChatMessageProcedure,
+		svc.SendChatMessage,
+		connect.WithSchema(piratesServiceMethods.ByName("SendChatMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	piratesServiceMutePlayerHandler := connect.NewUnaryHandler(
+		PiratesServiceMutePlayerProcedure,
+		svc.MutePlayer,
+		connect.WithSchema(piratesServiceMethods.ByName("MutePlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
 	piratesServiceSubscribeEventsHandler := connect.NewServerStreamHandler(
 		PiratesServiceSubscribeEventsProcedure,
 		svc.SubscribeEvents,
@@ -333,20 +1037,80 @@ func NewPiratesServiceHandler(svc PiratesServiceHandler, opts ...connect.Handler
 			piratesServiceJoinQueueHandler.ServeHTTP(w, r)
 		case PiratesServiceLeaveQueueProcedure:
 			piratesServiceLeaveQueueHandler.ServeHTTP(w, r)
+		case PiratesServiceGetQueueStatusProcedure:
+			piratesServiceGetQueueStatusHandler.ServeHTTP(w, r)
 		case PiratesServiceListPlayersProcedure:
 			piratesServiceListPlayersHandler.ServeHTTP(w, r)
 		case PiratesServiceChallengePlayerProcedure:
 			piratesServiceChallengePlayerHandler.ServeHTTP(w, r)
 		case PiratesServiceRespondToMatchProcedure:
 			piratesServiceRespondToMatchHandler.ServeHTTP(w, r)
+		case PiratesServiceCreatePrivateLobbyProcedure:
+			piratesServiceCreatePrivateLobbyHandler.ServeHTTP(w, r)
+		case PiratesServiceJoinPrivateLobbyProcedure:
+			piratesServiceJoinPrivateLobbyHandler.ServeHTTP(w, r)
+		case PiratesServiceClosePrivateLobbyProcedure:
+			piratesServiceClosePrivateLobbyHandler.ServeHTTP(w, r)
+		case PiratesServiceInviteToPartyProcedure:
+			piratesServiceInviteToPartyHandler.ServeHTTP(w, r)
+		case PiratesServiceRespondToPartyInviteProcedure:
+			piratesServiceRespondToPartyInviteHandler.ServeHTTP(w, r)
+		case PiratesServiceLeavePartyProcedure:
+			piratesServiceLeavePartyHandler.ServeHTTP(w, r)
+		case PiratesServiceCreateTournamentProcedure:
+			piratesServiceCreateTournamentHandler.ServeHTTP(w, r)
+		case PiratesServiceListTournamentsProcedure:
+			piratesServiceListTournamentsHandler.ServeHTTP(w, r)
+		case PiratesServiceRegisterForTournamentProcedure:
+			piratesServiceRegisterForTournamentHandler.ServeHTTP(w, r)
+		case PiratesServiceWithdrawFromTournamentProcedure:
+			piratesServiceWithdrawFromTournamentHandler.ServeHTTP(w, r)
+		case PiratesServiceStartTournamentProcedure:
+			piratesServiceStartTournamentHandler.ServeHTTP(w, r)
+		case PiratesServiceGetTournamentProcedure:
+			piratesServiceGetTournamentHandler.ServeHTTP(w, r)
+		case PiratesServiceStartDailyPuzzleProcedure:
+			piratesServiceStartDailyPuzzleHandler.ServeHTTP(w, r)
+		case PiratesServicePuzzleAttackProcedure:
+			piratesServicePuzzleAttackHandler.ServeHTTP(w, r)
+		case PiratesServicePuzzleUsePowerProcedure:
+			piratesServicePuzzleUsePowerHandler.ServeHTTP(w, r)
+		case PiratesServiceGetPuzzleLeaderboardProcedure:
+			piratesServiceGetPuzzleLeaderboardHandler.ServeHTTP(w, r)
+		case PiratesServiceGetPlayerProfileProcedure:
+			piratesServiceGetPlayerProfileHandler.ServeHTTP(w, r)
+		case PiratesServiceGetLeaderboardProcedure:
+			piratesServiceGetLeaderboardHandler.ServeHTTP(w, r)
+		case PiratesServiceSendFriendRequestProcedure:
+			piratesServiceSendFriendRequestHandler.ServeHTTP(w, r)
+		case PiratesServiceRespondToFriendRequestProcedure:
+			piratesServiceRespondToFriendRequestHandler.ServeHTTP(w, r)
+		case PiratesServiceRemoveFriendProcedure:
+			piratesServiceRemoveFriendHandler.ServeHTTP(w, r)
+		case PiratesServiceBlockPlayerProcedure:
+			piratesServiceBlockPlayerHandler.ServeHTTP(w, r)
+		case PiratesServiceUnblockPlayerProcedure:
+			piratesServiceUnblockPlayerHandler.ServeHTTP(w, r)
+		case PiratesServiceGetFriendsProcedure:
+			piratesServiceGetFriendsHandler.ServeHTTP(w, r)
+		case PiratesServiceChallengeFriendProcedure:
+			piratesServiceChallengeFriendHandler.ServeHTTP(w, r)
 		case PiratesServicePlaceShipsProcedure:
 			piratesServicePlaceShipsHandler.ServeHTTP(w, r)
 		case PiratesServiceAttackProcedure:
 			piratesServiceAttackHandler.ServeHTTP(w, r)
+		case PiratesServiceAttackSalvoProcedure:
+			piratesServiceAttackSalvoHandler.ServeHTTP(w, r)
 		case PiratesServiceUsePowerProcedure:
 			piratesServiceUsePowerHandler.ServeHTTP(w, r)
+		case PiratesServicePreviewPowerProcedure:
+			piratesServicePreviewPowerHandler.ServeHTTP(w, r)
 		case PiratesServiceForfeitProcedure:
 			piratesServiceForfeitHandler.ServeHTTP(w, r)
+		case PiratesServiceSendChatMessageProcedure:
+			piratesServiceSendChatMessageHandler.ServeHTTP(w, r)
+		case PiratesServiceMutePlayerProcedure:
+			piratesServiceMutePlayerHandler.ServeHTTP(w, r)
 		case PiratesServiceSubscribeEventsProcedure:
 			piratesServiceSubscribeEventsHandler.ServeHTTP(w, r)
 		default:
@@ -370,6 +1134,10 @@ func (UnimplementedPiratesServiceHandler) LeaveQueue(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.LeaveQueue is not implemented"))
 }
 
+func (UnimplementedPiratesServiceHandler) GetQueueStatus(context.Context, *connect.Request[v1.GetQueueStatusRequest]) (*connect.Response[v1.QueueStatusUpdate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.GetQueueStatus is not implemented"))
+}
+
 func (UnimplementedPiratesServiceHandler) ListPlayers(context.Context, *connect.Request[v1.ListPlayersRequest]) (*connect.Response[v1.PlayerListUpdate], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.ListPlayers is not implemented"))
 }
@@ -382,6 +1150,106 @@ func (UnimplementedPiratesServiceHandler) RespondToMatch(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.RespondToMatch is not implemented"))
 }
 
+func (UnimplementedPiratesServiceHandler) CreatePrivateLobby(context.Context, *connect.Request[v1.CreatePrivateLobbyRequest]) (*connect.Response[v1.PrivateLobby], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.CreatePrivateLobby is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) JoinPrivateLobby(context.Context, *connect.Request[v1.JoinPrivateLobbyRequest]) (*connect.Response[v1.JoinPrivateLobbyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.JoinPrivateLobby is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) ClosePrivateLobby(context.Context, *connect.Request[v1.ClosePrivateLobbyRequest]) (*connect.Response[v1.ClosePrivateLobbyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.ClosePrivateLobby is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) InviteToParty(context.Context, *connect.Request[v1.InviteToPartyRequest]) (*connect.Response[v1.Party], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.InviteToParty is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) RespondToPartyInvite(context.Context, *connect.Request[v1.RespondToPartyInviteRequest]) (*connect.Response[v1.Party], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.RespondToPartyInvite is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) LeaveParty(context.Context, *connect.Request[v1.LeavePartyRequest]) (*connect.Response[v1.LeavePartyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.LeaveParty is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.CreateTournament is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) ListTournaments(context.Context, *connect.Request[v1.ListTournamentsRequest]) (*connect.Response[v1.ListTournamentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.ListTournaments is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) RegisterForTournament(context.Context, *connect.Request[v1.RegisterForTournamentRequest]) (*connect.Response[v1.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.RegisterForTournament is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) WithdrawFromTournament(context.Context, *connect.Request[v1.WithdrawFromTournamentRequest]) (*connect.Response[v1.Tournament], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.WithdrawFromTournament is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) StartTournament(context.Context, *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.TournamentBracket], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.StartTournament is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.TournamentBracket], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.GetTournament is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) StartDailyPuzzle(context.Context, *connect.Request[v1.StartDailyPuzzleRequest]) (*connect.Response[v1.DailyPuzzle], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.StartDailyPuzzle is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) PuzzleAttack(context.Context, *connect.Request[v1.PuzzleAttackRequest]) (*connect.Response[v1.PuzzleShotResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.PuzzleAttack is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) PuzzleUsePower(context.Context, *connect.Request[v1.PuzzleUsePowerRequest]) (*connect.Response[v1.PuzzleShotResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.PuzzleUsePower is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) GetPuzzleLeaderboard(context.Context, *connect.Request[v1.GetPuzzleLeaderboardRequest]) (*connect.Response[v1.PuzzleLeaderboard], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.GetPuzzleLeaderboard is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) GetPlayerProfile(context.Context, *connect.Request[v1.GetPlayerProfileRequest]) (*connect.Response[v1.PlayerProfile], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.GetPlayerProfile is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.Leaderboard], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.GetLeaderboard is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) SendFriendRequest(context.Context, *connect.Request[v1.SendFriendRequestRequest]) (*connect.Response[v1.FriendList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.SendFriendRequest is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) RespondToFriendRequest(context.Context, *connect.Request[v1.RespondToFriendRequestRequest]) (*connect.Response[v1.FriendList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.RespondToFriendRequest is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) RemoveFriend(context.Context, *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.FriendList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.RemoveFriend is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) BlockPlayer(context.Context, *connect.Request[v1.BlockPlayerRequest]) (*connect.Response[v1.FriendList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.BlockPlayer is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) UnblockPlayer(context.Context, *connect.Request[v1.UnblockPlayerRequest]) (*connect.Response[v1.FriendList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.UnblockPlayer is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) GetFriends(context.Context, *connect.Request[v1.GetFriendsRequest]) (*connect.Response[v1.FriendList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.GetFriends is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) ChallengeFriend(context.Context, *connect.Request[v1.ChallengeFriendRequest]) (*connect.Response[v1.ChallengeFriendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.ChallengeFriend is not implemented"))
+}
+
 func (UnimplementedPiratesServiceHandler) PlaceShips(context.Context, *connect.Request[v1.PlaceShipsRequest]) (*connect.Response[v1.PlacementResult], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.PlaceShips is not implemented"))
 }
@@ -390,14 +1258,30 @@ func (UnimplementedPiratesServiceHandler) Attack(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.Attack is not implemented"))
 }
 
+func (UnimplementedPiratesServiceHandler) AttackSalvo(context.Context, *connect.Request[v1.AttackSalvoRequest]) (*connect.Response[v1.SalvoResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.AttackSalvo is not implemented"))
+}
+
 func (UnimplementedPiratesServiceHandler) UsePower(context.Context, *connect.Request[v1.UsePowerRequest]) (*connect.Response[v1.PowerResult], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.UsePower is not implemented"))
 }
 
+func (UnimplementedPiratesServiceHandler) PreviewPower(context.Context, *connect.Request[v1.PreviewPowerRequest]) (*connect.Response[v1.PowerPreview], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.PreviewPower is not implemented"))
+}
+
 func (UnimplementedPiratesServiceHandler) Forfeit(context.Context, *connect.Request[v1.ForfeitRequest]) (*connect.Response[v1.ForfeitResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.Forfeit is not implemented"))
 }
 
+func (UnimplementedPiratesServiceHandler) SendChatMessage(context.Context, *connect.Request[v1.SendChatMessageRequest]) (*connect.Response[v1.SendChatMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.SendChatMessage is not implemented"))
+}
+
+func (UnimplementedPiratesServiceHandler) MutePlayer(context.Context, *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.MutePlayer is not implemented"))
+}
+
 func (UnimplementedPiratesServiceHandler) SubscribeEvents(context.Context, *connect.Request[v1.SubscribeEventsRequest], *connect.ServerStream[v1.GameEvent]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("pirates.v1.PiratesService.SubscribeEvents is not implemented"))
 }
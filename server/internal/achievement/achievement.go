@@ -0,0 +1,217 @@
+// Package achievement awards achievements for what players do in their
+// games. Achievements are declared as criteria on game events; each
+// matching event moves an achievement towards its goal.
+package achievement
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+type Trigger int
+
+const (
+	// OnPower is a power the player used.
+	OnPower Trigger = iota + 1
+	// OnWin is a game the player won.
+	OnWin
+)
+
+// Event is something a player did that may progress their achievements.
+type Event struct {
+	PlayerID string
+	Trigger  Trigger
+	// Power and SunkShips describe a power used: its type and the names of
+	// the ships it sank.
+	Power     piratesv1.PowerType
+	SunkShips []string
+	// ShipsLost counts the ships the player lost in a game won, and Reason
+	// is why that game ended.
+	ShipsLost int
+	Reason    piratesv1.GameOverReason
+}
+
+// Definition declares an achievement. An event progresses it when it has
+// its trigger and meets every criterion set.
+type Definition struct {
+	ID          string
+	Name        string
+	Description string
+	Trigger     Trigger
+	// Power is the power used.
+	Power piratesv1.PowerType
+	// SinkShip names a ship the event must sink.
+	SinkShip string
+	// MinSunk is the least number of ships the event must sink.
+	MinSunk int
+	// SankFleet requires a win by sinking every opposing ship, rather than
+	// by forfeit or disconnection.
+	SankFleet bool
+	// Flawless requires a win without losing a ship; MinShipsLost, a win
+	// after losing at least that many.
+	Flawless     bool
+	MinShipsLost int
+	// Goal is the number of matching events that unlocks the achievement,
+	// one when unset.
+	Goal int
+}
+
+// Definitions lists every achievement, in the order they are shown.
+var Definitions = []Definition{
+	{
+		ID:          "flawless-victory",
+		Name:        "Flawless Victory",
+		Description: "Win a game without losing a ship",
+		Trigger:     OnWin,
+		SankFleet:   true,
+		Flawless:    true,
+	},
+	{
+		ID:           "last-plank",
+		Name:         "Last Plank Standing",
+		Description:  "Win a game after losing four ships",
+		Trigger:      OnWin,
+		SankFleet:    true,
+		MinShipsLost: 4,
+	},
+	{
+		ID:          "galion-slayer",
+		Name:        "Galion Slayer",
+		Description: "Sink a Galion with Instakill",
+		Trigger:     OnPower,
+		Power:       piratesv1.PowerType_POWER_TYPE_INSTAKILL,
+		SinkShip:    "Galion",
+	},
+	{
+		ID:          "release-the-kraken",
+		Name:        "Release the Kraken",
+		Description: "Sink two ships at once with the Kraken",
+		Trigger:     OnPower,
+		Power:       piratesv1.PowerType_POWER_TYPE_KRAKEN,
+		MinSunk:     2,
+	},
+	{
+		ID:          "sea-wolf",
+		Name:        "Sea Wolf",
+		Description: "Win 10 games by sinking the enemy fleet",
+		Trigger:     OnWin,
+		SankFleet:   true,
+		Goal:        10,
+	},
+}
+
+func (d Definition) matches(e Event) bool {
+	switch {
+	case e.Trigger != d.Trigger:
+		return false
+	case d.Power != piratesv1.PowerType_POWER_TYPE_UNSPECIFIED && e.Power != d.Power:
+		return false
+	case d.MinSunk > len(e.SunkShips):
+		return false
+	case d.SankFleet && e.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK:
+		return false
+	case d.Flawless && e.ShipsLost > 0:
+		return false
+	case d.MinShipsLost > e.ShipsLost:
+		return false
+	}
+	return d.SinkShip == "" || slices.Contains(e.SunkShips, d.SinkShip)
+}
+
+func (d Definition) goal() int {
+	return max(d.Goal, 1)
+}
+
+type progress struct {
+	count      int
+	unlockedAt time.Time
+}
+
+// Tracker keeps every player's progress by player ID, in memory: it lasts
+// only as long as the process, and follows a player across sessions only
+// when they resume with the same ID. Each achievement unlocked is handed to
+// OnUnlock.
+type Tracker struct {
+	mu       sync.Mutex
+	progress map[string]map[string]*progress
+
+	OnUnlock func(playerID string, achievement *piratesv1.Achievement)
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		progress: make(map[string]map[string]*progress),
+	}
+}
+
+// Observe progresses the achievements e matches.
+func (t *Tracker) Observe(e Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, def := range Definitions {
+		if !def.matches(e) {
+			continue
+		}
+		p := t.progressLocked(e.PlayerID, def.ID)
+		if !p.unlockedAt.IsZero() {
+			continue
+		}
+		p.count++
+		if p.count < def.goal() {
+			continue
+		}
+		p.unlockedAt = time.Now()
+		if t.OnUnlock != nil {
+			go t.OnUnlock(e.PlayerID, def.toProto(p))
+		}
+	}
+}
+
+// Achievements returns every achievement with playerID's progress.
+func (t *Tracker) Achievements(playerID string) []*piratesv1.Achievement {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	achievements := make([]*piratesv1.Achievement, len(Definitions))
+	for i, def := range Definitions {
+		p := t.progress[playerID][def.ID]
+		if p == nil {
+			p = &progress{}
+		}
+		achievements[i] = def.toProto(p)
+	}
+	return achievements
+}
+
+func (t *Tracker) progressLocked(playerID, id string) *progress {
+	byID, exists := t.progress[playerID]
+	if !exists {
+		byID = make(map[string]*progress)
+		t.progress[playerID] = byID
+	}
+	p, exists := byID[id]
+	if !exists {
+		p = &progress{}
+		byID[id] = p
+	}
+	return p
+}
+
+func (d Definition) toProto(p *progress) *piratesv1.Achievement {
+	achievement := &piratesv1.Achievement{
+		Id:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		Progress:    int32(p.count),
+		Goal:        int32(d.goal()),
+	}
+	if !p.unlockedAt.IsZero() {
+		achievement.Unlocked = true
+		achievement.UnlockedAtUnixMs = p.unlockedAt.UnixMilli()
+	}
+	return achievement
+}
@@ -0,0 +1,94 @@
+package achievement
+
+import (
+	"testing"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func find(achievements []*piratesv1.Achievement, id string) *piratesv1.Achievement {
+	for _, a := range achievements {
+		if a.Id == id {
+			return a
+		}
+	}
+	return nil
+}
+
+func TestDefinition_Matches(t *testing.T) {
+	sunk := piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK
+	tests := []struct {
+		id    string
+		event Event
+		want  bool
+	}{
+		{"flawless-victory", Event{Trigger: OnWin, Reason: sunk}, true},
+		{"flawless-victory", Event{Trigger: OnWin, Reason: sunk, ShipsLost: 1}, false},
+		{"flawless-victory", Event{Trigger: OnWin, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT}, false},
+		{"last-plank", Event{Trigger: OnWin, Reason: sunk, ShipsLost: 4}, true},
+		{"last-plank", Event{Trigger: OnWin, Reason: sunk, ShipsLost: 3}, false},
+		{"last-plank", Event{Trigger: OnWin, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT, ShipsLost: 4}, false},
+		{"sea-wolf", Event{Trigger: OnWin, Reason: sunk}, true},
+		{"sea-wolf", Event{Trigger: OnWin, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT}, false},
+		{"galion-slayer", Event{Trigger: OnPower, Power: piratesv1.PowerType_POWER_TYPE_INSTAKILL, SunkShips: []string{"Galion"}}, true},
+		{"galion-slayer", Event{Trigger: OnPower, Power: piratesv1.PowerType_POWER_TYPE_INSTAKILL, SunkShips: []string{"Brick"}}, false},
+		{"galion-slayer", Event{Trigger: OnPower, Power: piratesv1.PowerType_POWER_TYPE_KRAKEN, SunkShips: []string{"Galion"}}, false},
+		{"release-the-kraken", Event{Trigger: OnPower, Power: piratesv1.PowerType_POWER_TYPE_KRAKEN, SunkShips: []string{"Brick", "Chaloupe"}}, true},
+		{"release-the-kraken", Event{Trigger: OnPower, Power: piratesv1.PowerType_POWER_TYPE_KRAKEN, SunkShips: []string{"Brick"}}, false},
+		{"release-the-kraken", Event{Trigger: OnWin, Power: piratesv1.PowerType_POWER_TYPE_KRAKEN, SunkShips: []string{"Brick", "Chaloupe"}}, false},
+	}
+	defs := make(map[string]Definition)
+	for _, def := range Definitions {
+		defs[def.ID] = def
+	}
+	for _, tt := range tests {
+		if got := defs[tt.id].matches(tt.event); got != tt.want {
+			t.Errorf("%s matches %+v = %v, want %v", tt.id, tt.event, got, tt.want)
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tr := NewTracker()
+	unlocked := make(chan *piratesv1.Achievement, 10)
+	tr.OnUnlock = func(playerID string, a *piratesv1.Achievement) {
+		if playerID == "p1" {
+			unlocked <- a
+		}
+	}
+
+	for range 10 {
+		tr.Observe(Event{PlayerID: "p1", Trigger: OnWin, ShipsLost: 2, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK})
+	}
+
+	select {
+	case a := <-unlocked:
+		if a.Id != "sea-wolf" || !a.Unlocked || a.Progress != 10 {
+			t.Errorf("unexpected unlock: %v", a)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Sea Wolf to unlock")
+	}
+
+	tr.Observe(Event{PlayerID: "p1", Trigger: OnWin, ShipsLost: 2, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK})
+	select {
+	case a := <-unlocked:
+		t.Errorf("expected no second unlock, got %v", a)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	achievements := tr.Achievements("p1")
+	if len(achievements) != len(Definitions) {
+		t.Fatalf("expected every achievement, got %d", len(achievements))
+	}
+	if a := find(achievements, "sea-wolf"); a.Progress != 10 || a.UnlockedAtUnixMs == 0 {
+		t.Errorf("unexpected Sea Wolf: %v", a)
+	}
+	if a := find(achievements, "flawless-victory"); a.Unlocked || a.Progress != 0 || a.Goal != 1 {
+		t.Errorf("unexpected Flawless Victory: %v", a)
+	}
+	if a := find(tr.Achievements("p2"), "sea-wolf"); a.Progress != 0 {
+		t.Errorf("expected no progress for p2, got %v", a)
+	}
+}
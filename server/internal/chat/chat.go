@@ -0,0 +1,144 @@
+package chat
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// MaxMessageLength is the maximum length of a chat message, in characters.
+const MaxMessageLength = 200
+
+var (
+	ErrEmptyMessage   = errors.New("message is empty")
+	ErrMessageTooLong = errors.New("message is too long")
+	ErrInvalidEmote   = errors.New("invalid emote")
+	ErrRateLimited    = errors.New("too many messages, slow down")
+)
+
+// Sanitize trims a message and strips control characters, then checks it
+// against the length limit.
+func Sanitize(text string) (string, error) {
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, text)
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		return "", ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(text) > MaxMessageLength {
+		return "", ErrMessageTooLong
+	}
+	return text, nil
+}
+
+// ValidateEmote reports whether emote is one of the preset quick emotes.
+func ValidateEmote(emote piratesv1.QuickEmote) error {
+	if emote == piratesv1.QuickEmote_QUICK_EMOTE_UNSPECIFIED {
+		return ErrInvalidEmote
+	}
+	if _, ok := piratesv1.QuickEmote_name[int32(emote)]; !ok {
+		return ErrInvalidEmote
+	}
+	return nil
+}
+
+// RateLimiter allows each player at most limit messages per sliding window.
+type RateLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	sent   map[string][]time.Time
+}
+
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		sent:   make(map[string][]time.Time),
+	}
+}
+
+// Allow records a message from playerID and reports whether it is within
+// the limit. Rejected messages do not count against the player.
+func (l *RateLimiter) Allow(playerID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	recent := l.sent[playerID][:0]
+	for _, t := range l.sent[playerID] {
+		if now.Sub(t) < l.window {
+			recent = append(recent, t)
+		}
+	}
+
+	if len(recent) >= l.limit {
+		l.sent[playerID] = recent
+		return false
+	}
+	l.sent[playerID] = append(recent, now)
+	return true
+}
+
+func (l *RateLimiter) Forget(playerID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.sent, playerID)
+}
+
+// Mutes tracks, for each player, the senders whose messages they do not want
+// to receive.
+type Mutes struct {
+	mu    sync.RWMutex
+	muted map[string]map[string]bool
+}
+
+func NewMutes() *Mutes {
+	return &Mutes{
+		muted: make(map[string]map[string]bool),
+	}
+}
+
+func (m *Mutes) SetMuted(playerID, targetID string, muted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !muted {
+		delete(m.muted[playerID], targetID)
+		return
+	}
+	if m.muted[playerID] == nil {
+		m.muted[playerID] = make(map[string]bool)
+	}
+	m.muted[playerID][targetID] = true
+}
+
+// IsMuted reports whether playerID muted senderID.
+func (m *Mutes) IsMuted(playerID, senderID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.muted[playerID][senderID]
+}
+
+// Forget drops every mute set by or against playerID.
+func (m *Mutes) Forget(playerID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.muted, playerID)
+	for _, targets := range m.muted {
+		delete(targets, playerID)
+	}
+}
@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func TestSanitize(t *testing.T) {
+	t.Run("trims and strips control characters", func(t *testing.T) {
+		text, err := Sanitize("  Ahoy\nmatey\t ")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if text != "Ahoy matey" {
+			t.Errorf("expected 'Ahoy matey', got %q", text)
+		}
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		if _, err := Sanitize(" \n "); err != ErrEmptyMessage {
+			t.Errorf("expected ErrEmptyMessage, got %v", err)
+		}
+	})
+
+	t.Run("length is counted in characters", func(t *testing.T) {
+		if _, err := Sanitize(strings.Repeat("☠", MaxMessageLength)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if _, err := Sanitize(strings.Repeat("a", MaxMessageLength+1)); err != ErrMessageTooLong {
+			t.Errorf("expected ErrMessageTooLong, got %v", err)
+		}
+	})
+}
+
+func TestValidateEmote(t *testing.T) {
+	if err := ValidateEmote(piratesv1.QuickEmote_QUICK_EMOTE_AHOY); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateEmote(piratesv1.QuickEmote_QUICK_EMOTE_UNSPECIFIED); err != ErrInvalidEmote {
+		t.Errorf("expected ErrInvalidEmote, got %v", err)
+	}
+	if err := ValidateEmote(piratesv1.QuickEmote(999)); err != ErrInvalidEmote {
+		t.Errorf("expected ErrInvalidEmote, got %v", err)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(2, 30*time.Millisecond)
+
+	if !l.Allow("player1") || !l.Allow("player1") {
+		t.Fatal("expected first messages to be allowed")
+	}
+	if l.Allow("player1") {
+		t.Error("expected third message to be rate limited")
+	}
+	if !l.Allow("player2") {
+		t.Error("expected limits to be per player")
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	if !l.Allow("player1") {
+		t.Error("expected message to be allowed after the window")
+	}
+}
+
+func TestMutes(t *testing.T) {
+	m := NewMutes()
+
+	m.SetMuted("player1", "player2", true)
+	if !m.IsMuted("player1", "player2") {
+		t.Error("expected player2 to be muted by player1")
+	}
+	if m.IsMuted("player2", "player1") {
+		t.Error("expected mutes to be one-way")
+	}
+
+	m.SetMuted("player1", "player2", false)
+	if m.IsMuted("player1", "player2") {
+		t.Error("expected player2 to be unmuted")
+	}
+
+	m.SetMuted("player1", "player2", true)
+	m.Forget("player2")
+	if m.IsMuted("player1", "player2") {
+		t.Error("expected mutes against a removed player to be dropped")
+	}
+}
@@ -0,0 +1,289 @@
+// Package friends keeps the players' friends, friend requests and block
+// lists, and the challenges waiting for a friend to finish their game.
+package friends
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+var (
+	ErrSelf             = errors.New("cannot befriend or block yourself")
+	ErrAlreadyFriends   = errors.New("already friends")
+	ErrAlreadyRequested = errors.New("friend request already sent")
+	ErrRequestNotFound  = errors.New("friend request not found")
+	ErrNotFriends       = errors.New("not friends")
+	// ErrBlocked hides from a player that someone blocked them.
+	ErrBlocked = errors.New("player unavailable")
+)
+
+// set is an insertion-ordered set of player IDs.
+type set []string
+
+func (s set) has(id string) bool { return slices.Contains(s, id) }
+
+func (s *set) add(id string) {
+	if !s.has(id) {
+		*s = append(*s, id)
+	}
+}
+
+func (s *set) remove(id string) {
+	*s = slices.DeleteFunc(*s, func(other string) bool { return other == id })
+}
+
+// Challenge is a friend's challenge waiting for the player's game to end.
+type Challenge struct {
+	ChallengerID string
+	Rules        game.Rules
+}
+
+type relations struct {
+	friends set
+	// incoming holds who asked to befriend the player; outgoing, whom they
+	// asked.
+	incoming set
+	outgoing set
+	blocked  set
+	// challenges holds the friends' challenges waiting for the player, in
+	// the order they were made.
+	challenges []Challenge
+}
+
+// Manager holds every player's relations. Friendship is mutual; blocking
+// is not, but either player blocking the other keeps them apart.
+type Manager struct {
+	mu      sync.RWMutex
+	players map[string]*relations
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		players: make(map[string]*relations),
+	}
+}
+
+// SendRequest asks toID to befriend fromID. If toID already asked fromID,
+// they become friends right away and accepted is true.
+func (m *Manager) SendRequest(fromID, toID string) (accepted bool, err error) {
+	if fromID == toID {
+		return false, ErrSelf
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	from, to := m.relationsLocked(fromID), m.relationsLocked(toID)
+	switch {
+	case from.blocked.has(toID) || to.blocked.has(fromID):
+		return false, ErrBlocked
+	case from.friends.has(toID):
+		return false, ErrAlreadyFriends
+	case from.outgoing.has(toID):
+		return false, ErrAlreadyRequested
+	case from.incoming.has(toID):
+		m.befriendLocked(fromID, toID)
+		return true, nil
+	}
+	from.outgoing.add(toID)
+	to.incoming.add(fromID)
+	return false, nil
+}
+
+// RespondToRequest answers the friend request fromID sent playerID.
+func (m *Manager) RespondToRequest(playerID, fromID string, accepted bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.relationsLocked(playerID).incoming.has(fromID) {
+		return ErrRequestNotFound
+	}
+	if accepted {
+		m.befriendLocked(playerID, fromID)
+	} else {
+		m.dropRequestsLocked(playerID, fromID)
+	}
+	return nil
+}
+
+// Remove ends the friendship of playerID and friendID, and drops the
+// challenges either queued for the other.
+func (m *Manager) Remove(playerID, friendID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.relationsLocked(playerID).friends.has(friendID) {
+		return ErrNotFriends
+	}
+	m.unfriendLocked(playerID, friendID)
+	return nil
+}
+
+// Block stops targetID from befriending or playing with playerID. It ends
+// their friendship and drops their pending requests and challenges.
+func (m *Manager) Block(playerID, targetID string) error {
+	if playerID == targetID {
+		return ErrSelf
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.unfriendLocked(playerID, targetID)
+	m.dropRequestsLocked(playerID, targetID)
+	m.relationsLocked(playerID).blocked.add(targetID)
+	return nil
+}
+
+func (m *Manager) Unblock(playerID, targetID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r, exists := m.players[playerID]; exists {
+		r.blocked.remove(targetID)
+	}
+}
+
+// IsBlocked reports whether either player blocked the other.
+func (m *Manager) IsBlocked(a, b string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.players[a] != nil && m.players[a].blocked.has(b) ||
+		m.players[b] != nil && m.players[b].blocked.has(a)
+}
+
+func (m *Manager) AreFriends(a, b string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.players[a] != nil && m.players[a].friends.has(b)
+}
+
+// List is a player's relations, each in the order they were made.
+type List struct {
+	FriendIDs   []string
+	IncomingIDs []string
+	OutgoingIDs []string
+	BlockedIDs  []string
+}
+
+func (m *Manager) List(playerID string) List {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	r, exists := m.players[playerID]
+	if !exists {
+		return List{}
+	}
+	return List{
+		FriendIDs:   slices.Clone(r.friends),
+		IncomingIDs: slices.Clone(r.incoming),
+		OutgoingIDs: slices.Clone(r.outgoing),
+		BlockedIDs:  slices.Clone(r.blocked),
+	}
+}
+
+// FriendIDs returns playerID's friends.
+func (m *Manager) FriendIDs(playerID string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if r, exists := m.players[playerID]; exists {
+		return slices.Clone(r.friends)
+	}
+	return nil
+}
+
+// QueueChallenge records that challengerID wants to challenge friendID
+// with rules once friendID's game is over. A new challenge replaces the
+// one challengerID queued before, keeping its place.
+func (m *Manager) QueueChallenge(challengerID, friendID string, rules game.Rules) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.relationsLocked(challengerID).friends.has(friendID) {
+		return ErrNotFriends
+	}
+	r := m.relationsLocked(friendID)
+	challenge := Challenge{ChallengerID: challengerID, Rules: rules}
+	if i := r.challengeIndex(challengerID); i >= 0 {
+		r.challenges[i] = challenge
+	} else {
+		r.challenges = append(r.challenges, challenge)
+	}
+	return nil
+}
+
+// NextChallenge takes the challenge that has waited the longest for
+// playerID off their queue.
+func (m *Manager) NextChallenge(playerID string) (Challenge, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, exists := m.players[playerID]
+	if !exists || len(r.challenges) == 0 {
+		return Challenge{}, false
+	}
+	challenge := r.challenges[0]
+	r.challenges = r.challenges[1:]
+	return challenge, true
+}
+
+// DropChallenges drops the challenges waiting for playerID and those they
+// queued, once they are gone. Their other relations are kept for when they
+// come back.
+func (m *Manager) DropChallenges(playerID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r, exists := m.players[playerID]; exists {
+		r.challenges = nil
+	}
+	for _, other := range m.players {
+		other.dropChallenge(playerID)
+	}
+}
+
+func (m *Manager) befriendLocked(a, b string) {
+	m.dropRequestsLocked(a, b)
+	m.relationsLocked(a).friends.add(b)
+	m.relationsLocked(b).friends.add(a)
+}
+
+func (m *Manager) unfriendLocked(a, b string) {
+	ra, rb := m.relationsLocked(a), m.relationsLocked(b)
+	ra.friends.remove(b)
+	rb.friends.remove(a)
+	ra.dropChallenge(b)
+	rb.dropChallenge(a)
+}
+
+// dropRequestsLocked drops the friend requests a and b sent each other.
+func (m *Manager) dropRequestsLocked(a, b string) {
+	ra, rb := m.relationsLocked(a), m.relationsLocked(b)
+	ra.incoming.remove(b)
+	ra.outgoing.remove(b)
+	rb.incoming.remove(a)
+	rb.outgoing.remove(a)
+}
+
+func (r *relations) challengeIndex(challengerID string) int {
+	return slices.IndexFunc(r.challenges, func(c Challenge) bool { return c.ChallengerID == challengerID })
+}
+
+func (r *relations) dropChallenge(challengerID string) {
+	r.challenges = slices.DeleteFunc(r.challenges, func(c Challenge) bool { return c.ChallengerID == challengerID })
+}
+
+func (m *Manager) relationsLocked(playerID string) *relations {
+	r, exists := m.players[playerID]
+	if !exists {
+		r = &relations{}
+		m.players[playerID] = r
+	}
+	return r
+}
@@ -0,0 +1,161 @@
+package friends
+
+import (
+	"slices"
+	"testing"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+func TestManager_Requests(t *testing.T) {
+	t.Run("accepting makes friends", func(t *testing.T) {
+		m := NewManager()
+		if accepted, err := m.SendRequest("p1", "p2"); err != nil || accepted {
+			t.Fatalf("SendRequest: accepted=%v, err=%v", accepted, err)
+		}
+		if _, err := m.SendRequest("p1", "p2"); err != ErrAlreadyRequested {
+			t.Errorf("expected ErrAlreadyRequested, got %v", err)
+		}
+		if list := m.List("p2"); !slices.Equal(list.IncomingIDs, []string{"p1"}) {
+			t.Errorf("expected a request from p1, got %v", list)
+		}
+
+		if err := m.RespondToRequest("p2", "p1", true); err != nil {
+			t.Fatalf("RespondToRequest failed: %v", err)
+		}
+		if !m.AreFriends("p1", "p2") || !m.AreFriends("p2", "p1") {
+			t.Error("expected p1 and p2 to be friends")
+		}
+		if list := m.List("p1"); len(list.OutgoingIDs) != 0 {
+			t.Errorf("expected the request answered, got %v", list)
+		}
+		if _, err := m.SendRequest("p2", "p1"); err != ErrAlreadyFriends {
+			t.Errorf("expected ErrAlreadyFriends, got %v", err)
+		}
+	})
+
+	t.Run("crossed requests make friends", func(t *testing.T) {
+		m := NewManager()
+		m.SendRequest("p1", "p2")
+		if accepted, err := m.SendRequest("p2", "p1"); err != nil || !accepted {
+			t.Fatalf("SendRequest: accepted=%v, err=%v", accepted, err)
+		}
+		if !m.AreFriends("p1", "p2") {
+			t.Error("expected p1 and p2 to be friends")
+		}
+	})
+
+	t.Run("declining drops the request", func(t *testing.T) {
+		m := NewManager()
+		m.SendRequest("p1", "p2")
+		if err := m.RespondToRequest("p2", "p1", false); err != nil {
+			t.Fatalf("RespondToRequest failed: %v", err)
+		}
+		if m.AreFriends("p1", "p2") {
+			t.Error("expected no friendship")
+		}
+		if err := m.RespondToRequest("p2", "p1", true); err != ErrRequestNotFound {
+			t.Errorf("expected ErrRequestNotFound, got %v", err)
+		}
+	})
+
+	t.Run("removing ends the friendship", func(t *testing.T) {
+		m := NewManager()
+		m.SendRequest("p1", "p2")
+		m.RespondToRequest("p2", "p1", true)
+		if err := m.Remove("p2", "p1"); err != nil {
+			t.Fatalf("Remove failed: %v", err)
+		}
+		if m.AreFriends("p1", "p2") {
+			t.Error("expected no friendship")
+		}
+		if err := m.Remove("p2", "p1"); err != ErrNotFriends {
+			t.Errorf("expected ErrNotFriends, got %v", err)
+		}
+	})
+
+	t.Run("cannot befriend yourself", func(t *testing.T) {
+		m := NewManager()
+		if _, err := m.SendRequest("p1", "p1"); err != ErrSelf {
+			t.Errorf("expected ErrSelf, got %v", err)
+		}
+	})
+}
+
+func TestManager_Block(t *testing.T) {
+	m := NewManager()
+	m.SendRequest("p1", "p2")
+	m.RespondToRequest("p2", "p1", true)
+	m.SendRequest("p3", "p1")
+
+	m.Block("p1", "p2")
+	m.Block("p1", "p3")
+	if m.AreFriends("p1", "p2") {
+		t.Error("expected blocking to end the friendship")
+	}
+	if !m.IsBlocked("p1", "p2") || !m.IsBlocked("p2", "p1") {
+		t.Error("expected the block to work both ways")
+	}
+	if list := m.List("p1"); len(list.IncomingIDs) != 0 || !slices.Equal(list.BlockedIDs, []string{"p2", "p3"}) {
+		t.Errorf("unexpected list: %v", list)
+	}
+	if _, err := m.SendRequest("p2", "p1"); err != ErrBlocked {
+		t.Errorf("expected ErrBlocked, got %v", err)
+	}
+
+	m.Unblock("p1", "p2")
+	if m.IsBlocked("p1", "p2") {
+		t.Error("expected p2 unblocked")
+	}
+	if _, err := m.SendRequest("p2", "p1"); err != nil {
+		t.Errorf("SendRequest failed: %v", err)
+	}
+}
+
+func TestManager_Challenges(t *testing.T) {
+	m := NewManager()
+	for _, id := range []string{"p2", "p3"} {
+		m.SendRequest(id, "p1")
+		m.RespondToRequest("p1", id, true)
+	}
+	if err := m.QueueChallenge("p4", "p1", game.DefaultRules()); err != ErrNotFriends {
+		t.Errorf("expected ErrNotFriends, got %v", err)
+	}
+
+	salvo := game.DefaultRules()
+	salvo.RuleSet = piratesv1.RuleSet_RULE_SET_SALVO
+	m.QueueChallenge("p3", "p1", game.DefaultRules())
+	m.QueueChallenge("p2", "p1", game.DefaultRules())
+	m.QueueChallenge("p3", "p1", salvo)
+	if c, ok := m.NextChallenge("p1"); !ok || c.ChallengerID != "p3" || c.Rules != salvo {
+		t.Errorf("expected p3's salvo challenge first, got %+v", c)
+	}
+
+	m.Remove("p1", "p2")
+	if c, ok := m.NextChallenge("p1"); ok {
+		t.Errorf("expected no challenge left, got %+v", c)
+	}
+}
+
+func TestManager_DropChallenges(t *testing.T) {
+	m := NewManager()
+	for _, id := range []string{"p2", "p3"} {
+		m.SendRequest("p1", id)
+		m.RespondToRequest(id, "p1", true)
+	}
+	m.Block("p4", "p1")
+	m.QueueChallenge("p1", "p2", game.DefaultRules())
+	m.QueueChallenge("p3", "p1", game.DefaultRules())
+
+	m.DropChallenges("p1")
+	if _, ok := m.NextChallenge("p2"); ok {
+		t.Error("expected the challenge p1 queued dropped")
+	}
+	if _, ok := m.NextChallenge("p1"); ok {
+		t.Error("expected the challenge waiting for p1 dropped")
+	}
+	if !slices.Equal(m.FriendIDs("p1"), []string{"p2", "p3"}) || !m.IsBlocked("p4", "p1") {
+		t.Error("expected the other relations of p1 kept")
+	}
+}
@@ -3,22 +3,38 @@ package game
 import (
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
 	"sync"
+	"time"
 
 	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
 )
 
 const GridSize = 10
 
+// MaxPlayers is the largest number of players a free-for-all game seats.
+const MaxPlayers = 4
+
 var (
-	ErrInvalidPlayer     = errors.New("invalid player")
-	ErrNotYourTurn       = errors.New("not your turn")
-	ErrGameNotInProgress = errors.New("game not in progress")
-	ErrInvalidTarget     = errors.New("invalid target coordinates")
-	ErrAlreadyHit        = errors.New("cell already hit")
-	ErrPowerNotAvailable = errors.New("power not available")
-	ErrInvalidPlacement  = errors.New("invalid ship placement")
+	ErrInvalidPlayer      = errors.New("invalid player")
+	ErrNotYourTurn        = errors.New("not your turn")
+	ErrGameNotInProgress  = errors.New("game not in progress")
+	ErrInvalidTarget      = errors.New("invalid target coordinates")
+	ErrAlreadyHit         = errors.New("cell already hit")
+	ErrPowerNotAvailable  = errors.New("power not available")
+	ErrInvalidPlacement   = errors.New("invalid ship placement")
 	ErrShipsAlreadyPlaced = errors.New("ships already placed")
+	ErrSalvoRequired      = errors.New("salvo games fire with a salvo")
+	ErrNotSalvoGame       = errors.New("not a salvo game")
+	ErrWrongSalvoSize     = errors.New("wrong number of salvo shots")
+	ErrShieldActive       = errors.New("a shield is already up")
+	ErrNothingToRepair    = errors.New("nothing to repair")
+	ErrDuplicateTarget    = errors.New("salvo targets the same cell twice")
+	ErrInvalidOrientation = errors.New("invalid orientation")
+	ErrInvalidOpponent    = errors.New("invalid opponent")
+	ErrPlayerEliminated   = errors.New("player eliminated")
 )
 
 type ShipDefinition struct {
@@ -39,6 +55,8 @@ type GameStatus int
 const (
 	StatusWaitingForShips GameStatus = iota
 	StatusPlayer1Turn
+	// StatusPlayer2Turn is also the status of the turns of every seat after
+	// the second in a free-for-all.
 	StatusPlayer2Turn
 	StatusFinished
 )
@@ -52,6 +70,20 @@ type Cell struct {
 	Hit      bool
 	Revealed bool
 	Sunk     bool
+	// Island and Mine are terrain. Ships cannot be placed on either.
+	Island bool
+	Mine   bool
+	// MineDamage marks a hit dealt by a mine, which the opponent never saw.
+	MineDamage bool
+	// Decoy is a fake one-cell ship. It answers shots and sonar like a ship
+	// but is not part of the fleet.
+	Decoy bool
+}
+
+// looksLikeShip reports whether the opponent sees a ship on the cell: a
+// real ship or a decoy.
+func (c *Cell) looksLikeShip() bool {
+	return c.ShipID != "" || c.Decoy
 }
 
 type Grid [GridSize][GridSize]*Cell
@@ -86,17 +118,64 @@ func (s *GameShip) ToProto() *piratesv1.Ship {
 	}
 }
 
+func (s *GameShip) toFleetProto() *piratesv1.FleetShip {
+	return &piratesv1.FleetShip{
+		Ship: s.ToProto(),
+		Hits: int32(s.Hits),
+		Sunk: s.IsSunk(),
+	}
+}
+
+// PlayerStats tracks what a player did during a game, for the end-of-game
+// summary. Shots count every opponent cell fired at, whether by a normal
+// attack or an offensive power.
+type PlayerStats struct {
+	ShotsFired int
+	Hits       int
+	ShipsSunk  int
+	PowersUsed []piratesv1.PowerType
+}
+
+func (s *PlayerStats) recordShot(hit bool) {
+	s.ShotsFired++
+	if hit {
+		s.Hits++
+	}
+}
+
+func (s *PlayerStats) ToProto() *piratesv1.PlayerGameStats {
+	var accuracy float32
+	if s.ShotsFired > 0 {
+		accuracy = float32(s.Hits) / float32(s.ShotsFired)
+	}
+	return &piratesv1.PlayerGameStats{
+		ShotsFired: int32(s.ShotsFired),
+		Hits:       int32(s.Hits),
+		Accuracy:   accuracy,
+		PowersUsed: append([]piratesv1.PowerType(nil), s.PowersUsed...),
+		ShipsSunk:  int32(s.ShipsSunk),
+	}
+}
+
 type PlayerState struct {
 	Grid       Grid
 	Ships      map[string]*GameShip
-	Powers     map[piratesv1.PowerType]bool
+	Powers     map[piratesv1.PowerType]int
 	ShipsReady bool
+	Stats      PlayerStats
+	// Shield is the center of the player's active shield, if any.
+	Shield *Coordinate
+	// Eliminated players lost their fleet or left. They watch the rest of
+	// the game as spectators.
+	Eliminated bool
+	// EliminationReason tells why an eliminated player is out.
+	EliminationReason piratesv1.GameOverReason
 }
 
 func NewPlayerState() *PlayerState {
 	ps := &PlayerState{
 		Ships:  make(map[string]*GameShip),
-		Powers: make(map[piratesv1.PowerType]bool),
+		Powers: make(map[piratesv1.PowerType]int),
 	}
 	for i := 0; i < GridSize; i++ {
 		for j := 0; j < GridSize; j++ {
@@ -115,10 +194,73 @@ func (ps *PlayerState) AllShipsSunk() bool {
 	return len(ps.Ships) > 0
 }
 
+// Fleet returns every ship of the player, fully revealed, ordered by ID.
+func (ps *PlayerState) Fleet() []*piratesv1.FleetShip {
+	ships := ps.sortedShips()
+	fleet := make([]*piratesv1.FleetShip, 0, len(ships))
+	for _, ship := range ships {
+		fleet = append(fleet, ship.toFleetProto())
+	}
+	return fleet
+}
+
+// summary describes the player's game once it is over.
+func (ps *PlayerState) summary(playerID string) *piratesv1.PlayerSummary {
+	return &piratesv1.PlayerSummary{
+		PlayerId: playerID,
+		Fleet:    ps.Fleet(),
+		Stats:    ps.Stats.ToProto(),
+		Decoys:   coordsToProto(ps.Grid.Decoys()),
+	}
+}
+
+// sortedShips returns the player's ships ordered by ID.
+func (ps *PlayerState) sortedShips() []*GameShip {
+	ships := make([]*GameShip, 0, len(ps.Ships))
+	for _, ship := range ps.Ships {
+		ships = append(ships, ship)
+	}
+	sort.Slice(ships, func(i, j int) bool { return ships[i].ID < ships[j].ID })
+	return ships
+}
+
+// SurvivingShips returns the number of ships that are not sunk yet.
+func (ps *PlayerState) SurvivingShips() int {
+	n := 0
+	for _, ship := range ps.Ships {
+		if !ship.IsSunk() {
+			n++
+		}
+	}
+	return n
+}
+
+// unhitCells counts the cells worth firing at: not hit yet, and not an
+// island.
+func (ps *PlayerState) unhitCells() int {
+	n := 0
+	for x := 0; x < GridSize; x++ {
+		for y := 0; y < GridSize; y++ {
+			if cell := ps.Grid[x][y]; !cell.Hit && !cell.Island {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// AvailablePowers returns one entry per power charge, ordered by type.
+// Powers of the same type stack.
 func (ps *PlayerState) AvailablePowers() []*piratesv1.Power {
+	types := make([]piratesv1.PowerType, 0, len(ps.Powers))
+	for pt := range ps.Powers {
+		types = append(types, pt)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
 	var powers []*piratesv1.Power
-	for pt, available := range ps.Powers {
-		if available {
+	for _, pt := range types {
+		for i := 0; i < ps.Powers[pt]; i++ {
 			powers = append(powers, &piratesv1.Power{
 				Type: pt,
 				Name: powerName(pt),
@@ -128,19 +270,17 @@ func (ps *PlayerState) AvailablePowers() []*piratesv1.Power {
 	return powers
 }
 
-func powerName(pt piratesv1.PowerType) string {
-	switch pt {
-	case piratesv1.PowerType_POWER_TYPE_INSTAKILL:
-		return "Instakill"
-	case piratesv1.PowerType_POWER_TYPE_TRIPLE:
-		return "Triple"
-	case piratesv1.PowerType_POWER_TYPE_SONAR:
-		return "Sonar"
-	case piratesv1.PowerType_POWER_TYPE_KRAKEN:
-		return "Kraken"
-	default:
-		return ""
+// shielded reports whether the player's shield covers (x, y).
+func (ps *PlayerState) shielded(x, y int) bool {
+	if ps.Shield == nil {
+		return false
 	}
+	area := Powers[piratesv1.PowerType_POWER_TYPE_SHIELD].Cells(ps.Shield.X, ps.Shield.Y, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+	return slices.Contains(area, Coordinate{X: x, Y: y})
+}
+
+func powerName(pt piratesv1.PowerType) string {
+	return Powers[pt].Name
 }
 
 func shipSizeToPower(size int) piratesv1.PowerType {
@@ -161,54 +301,213 @@ func shipSizeToPower(size int) piratesv1.PowerType {
 type Game struct {
 	mu sync.RWMutex
 
-	ID           string
+	ID string
+	// PlayerIDs seats the players in turn order. Player1ID and Player2ID
+	// are the first two seats, kept for two-player callers.
+	PlayerIDs    []string
 	Player1ID    string
 	Player2ID    string
 	Player1State *PlayerState
 	Player2State *PlayerState
+	states       map[string]*PlayerState
 	CurrentTurn  string
 	Status       GameStatus
 	Winner       string
+	EndReason    piratesv1.GameOverReason
+	Rules        Rules
+	CreatedAt    time.Time
+	EndedAt      time.Time
+	// SalvoShots is the number of targets the current player's salvo must
+	// have, set at the start of each turn of a salvo game.
+	SalvoShots int
+	// BonusTurn is true while the current player plays again after a hit.
+	BonusTurn bool
+	// TurnDeadline is when the current turn times out, under a turn time.
+	TurnDeadline time.Time
+	// Series is the series of games this game is part of, if any.
+	Series *Series
+
+	bonusStreak int
+	turnHit     bool
+	turnSunk    bool
+	shieldHit   bool
+	// lastTurns records who last played for each team, so that teammates
+	// take turns.
+	lastTurns map[int]string
+	// TerrainSeed generated the terrain, and seeds the mine blasts, of a
+	// terrain game.
+	TerrainSeed int64
+	rng         *rand.Rand
 }
 
 func NewGame(id, player1ID, player2ID string) *Game {
-	return &Game{
-		ID:           id,
-		Player1ID:    player1ID,
-		Player2ID:    player2ID,
-		Player1State: NewPlayerState(),
-		Player2State: NewPlayerState(),
-		Status:       StatusWaitingForShips,
+	return NewGameWithRules(id, player1ID, player2ID, DefaultRules())
+}
+
+func NewGameWithRules(id, player1ID, player2ID string, rules Rules) *Game {
+	return NewGameForPlayers(id, []string{player1ID, player2ID}, rules)
+}
+
+// NewGameForPlayers creates a game seating playerIDs in turn order. More
+// than two players play a free-for-all, each attack aimed at an opponent of
+// the attacker's choice, unless the rules make teams. Callers keep the
+// count within MaxPlayers, and seat exactly four players in team games.
+func NewGameForPlayers(id string, playerIDs []string, rules Rules) *Game {
+	g := &Game{
+		ID:        id,
+		PlayerIDs: append([]string(nil), playerIDs...),
+		states:    make(map[string]*PlayerState, len(playerIDs)),
+		Status:    StatusWaitingForShips,
+		Rules:     rules,
+		CreatedAt: time.Now(),
+		lastTurns: make(map[int]string),
+	}
+	for i, playerID := range playerIDs {
+		ps := NewPlayerState()
+		// Teammates draw on a single pool of powers.
+		if first := playerIDs[rules.Team(i)]; rules.Teams && first != playerID {
+			ps.Powers = g.states[first].Powers
+		}
+		g.states[playerID] = ps
+	}
+	g.Player1ID, g.Player2ID = playerIDs[0], playerIDs[1]
+	g.Player1State, g.Player2State = g.states[g.Player1ID], g.states[g.Player2ID]
+
+	if rules.Terrain {
+		g.generateTerrain(rules.TerrainSeed)
+	}
+	if rules.DefensivePowers {
+		// Once per team: teammates share the pool of their first seat.
+		for i, playerID := range playerIDs {
+			if rules.Team(i) != i {
+				continue
+			}
+			ps := g.states[playerID]
+			ps.Powers[piratesv1.PowerType_POWER_TYPE_SHIELD]++
+			ps.Powers[piratesv1.PowerType_POWER_TYPE_REPAIR]++
+			ps.Powers[piratesv1.PowerType_POWER_TYPE_DECOY]++
+		}
 	}
+	return g
 }
 
 func (g *Game) getPlayerState(playerID string) (*PlayerState, error) {
-	switch playerID {
-	case g.Player1ID:
-		return g.Player1State, nil
-	case g.Player2ID:
-		return g.Player2State, nil
-	default:
+	ps, ok := g.states[playerID]
+	if !ok {
 		return nil, ErrInvalidPlayer
 	}
+	return ps, nil
 }
 
+// seatStates returns the players' states in turn order.
+func (g *Game) seatStates() []*PlayerState {
+	states := make([]*PlayerState, len(g.PlayerIDs))
+	for i, playerID := range g.PlayerIDs {
+		states[i] = g.states[playerID]
+	}
+	return states
+}
+
+// GetOpponentID returns the next opponent of playerID still in the game, in
+// turn order: the other player of a two-player game.
 func (g *Game) GetOpponentID(playerID string) string {
-	if playerID == g.Player1ID {
-		return g.Player2ID
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.nextOpponentLocked(playerID)
+}
+
+func (g *Game) nextOpponentLocked(playerID string) string {
+	opponents := g.OpponentIDs(playerID)
+	for _, id := range opponents {
+		if !g.states[id].Eliminated {
+			return id
+		}
 	}
-	return g.Player1ID
+	// No opponent is left: the game is over, and the next one stands in.
+	return opponents[0]
 }
 
-func (g *Game) getOpponentState(playerID string) (*PlayerState, error) {
-	switch playerID {
-	case g.Player1ID:
-		return g.Player2State, nil
-	case g.Player2ID:
-		return g.Player1State, nil
-	default:
-		return nil, ErrInvalidPlayer
+// nextPlayerLocked returns who plays after the current player: the next
+// opponent still in the game or, in team games, the member of that
+// opponent's team after the one who played last for it.
+func (g *Game) nextPlayerLocked() string {
+	next := g.nextOpponentLocked(g.CurrentTurn)
+	last, ok := g.lastTurns[g.team(next)]
+	if !ok {
+		return next
+	}
+	for _, id := range append(g.OtherPlayerIDs(last), last) {
+		if g.allied(id, next) && !g.states[id].Eliminated {
+			return id
+		}
+	}
+	return next
+}
+
+// OtherPlayerIDs returns every other player of the game in turn order from
+// playerID, allies and eliminated players included.
+func (g *Game) OtherPlayerIDs(playerID string) []string {
+	seat := slices.Index(g.PlayerIDs, playerID)
+	n := len(g.PlayerIDs)
+	var ids []string
+	for i := 1; i <= n; i++ {
+		if id := g.PlayerIDs[(seat+i+n)%n]; id != playerID {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// OpponentIDs returns playerID's opponents in turn order from them,
+// eliminated or not. Outside team games, everyone else is an opponent.
+func (g *Game) OpponentIDs(playerID string) []string {
+	return slices.DeleteFunc(g.OtherPlayerIDs(playerID), func(id string) bool {
+		return g.allied(playerID, id)
+	})
+}
+
+// AllyIDs returns playerID's teammates, eliminated or not.
+func (g *Game) AllyIDs(playerID string) []string {
+	return slices.DeleteFunc(g.OtherPlayerIDs(playerID), func(id string) bool {
+		return !g.allied(playerID, id)
+	})
+}
+
+func (g *Game) team(playerID string) int {
+	return g.Rules.Team(slices.Index(g.PlayerIDs, playerID))
+}
+
+// allied reports whether a and b play on the same team, which a player
+// does with themselves.
+func (g *Game) allied(a, b string) bool {
+	return g.team(a) == g.team(b)
+}
+
+// targetState returns the state of the opponent playerID fires at. An empty
+// targetID picks the next opponent still in the game.
+func (g *Game) targetState(playerID, targetID string) (*PlayerState, error) {
+	if targetID == "" {
+		targetID = g.nextOpponentLocked(playerID)
+	}
+	ts, ok := g.states[targetID]
+	if !ok || g.allied(playerID, targetID) {
+		return nil, ErrInvalidOpponent
+	}
+	if ts.Eliminated {
+		return nil, ErrPlayerEliminated
+	}
+	return ts, nil
+}
+
+// standingLocked returns the players still in the game, in turn order.
+func (g *Game) standingLocked() []string {
+	var ids []string
+	for _, playerID := range g.PlayerIDs {
+		if !g.states[playerID].Eliminated {
+			ids = append(ids, playerID)
+		}
 	}
+	return ids
 }
 
 func (g *Game) IsPlayerTurn(playerID string) bool {
@@ -220,13 +519,76 @@ func (g *Game) IsPlayerTurn(playerID string) bool {
 func (g *Game) NextTurn() {
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	g.nextTurnLocked()
+}
+
+// FinishTurn ends the current player's action. Under a bonus-turn rule a
+// qualifying hit lets the same player go again, up to the rules' cap;
+// otherwise the turn passes to the opponent.
+func (g *Game) FinishTurn() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.states[g.CurrentTurn].Eliminated && g.earnedBonusLocked() {
+		g.BonusTurn = true
+		g.bonusStreak++
+		g.startTurnLocked()
+		return
+	}
+	g.nextTurnLocked()
+}
+
+func (g *Game) earnedBonusLocked() bool {
+	var earned bool
+	switch g.Rules.BonusTurn {
+	case piratesv1.BonusTurn_BONUS_TURN_ON_HIT:
+		earned = g.turnHit
+	case piratesv1.BonusTurn_BONUS_TURN_ON_SINK:
+		earned = g.turnSunk
+	}
+	return earned && (g.Rules.MaxBonusTurns <= 0 || g.bonusStreak < g.Rules.MaxBonusTurns)
+}
+
+func (g *Game) nextTurnLocked() {
+	g.BonusTurn = false
+	g.bonusStreak = 0
+	g.CurrentTurn = g.nextPlayerLocked()
 	if g.CurrentTurn == g.Player1ID {
-		g.CurrentTurn = g.Player2ID
-		g.Status = StatusPlayer2Turn
-	} else {
-		g.CurrentTurn = g.Player1ID
 		g.Status = StatusPlayer1Turn
+	} else {
+		g.Status = StatusPlayer2Turn
 	}
+	g.startTurnLocked()
+}
+
+// startTurnLocked prepares the turn of the current player.
+func (g *Game) startTurnLocked() {
+	g.turnHit = false
+	g.turnSunk = false
+	g.lastTurns[g.team(g.CurrentTurn)] = g.CurrentTurn
+	if g.Rules.TurnTime > 0 {
+		g.TurnDeadline = time.Now().Add(g.Rules.TurnTime)
+	}
+
+	if g.Rules.RuleSet != piratesv1.RuleSet_RULE_SET_SALVO {
+		return
+	}
+
+	playerState, _ := g.getPlayerState(g.CurrentTurn)
+
+	shots := g.Rules.SalvoShots
+	if shots <= 0 {
+		shots = playerState.SurvivingShips()
+	}
+	// A salvo can never have more shots than there are cells left to hit on
+	// the most open board.
+	open := 0
+	for _, opponentID := range g.OpponentIDs(g.CurrentTurn) {
+		if other := g.states[opponentID]; !other.Eliminated {
+			open = max(open, other.unhitCells())
+		}
+	}
+	g.SalvoShots = min(shots, open)
 }
 
 func (g *Game) PlaceShips(playerID string, ships []*piratesv1.Ship) error {
@@ -246,7 +608,7 @@ func (g *Game) PlaceShips(playerID string, ships []*piratesv1.Ship) error {
 		return ErrShipsAlreadyPlaced
 	}
 
-	if err := g.validateShipPlacement(ships); err != nil {
+	if err := g.validateShipPlacement(ps, ships); err != nil {
 		return err
 	}
 
@@ -257,16 +619,9 @@ func (g *Game) PlaceShips(playerID string, ships []*piratesv1.Ship) error {
 			Size: int(ship.Size),
 		}
 
-		x, y := int(ship.Start.X), int(ship.Start.Y)
-		for i := 0; i < int(ship.Size); i++ {
-			var cx, cy int
-			if ship.Horizontal {
-				cx, cy = x+i, y
-			} else {
-				cx, cy = x, y+i
-			}
-			gameShip.Cells = append(gameShip.Cells, Coordinate{X: cx, Y: cy})
-			ps.Grid[cx][cy].ShipID = ship.Id
+		gameShip.Cells = shipCells(ship)
+		for _, c := range gameShip.Cells {
+			ps.Grid[c.X][c.Y].ShipID = ship.Id
 		}
 		ps.Ships[ship.Id] = gameShip
 	}
@@ -275,69 +630,141 @@ func (g *Game) PlaceShips(playerID string, ships []*piratesv1.Ship) error {
 	return nil
 }
 
-func (g *Game) validateShipPlacement(ships []*piratesv1.Ship) error {
+func (g *Game) validateShipPlacement(ps *PlayerState, ships []*piratesv1.Ship) error {
 	if len(ships) != len(RequiredShips) {
 		return fmt.Errorf("%w: expected %d ships, got %d", ErrInvalidPlacement, len(RequiredShips), len(ships))
 	}
 
-	requiredCounts := make(map[int]int)
+	// Each ship must match a distinct required definition by name and size.
+	remaining := make(map[string]int)
 	for _, def := range RequiredShips {
-		requiredCounts[def.Size]++
+		remaining[def.Name] = def.Size
 	}
-	providedCounts := make(map[int]int)
+	ids := make(map[string]bool)
 	for _, ship := range ships {
-		providedCounts[int(ship.Size)]++
-	}
-	for size, count := range requiredCounts {
-		if providedCounts[size] != count {
-			return fmt.Errorf("%w: wrong number of size-%d ships", ErrInvalidPlacement, size)
+		if ship.Id == "" {
+			return fmt.Errorf("%w: ship %s has no id", ErrInvalidPlacement, ship.Name)
+		}
+		if ids[ship.Id] {
+			return fmt.Errorf("%w: duplicate ship id %s", ErrInvalidPlacement, ship.Id)
 		}
+		ids[ship.Id] = true
+
+		size, ok := remaining[ship.Name]
+		if !ok {
+			if requiredShip(ship.Name) {
+				return fmt.Errorf("%w: ship %s is placed more than once", ErrInvalidPlacement, ship.Name)
+			}
+			return fmt.Errorf("%w: unknown ship %q", ErrInvalidPlacement, ship.Name)
+		}
+		if int(ship.Size) != size {
+			return fmt.Errorf("%w: ship %s must have size %d, got %d", ErrInvalidPlacement, ship.Name, size, ship.Size)
+		}
+		delete(remaining, ship.Name)
 	}
 
-	occupied := make(map[Coordinate]bool)
+	occupied := make(map[Coordinate]*piratesv1.Ship)
 	for _, ship := range ships {
 		if ship.Start == nil {
-			return fmt.Errorf("%w: ship %s has no start coordinate", ErrInvalidPlacement, ship.Id)
+			return fmt.Errorf("%w: ship %s has no start coordinate", ErrInvalidPlacement, ship.Name)
 		}
 
-		x, y := int(ship.Start.X), int(ship.Start.Y)
-		for i := 0; i < int(ship.Size); i++ {
-			var cx, cy int
-			if ship.Horizontal {
-				cx, cy = x+i, y
-			} else {
-				cx, cy = x, y+i
+		for _, coord := range shipCells(ship) {
+			if coord.X < 0 || coord.X >= GridSize || coord.Y < 0 || coord.Y >= GridSize {
+				return fmt.Errorf("%w: ship %s extends out of bounds at (%d, %d)", ErrInvalidPlacement, ship.Name, coord.X, coord.Y)
 			}
-
-			if cx < 0 || cx >= GridSize || cy < 0 || cy >= GridSize {
-				return fmt.Errorf("%w: ship %s extends out of bounds", ErrInvalidPlacement, ship.Id)
+			if other, ok := occupied[coord]; ok {
+				return fmt.Errorf("%w: %s overlaps %s at (%d, %d)", ErrInvalidPlacement, ship.Name, other.Name, coord.X, coord.Y)
+			}
+			if cell := ps.Grid[coord.X][coord.Y]; cell.Island {
+				return fmt.Errorf("%w: %s is on an island at (%d, %d)", ErrInvalidPlacement, ship.Name, coord.X, coord.Y)
+			} else if cell.Mine {
+				return fmt.Errorf("%w: %s is on a mine at (%d, %d)", ErrInvalidPlacement, ship.Name, coord.X, coord.Y)
 			}
+			occupied[coord] = ship
+		}
+	}
 
-			coord := Coordinate{X: cx, Y: cy}
-			if occupied[coord] {
-				return fmt.Errorf("%w: ships overlap at (%d, %d)", ErrInvalidPlacement, cx, cy)
+	if g.Rules.NoTouch {
+		for _, ship := range ships {
+			for _, coord := range shipCells(ship) {
+				for dx := -1; dx <= 1; dx++ {
+					for dy := -1; dy <= 1; dy++ {
+						n := Coordinate{X: coord.X + dx, Y: coord.Y + dy}
+						if other, ok := occupied[n]; ok && other != ship {
+							return fmt.Errorf("%w: %s at (%d, %d) touches %s at (%d, %d)",
+								ErrInvalidPlacement, ship.Name, coord.X, coord.Y, other.Name, n.X, n.Y)
+						}
+					}
+				}
 			}
-			occupied[coord] = true
 		}
 	}
 
 	return nil
 }
 
-func (g *Game) BothPlayersReady() bool {
+func requiredShip(name string) bool {
+	for _, def := range RequiredShips {
+		if def.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// shipCells returns the cells a placed ship covers, without bounds checks.
+func shipCells(ship *piratesv1.Ship) []Coordinate {
+	x, y := int(ship.Start.X), int(ship.Start.Y)
+	cells := make([]Coordinate, 0, ship.Size)
+	for i := 0; i < int(ship.Size); i++ {
+		if ship.Horizontal {
+			cells = append(cells, Coordinate{X: x + i, Y: y})
+		} else {
+			cells = append(cells, Coordinate{X: x, Y: y + i})
+		}
+	}
+	return cells
+}
+
+// AllPlayersReady reports whether every player still in the game has
+// placed their ships.
+func (g *Game) AllPlayersReady() bool {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
-	return g.Player1State.ShipsReady && g.Player2State.ShipsReady
+	for _, ps := range g.states {
+		if !ps.Eliminated && !ps.ShipsReady {
+			return false
+		}
+	}
+	return true
 }
 
 func (g *Game) StartGame() {
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	g.CurrentTurn = g.Player1ID
-	g.Status = StatusPlayer1Turn
+	g.startLocked(g.Player1ID)
+}
+
+// startLocked starts the battle with playerID's turn.
+func (g *Game) startLocked(playerID string) {
+	g.CurrentTurn = playerID
+	if playerID == g.Player1ID {
+		g.Status = StatusPlayer1Turn
+	} else {
+		g.Status = StatusPlayer2Turn
+	}
+	g.startTurnLocked()
 }
 
+// Attack fires at the next opponent still in the game, the only one in a
+// two-player game.
 func (g *Game) Attack(playerID string, x, y int) (*piratesv1.AttackResult, error) {
+	return g.AttackOn(playerID, "", x, y)
+}
+
+// AttackOn fires at cell (x, y) of targetID's grid.
+func (g *Game) AttackOn(playerID, targetID string, x, y int) (*piratesv1.AttackResult, error) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
@@ -349,53 +776,127 @@ func (g *Game) Attack(playerID string, x, y int) (*piratesv1.AttackResult, error
 		return nil, ErrNotYourTurn
 	}
 
+	if g.Rules.RuleSet == piratesv1.RuleSet_RULE_SET_SALVO {
+		return nil, ErrSalvoRequired
+	}
+
 	if x < 0 || x >= GridSize || y < 0 || y >= GridSize {
 		return nil, ErrInvalidTarget
 	}
 
-	opponentState, err := g.getOpponentState(playerID)
+	opponentState, err := g.targetState(playerID, targetID)
 	if err != nil {
 		return nil, err
 	}
 
-	cell := opponentState.Grid[x][y]
-	if cell.Hit {
+	if opponentState.Grid[x][y].Hit {
 		return nil, ErrAlreadyHit
 	}
 
-	cell.Hit = true
+	playerState, _ := g.getPlayerState(playerID)
+	result := g.fire(playerState, opponentState, x, y)
+	g.dropSpentShield(opponentState)
+	return result, nil
+}
 
-	result := &piratesv1.AttackResult{
-		Target: &piratesv1.Coordinate{X: int32(x), Y: int32(y)},
-		Hit:    cell.ShipID != "",
+// AttackSalvo fires a salvo at the next opponent still in the game.
+func (g *Game) AttackSalvo(playerID string, targets []*piratesv1.Coordinate) (*piratesv1.SalvoResult, error) {
+	return g.AttackSalvoOn(playerID, "", targets)
+}
+
+// AttackSalvoOn fires every target of a salvo at targetID's grid. The salvo
+// is validated as a whole before any shot is resolved, so it either lands
+// entirely or not at all.
+func (g *Game) AttackSalvoOn(playerID, targetID string, targets []*piratesv1.Coordinate) (*piratesv1.SalvoResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.Status != StatusPlayer1Turn && g.Status != StatusPlayer2Turn {
+		return nil, ErrGameNotInProgress
 	}
 
-	if cell.ShipID != "" {
-		ship := opponentState.Ships[cell.ShipID]
-		ship.Hits++
+	if g.CurrentTurn != playerID {
+		return nil, ErrNotYourTurn
+	}
 
-		if ship.IsSunk() {
-			for _, coord := range ship.Cells {
-				opponentState.Grid[coord.X][coord.Y].Sunk = true
-			}
-			result.SunkShip = ship.ToProto()
-
-			// Power goes to the DEFENDER (opponent) as compensation
-			power := shipSizeToPower(ship.Size)
-			if power != piratesv1.PowerType_POWER_TYPE_UNSPECIFIED {
-				opponentState.Powers[power] = true
-				result.PowerGained = &piratesv1.Power{
-					Type: power,
-					Name: powerName(power),
-				}
-			}
+	if g.Rules.RuleSet != piratesv1.RuleSet_RULE_SET_SALVO {
+		return nil, ErrNotSalvoGame
+	}
+
+	opponentState, err := g.targetState(playerID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A board with fewer cells left than the salvo's shots takes one shot
+	// per cell.
+	if want := min(g.SalvoShots, opponentState.unhitCells()); len(targets) != want {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrWrongSalvoSize, want, len(targets))
+	}
+
+	seen := make(map[Coordinate]bool, len(targets))
+	for _, t := range targets {
+		c := Coordinate{X: int(t.GetX()), Y: int(t.GetY())}
+		if c.X < 0 || c.X >= GridSize || c.Y < 0 || c.Y >= GridSize {
+			return nil, ErrInvalidTarget
+		}
+		if opponentState.Grid[c.X][c.Y].Hit {
+			return nil, ErrAlreadyHit
 		}
+		if seen[c] {
+			return nil, ErrDuplicateTarget
+		}
+		seen[c] = true
 	}
 
+	playerState, _ := g.getPlayerState(playerID)
+	result := &piratesv1.SalvoResult{}
+	for _, t := range targets {
+		// A mine may sink the attacker's last ship mid-salvo.
+		if playerState.AllShipsSunk() {
+			break
+		}
+		shot := g.fire(playerState, opponentState, int(t.X), int(t.Y))
+		result.Shots = append(result.Shots, shot)
+		if shot.SunkShip != nil {
+			result.SunkShips = append(result.SunkShips, shot.SunkShip)
+		}
+		if shot.PowerGained != nil {
+			result.PowersGained = append(result.PowersGained, shot.PowerGained)
+		}
+	}
+	g.dropSpentShield(opponentState)
+
 	return result, nil
 }
 
-func (g *Game) UsePower(playerID string, power piratesv1.PowerType, x, y int, horizontal bool) (*piratesv1.PowerResult, error) {
+// fire resolves a single validated shot on the opponent's grid.
+func (g *Game) fire(playerState, opponentState *PlayerState, x, y int) *piratesv1.AttackResult {
+	s := g.shoot(playerState, opponentState, x, y)
+	result := &piratesv1.AttackResult{
+		Target:      &piratesv1.Coordinate{X: int32(x), Y: int32(y)},
+		Hit:         s.state == piratesv1.CellState_CELL_STATE_HIT || s.state == piratesv1.CellState_CELL_STATE_SUNK,
+		Island:      s.state == piratesv1.CellState_CELL_STATE_ISLAND,
+		Mine:        s.state == piratesv1.CellState_CELL_STATE_MINE,
+		Shielded:    s.state == piratesv1.CellState_CELL_STATE_SHIELDED,
+		MineBlast:   s.blast,
+		PowerGained: s.powerGained,
+	}
+	if s.sunk != nil {
+		result.SunkShip = s.sunk.ToProto()
+	}
+	return result
+}
+
+// UsePower aims power at the next opponent still in the game, or at the
+// player's own grid for defensive powers.
+func (g *Game) UsePower(playerID string, power piratesv1.PowerType, x, y int, o piratesv1.Orientation) (*piratesv1.PowerResult, error) {
+	return g.UsePowerOn(playerID, "", power, x, y, o)
+}
+
+// UsePowerOn aims power at (x, y) of targetID's grid. Defensive powers go
+// on the player's own grid and ignore targetID.
+func (g *Game) UsePowerOn(playerID, targetID string, power piratesv1.PowerType, x, y int, o piratesv1.Orientation) (*piratesv1.PowerResult, error) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
@@ -412,279 +913,399 @@ func (g *Game) UsePower(playerID string, power piratesv1.PowerType, x, y int, ho
 		return nil, err
 	}
 
-	if !playerState.Powers[power] {
+	if playerState.Powers[power] < 1 {
 		return nil, ErrPowerNotAvailable
 	}
 
-	opponentState, _ := g.getOpponentState(playerID)
-
-	var result *piratesv1.PowerResult
-	switch power {
-	case piratesv1.PowerType_POWER_TYPE_INSTAKILL:
-		result, err = g.useInstakill(opponentState, playerState, x, y)
-	case piratesv1.PowerType_POWER_TYPE_TRIPLE:
-		result, err = g.useTriple(opponentState, playerState, x, y, horizontal)
-	case piratesv1.PowerType_POWER_TYPE_SONAR:
-		result, err = g.useSonar(opponentState, x, y)
-	case piratesv1.PowerType_POWER_TYPE_KRAKEN:
-		result, err = g.useKraken(opponentState, playerState, x, y)
-	default:
+	def, ok := Powers[power]
+	if !ok {
 		return nil, ErrPowerNotAvailable
 	}
 
+	var opponentState *PlayerState
+	if !def.Defensive() {
+		if opponentState, err = g.targetState(playerID, targetID); err != nil {
+			return nil, err
+		}
+	}
+	result, err := g.usePower(def, playerState, opponentState, x, y, o)
 	if err != nil {
 		return nil, err
 	}
+	if opponentState != nil {
+		g.dropSpentShield(opponentState)
+	}
 
-	playerState.Powers[power] = false
+	playerState.Powers[power]--
+	playerState.Stats.PowersUsed = append(playerState.Stats.PowersUsed, power)
 	result.PowerUsed = power
 
 	return result, nil
 }
 
-func (g *Game) useInstakill(opponentState, _ *PlayerState, x, y int) (*piratesv1.PowerResult, error) {
-	if x < 0 || x >= GridSize || y < 0 || y >= GridSize {
-		return nil, ErrInvalidTarget
+// useShield raises a shield centered on (x, y) of the player's own grid,
+// over area. It absorbs every cell of the next opponent action that reaches
+// the area, then falls.
+func (g *Game) useShield(playerState *PlayerState, x, y int, area []Coordinate) (*piratesv1.PowerResult, error) {
+	if playerState.Shield != nil {
+		return nil, ErrShieldActive
 	}
 
-	cell := opponentState.Grid[x][y]
-	result := &piratesv1.PowerResult{}
-
-	cell.Hit = true
-	state := piratesv1.CellState_CELL_STATE_MISS
-	if cell.ShipID != "" {
-		ship := opponentState.Ships[cell.ShipID]
-		for _, coord := range ship.Cells {
-			opponentState.Grid[coord.X][coord.Y].Hit = true
-			opponentState.Grid[coord.X][coord.Y].Sunk = true
-			result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
-				Position: &piratesv1.Coordinate{X: int32(coord.X), Y: int32(coord.Y)},
-				State:    piratesv1.CellState_CELL_STATE_SUNK,
-			})
-		}
-		ship.Hits = ship.Size
-		result.SunkShips = append(result.SunkShips, ship.ToProto())
-
-		// Power goes to defender (opponentState) as compensation
-		power := shipSizeToPower(ship.Size)
-		if power != piratesv1.PowerType_POWER_TYPE_UNSPECIFIED {
-			opponentState.Powers[power] = true
-		}
-	} else {
+	playerState.Shield = &Coordinate{X: x, Y: y}
+	result := &piratesv1.PowerResult{OwnGrid: true}
+	for _, c := range area {
 		result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
-			Position: &piratesv1.Coordinate{X: int32(x), Y: int32(y)},
-			State:    state,
+			Position: &piratesv1.Coordinate{X: int32(c.X), Y: int32(c.Y)},
+			State:    piratesv1.CellState_CELL_STATE_SHIELDED,
 		})
 	}
-
 	return result, nil
 }
 
-func (g *Game) useTriple(opponentState, _ *PlayerState, x, y int, horizontal bool) (*piratesv1.PowerResult, error) {
-	result := &piratesv1.PowerResult{}
-
-	targets := []Coordinate{{X: x, Y: y}}
-	if horizontal {
-		if x > 0 {
-			targets = append([]Coordinate{{X: x - 1, Y: y}}, targets...)
-		}
-		if x < GridSize-1 {
-			targets = append(targets, Coordinate{X: x + 1, Y: y})
-		}
-	} else {
-		if y > 0 {
-			targets = append([]Coordinate{{X: x, Y: y - 1}}, targets...)
-		}
-		if y < GridSize-1 {
-			targets = append(targets, Coordinate{X: x, Y: y + 1})
-		}
+// useRepair restores a hit cell of one of the player's ships that is still
+// afloat. Mine damage cannot be repaired: the opponent never learned where
+// it struck, and the repair would tell them.
+func (g *Game) useRepair(playerState *PlayerState, x, y int) (*piratesv1.PowerResult, error) {
+	cell := playerState.Grid[x][y]
+	switch {
+	case cell.ShipID == "" || !cell.Hit:
+		return nil, fmt.Errorf("%w: no damaged ship at (%d, %d)", ErrNothingToRepair, x, y)
+	case cell.Sunk:
+		return nil, fmt.Errorf("%w: sunk ships cannot be repaired", ErrNothingToRepair)
+	case cell.MineDamage:
+		return nil, fmt.Errorf("%w: mine damage cannot be repaired", ErrNothingToRepair)
 	}
 
-	for _, t := range targets {
-		if t.X < 0 || t.X >= GridSize || t.Y < 0 || t.Y >= GridSize {
-			continue
-		}
-
-		cell := opponentState.Grid[t.X][t.Y]
-		if cell.Hit {
-			continue
-		}
-
-		cell.Hit = true
-		state := piratesv1.CellState_CELL_STATE_MISS
-		if cell.ShipID != "" {
-			ship := opponentState.Ships[cell.ShipID]
-			ship.Hits++
-			state = piratesv1.CellState_CELL_STATE_HIT
+	cell.Hit = false
+	// The opponent is told, so they know a ship is there.
+	cell.Revealed = true
+	playerState.Ships[cell.ShipID].Hits--
+	return &piratesv1.PowerResult{
+		OwnGrid: true,
+		CellsAffected: []*piratesv1.CellReveal{{
+			Position: &piratesv1.Coordinate{X: int32(x), Y: int32(y)},
+			State:    piratesv1.CellState_CELL_STATE_REPAIRED,
+		}},
+	}, nil
+}
 
-			if ship.IsSunk() {
-				for _, coord := range ship.Cells {
-					opponentState.Grid[coord.X][coord.Y].Sunk = true
-				}
-				state = piratesv1.CellState_CELL_STATE_SUNK
-				result.SunkShips = append(result.SunkShips, ship.ToProto())
+// useDecoy places a decoy on an empty cell of the player's own grid that
+// the opponent has not fired at yet.
+func (g *Game) useDecoy(playerState *PlayerState, x, y int) (*piratesv1.PowerResult, error) {
+	cell := playerState.Grid[x][y]
+	if cell.ShipID != "" || cell.Decoy || cell.Hit || cell.Island || cell.Mine {
+		return nil, fmt.Errorf("%w: decoys go on an empty cell not fired at yet", ErrInvalidTarget)
+	}
 
-				// Power goes to defender as compensation
-				power := shipSizeToPower(ship.Size)
-				if power != piratesv1.PowerType_POWER_TYPE_UNSPECIFIED {
-					opponentState.Powers[power] = true
-				}
-			}
-		}
+	cell.Decoy = true
+	return &piratesv1.PowerResult{
+		OwnGrid: true,
+		CellsAffected: []*piratesv1.CellReveal{{
+			Position: &piratesv1.Coordinate{X: int32(x), Y: int32(y)},
+			State:    piratesv1.CellState_CELL_STATE_DECOY,
+		}},
+	}, nil
+}
 
-		result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
-			Position: &piratesv1.Coordinate{X: int32(t.X), Y: int32(t.Y)},
-			State:    state,
-		})
+// absorb reports whether defenderState's shield stops a shot at (x, y).
+func (g *Game) absorb(defenderState *PlayerState, x, y int) bool {
+	if !defenderState.shielded(x, y) {
+		return false
 	}
-
-	return result, nil
+	g.shieldHit = true
+	return true
 }
 
-func (g *Game) useSonar(opponentState *PlayerState, x, y int) (*piratesv1.PowerResult, error) {
-	if x < 0 || x >= GridSize || y < 0 || y >= GridSize {
-		return nil, ErrInvalidTarget
+// dropSpentShield lowers defenderState's shield once an action it absorbed
+// part of is over.
+func (g *Game) dropSpentShield(defenderState *PlayerState) {
+	if g.shieldHit {
+		defenderState.Shield = nil
 	}
+	g.shieldHit = false
+}
 
-	result := &piratesv1.PowerResult{}
+// compensate grants the owner of a sunk ship the power matching its size,
+// unless the rules disable powers. It returns the granted power, if any.
+func (g *Game) compensate(defenderState *PlayerState, ship *GameShip) *piratesv1.Power {
+	if !g.Rules.Powers {
+		return nil
+	}
+	power := shipSizeToPower(ship.Size)
+	if power == piratesv1.PowerType_POWER_TYPE_UNSPECIFIED {
+		return nil
+	}
+	defenderState.Powers[power]++
+	return &piratesv1.Power{
+		Type: power,
+		Name: powerName(power),
+	}
+}
 
-	reveal := func(cx, cy int) {
-		if cx < 0 || cx >= GridSize || cy < 0 || cy >= GridSize {
-			return
-		}
-		cell := opponentState.Grid[cx][cy]
-		cell.Revealed = true
-
-		var state piratesv1.CellState
-		if cell.Sunk {
-			state = piratesv1.CellState_CELL_STATE_SUNK
-		} else if cell.Hit && cell.ShipID != "" {
-			state = piratesv1.CellState_CELL_STATE_HIT
-		} else if cell.Hit {
-			state = piratesv1.CellState_CELL_STATE_MISS
-		} else if cell.ShipID != "" {
-			state = piratesv1.CellState_CELL_STATE_REVEALED
-		} else {
-			state = piratesv1.CellState_CELL_STATE_EMPTY
-		}
+// Forfeit takes playerID out of the game. In a two-player game their
+// opponent wins. A player already out cannot forfeit.
+func (g *Game) Forfeit(playerID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-		result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
-			Position: &piratesv1.Coordinate{X: int32(cx), Y: int32(cy)},
-			State:    state,
-		})
+	ps, err := g.getPlayerState(playerID)
+	if err != nil {
+		return err
 	}
-
-	for i := -5; i <= 5; i++ {
-		reveal(x+i, y)
-		if i != 0 {
-			reveal(x, y+i)
-		}
+	if ps.Eliminated {
+		return ErrPlayerEliminated
 	}
+	g.leaveLocked(playerID, piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT)
+	return nil
+}
 
-	reveal(x-1, y-1)
-	reveal(x+1, y-1)
-	reveal(x-1, y+1)
-	reveal(x+1, y+1)
+// Leave eliminates playerID for reason, e.g. a forfeit or a disconnection.
+// The game ends once a single player is left; otherwise, if it was
+// playerID's turn, the turn passes on. Leave does nothing for a player
+// already out or once the game is over.
+func (g *Game) Leave(playerID string, reason piratesv1.GameOverReason) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-	return result, nil
+	if _, err := g.getPlayerState(playerID); err != nil {
+		return err
+	}
+	g.leaveLocked(playerID, reason)
+	return nil
 }
 
-func (g *Game) useKraken(opponentState, _ *PlayerState, x, y int) (*piratesv1.PowerResult, error) {
-	if x < 0 || x >= GridSize || y < 0 || y >= GridSize {
-		return nil, ErrInvalidTarget
+func (g *Game) leaveLocked(playerID string, reason piratesv1.GameOverReason) {
+	if g.states[playerID].Eliminated || g.Status == StatusFinished {
+		return
 	}
+	g.eliminateLocked(playerID, reason)
+	if g.Status != StatusFinished && g.CurrentTurn == playerID {
+		g.nextTurnLocked()
+	}
+}
 
-	result := &piratesv1.PowerResult{}
-
-	attack := func(cx, cy int) {
-		if cx < 0 || cx >= GridSize || cy < 0 || cy >= GridSize {
-			return
-		}
-		cell := opponentState.Grid[cx][cy]
-		if cell.Hit {
-			return
-		}
+// TimeOutTurn takes the current player out of the game once their turn is
+// past its deadline at now, and returns them. The turn passes on unless the
+// game is over.
+func (g *Game) TimeOutTurn(now time.Time) (string, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-		cell.Hit = true
-		state := piratesv1.CellState_CELL_STATE_MISS
-		if cell.ShipID != "" {
-			ship := opponentState.Ships[cell.ShipID]
-			ship.Hits++
-			state = piratesv1.CellState_CELL_STATE_HIT
+	if g.Status != StatusPlayer1Turn && g.Status != StatusPlayer2Turn {
+		return "", false
+	}
+	if g.TurnDeadline.IsZero() || now.Before(g.TurnDeadline) {
+		return "", false
+	}
+	playerID := g.CurrentTurn
+	g.eliminateLocked(playerID, piratesv1.GameOverReason_GAME_OVER_REASON_TURN_TIMEOUT)
+	if g.Status != StatusFinished {
+		g.nextTurnLocked()
+	}
+	return playerID, true
+}
 
-			if ship.IsSunk() {
-				for _, coord := range ship.Cells {
-					opponentState.Grid[coord.X][coord.Y].Sunk = true
-				}
-				state = piratesv1.CellState_CELL_STATE_SUNK
-				result.SunkShips = append(result.SunkShips, ship.ToProto())
+// TimeOutPlacement takes the players who have not placed their ships out of
+// a game still in placement, and returns them. The battle then starts with
+// those who did; a single side left wins, and nobody left ends the game
+// without a winner.
+func (g *Game) TimeOutPlacement() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-				// Power goes to defender as compensation
-				power := shipSizeToPower(ship.Size)
-				if power != piratesv1.PowerType_POWER_TYPE_UNSPECIFIED {
-					opponentState.Powers[power] = true
-				}
-			}
+	if g.Status != StatusWaitingForShips {
+		return nil
+	}
+	reason := piratesv1.GameOverReason_GAME_OVER_REASON_PLACEMENT_TIMEOUT
+	var late []string
+	for _, playerID := range g.PlayerIDs {
+		if ps := g.states[playerID]; !ps.Eliminated && !ps.ShipsReady {
+			ps.Eliminated = true
+			ps.EliminationReason = reason
+			late = append(late, playerID)
 		}
-
-		result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
-			Position: &piratesv1.Coordinate{X: int32(cx), Y: int32(cy)},
-			State:    state,
-		})
+	}
+	if len(late) == 0 {
+		return nil
 	}
 
-	attack(x, y)
-	for i := 1; i <= 2; i++ {
-		attack(x-i, y)
-		attack(x+i, y)
-		attack(x, y-i)
-		attack(x, y+i)
+	standing := g.standingLocked()
+	switch {
+	case len(standing) == 0:
+		g.endLocked("", reason)
+	case !slices.ContainsFunc(standing, func(id string) bool { return !g.allied(id, standing[0]) }):
+		g.endLocked(standing[0], reason)
+	default:
+		g.startLocked(standing[0])
 	}
+	return late
+}
 
-	return result, nil
+// GetTurnDeadline returns when the current turn times out, zero without a
+// turn time.
+func (g *Game) GetTurnDeadline() time.Time {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.TurnDeadline
 }
 
-func (g *Game) Forfeit(playerID string) *piratesv1.GameOver {
+// CheckVictory eliminates the players whose fleet has been entirely sunk,
+// ends the game once a single player is left and reports whether it did.
+func (g *Game) CheckVictory() bool {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
-	g.Status = StatusFinished
-	g.Winner = g.GetOpponentID(playerID)
-
-	return &piratesv1.GameOver{
-		YouWon: false,
-		Reason: "opponent_forfeit",
-	}
+	g.eliminateSunkFleetsLocked()
+	return g.Status == StatusFinished
 }
 
-func (g *Game) CheckVictory() *piratesv1.GameOver {
+// EliminateSunkFleets eliminates the players whose fleet has been entirely
+// sunk and returns them in turn order. The game ends once a single player
+// is left.
+func (g *Game) EliminateSunkFleets() []string {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
-	if g.Player1State.AllShipsSunk() {
-		g.Winner = g.Player2ID
-		g.Status = StatusFinished
-		return &piratesv1.GameOver{
-			YouWon: true, // From perspective of player2
-			Reason: "all_ships_sunk",
+	return g.eliminateSunkFleetsLocked()
+}
+
+func (g *Game) eliminateSunkFleetsLocked() []string {
+	if g.Status == StatusFinished {
+		return nil
+	}
+	var eliminated []string
+	for _, playerID := range g.PlayerIDs {
+		if ps := g.states[playerID]; !ps.Eliminated && ps.AllShipsSunk() {
+			g.eliminateLocked(playerID, piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+			eliminated = append(eliminated, playerID)
 		}
 	}
-	if g.Player2State.AllShipsSunk() {
-		g.Winner = g.Player1ID
-		g.Status = StatusFinished
-		return &piratesv1.GameOver{
-			YouWon: true, // From perspective of player1
-			Reason: "all_ships_sunk",
+	return eliminated
+}
+
+// eliminateLocked takes playerID out of the game, and ends it in favor of
+// the last player, or team, standing.
+func (g *Game) eliminateLocked(playerID string, reason piratesv1.GameOverReason) {
+	g.states[playerID].Eliminated = true
+	g.states[playerID].EliminationReason = reason
+	standing := g.standingLocked()
+	for _, id := range standing {
+		if !g.allied(id, standing[0]) {
+			return
 		}
 	}
-	return nil
+	if len(standing) > 0 {
+		g.endLocked(standing[0], reason)
+	}
 }
 
-func (g *Game) SetWinner(playerID string) {
+// IsEliminated reports whether playerID is out of the game.
+func (g *Game) IsEliminated(playerID string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	ps, err := g.getPlayerState(playerID)
+	return err == nil && ps.Eliminated
+}
+
+// End finishes the game with the given winner and reason. An empty winnerID
+// ends the game without a winner.
+func (g *Game) End(winnerID string, reason piratesv1.GameOverReason) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	g.Winner = playerID
+	g.endLocked(winnerID, reason)
+}
+
+func (g *Game) endLocked(winnerID string, reason piratesv1.GameOverReason) {
+	if g.Status == StatusFinished {
+		return
+	}
+	g.Winner = winnerID
+	g.EndReason = reason
 	g.Status = StatusFinished
+	g.EndedAt = time.Now()
+}
+
+// GameOverFor builds the end-of-game message as seen by playerID, revealing
+// every fleet.
+func (g *Game) GameOverFor(playerID string) *piratesv1.GameOver {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	ps, err := g.getPlayerState(playerID)
+	if err != nil {
+		return nil
+	}
+	// Once the game is over, the winner is the only opponent still in it.
+	opponentState := g.states[g.nextOpponentLocked(playerID)]
+
+	end := g.EndedAt
+	if end.IsZero() {
+		end = time.Now()
+	}
+
+	summary := &piratesv1.GameSummary{
+		YourFleet:       ps.Fleet(),
+		OpponentFleet:   opponentState.Fleet(),
+		YourStats:       ps.Stats.ToProto(),
+		OpponentStats:   opponentState.Stats.ToProto(),
+		DurationSeconds: int32(end.Sub(g.CreatedAt).Seconds()),
+		OpponentDecoys:  coordsToProto(opponentState.Grid.Decoys()),
+	}
+	for _, opponentID := range g.OpponentIDs(playerID) {
+		summary.Opponents = append(summary.Opponents, g.states[opponentID].summary(opponentID))
+	}
+	for _, allyID := range g.AllyIDs(playerID) {
+		summary.Allies = append(summary.Allies, g.states[allyID].summary(allyID))
+	}
+
+	return &piratesv1.GameOver{
+		YouWon:   g.Winner != "" && g.allied(g.Winner, playerID),
+		Reason:   g.EndReason,
+		Summary:  summary,
+		WinnerId: g.Winner,
+		Series:   g.Series.ToProto(false),
+	}
+}
+
+// Outcome is how a finished game went for one of its players.
+type Outcome struct {
+	PlayerID string
+	Team     int
+	Won      bool
+	// Reason is why the player is out, or why the game ended for those
+	// still in it.
+	Reason    piratesv1.GameOverReason
+	Stats     PlayerStats
+	ShipsLost int
+}
+
+// Outcomes returns every player's outcome, in turn order.
+func (g *Game) Outcomes() []Outcome {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	outcomes := make([]Outcome, 0, len(g.PlayerIDs))
+	for _, playerID := range g.PlayerIDs {
+		ps := g.states[playerID]
+		outcome := Outcome{
+			PlayerID: playerID,
+			Team:     g.team(playerID),
+			Won:      g.Winner != "" && g.allied(g.Winner, playerID),
+			Reason:   g.EndReason,
+			Stats:    ps.Stats,
+		}
+		outcome.Stats.PowersUsed = slices.Clone(ps.Stats.PowersUsed)
+		for _, ship := range ps.Ships {
+			if ship.IsSunk() {
+				outcome.ShipsLost++
+			}
+		}
+		if ps.Eliminated {
+			outcome.Reason = ps.EliminationReason
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes
 }
 
 func (g *Game) GetWinner() string {
@@ -711,6 +1332,30 @@ func (g *Game) GetPlayerState(playerID string) (*PlayerState, error) {
 	return g.getPlayerState(playerID)
 }
 
+// TurnStartedFor describes the current turn from playerID's perspective.
+func (g *Game) TurnStartedFor(playerID string) *piratesv1.TurnStarted {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	turn := &piratesv1.TurnStarted{
+		YourTurn:        g.CurrentTurn == playerID,
+		BonusTurn:       g.BonusTurn,
+		CurrentPlayerId: g.CurrentTurn,
+	}
+	if ps, err := g.getPlayerState(playerID); err == nil {
+		turn.AvailablePowers = ps.AvailablePowers()
+		turn.Eliminated = ps.Eliminated
+	}
+	if turn.YourTurn {
+		turn.SalvoShots = int32(g.SalvoShots)
+	}
+	if !g.TurnDeadline.IsZero() {
+		turn.DeadlineUnixMs = g.TurnDeadline.UnixMilli()
+	}
+	turn.View, _ = g.viewLocked(playerID)
+	return turn
+}
+
 func (g *Game) GetPlayerPowers(playerID string) []*piratesv1.Power {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
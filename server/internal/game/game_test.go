@@ -1,7 +1,11 @@
 package game
 
 import (
+	"errors"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
 )
@@ -75,17 +79,120 @@ func TestPlaceShipsOverlapping(t *testing.T) {
 	}
 }
 
-func TestBothPlayersReady(t *testing.T) {
+func TestPlaceShipsValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(ships []*piratesv1.Ship)
+		want   string
+	}{
+		{
+			name:   "duplicate id",
+			modify: func(ships []*piratesv1.Ship) { ships[1].Id = "ship-1" },
+			want:   "duplicate ship id ship-1",
+		},
+		{
+			name:   "missing id",
+			modify: func(ships []*piratesv1.Ship) { ships[4].Id = "" },
+			want:   "ship Chaloupe has no id",
+		},
+		{
+			name:   "unknown name",
+			modify: func(ships []*piratesv1.Ship) { ships[2].Name = "Sloop" },
+			want:   `unknown ship "Sloop"`,
+		},
+		{
+			name:   "name placed twice",
+			modify: func(ships []*piratesv1.Ship) { ships[3].Name = "Brick" },
+			want:   "ship Brick is placed more than once",
+		},
+		{
+			name:   "wrong size for name",
+			modify: func(ships []*piratesv1.Ship) { ships[0].Size = 4; ships[1].Size = 5 },
+			want:   "ship Galion must have size 5, got 4",
+		},
+		{
+			name: "overlap names both ships",
+			modify: func(ships []*piratesv1.Ship) {
+				ships[1].Start = &piratesv1.Coordinate{X: 2, Y: 0}
+			},
+			want: "Frégate overlaps Galion at (2, 0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGame("game-1", "player-1", "player-2")
+			ships := createTestShips()
+			tt.modify(ships)
+
+			err := g.PlaceShips("player-1", ships)
+			if !errors.Is(err, ErrInvalidPlacement) {
+				t.Fatalf("expected ErrInvalidPlacement, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error to contain %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestPlaceShipsNoTouch(t *testing.T) {
+	newNoTouchGame := func() *Game {
+		rules := DefaultRules()
+		rules.NoTouch = true
+		return NewGameWithRules("game-1", "player-1", "player-2", rules)
+	}
+
+	t.Run("rejects adjacent ships", func(t *testing.T) {
+		g := newNoTouchGame()
+		err := g.PlaceShips("player-1", createTestShips())
+		if !errors.Is(err, ErrInvalidPlacement) {
+			t.Fatalf("expected ErrInvalidPlacement, got %v", err)
+		}
+		if want := "Galion at (0, 0) touches Frégate at (0, 1)"; !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got %q", want, err.Error())
+		}
+	})
+
+	t.Run("rejects diagonal contact", func(t *testing.T) {
+		g := newNoTouchGame()
+		ships := spacedTestShips()
+		ships[4].Start = &piratesv1.Coordinate{X: 5, Y: 1}
+		err := g.PlaceShips("player-1", ships)
+		if err == nil || !strings.Contains(err.Error(), "Galion at (4, 0) touches Chaloupe at (5, 1)") {
+			t.Errorf("expected diagonal contact error, got %v", err)
+		}
+	})
+
+	t.Run("accepts spaced ships", func(t *testing.T) {
+		g := newNoTouchGame()
+		if err := g.PlaceShips("player-1", spacedTestShips()); err != nil {
+			t.Errorf("expected spaced ships to be accepted, got %v", err)
+		}
+	})
+}
+
+func spacedTestShips() []*piratesv1.Ship {
+	return []*piratesv1.Ship{
+		{Id: "ship-1", Name: "Galion", Size: 5, Start: &piratesv1.Coordinate{X: 0, Y: 0}, Horizontal: true},
+		{Id: "ship-2", Name: "Frégate", Size: 4, Start: &piratesv1.Coordinate{X: 0, Y: 2}, Horizontal: true},
+		{Id: "ship-3", Name: "Brick", Size: 3, Start: &piratesv1.Coordinate{X: 0, Y: 4}, Horizontal: true},
+		{Id: "ship-4", Name: "Corvette", Size: 3, Start: &piratesv1.Coordinate{X: 0, Y: 6}, Horizontal: true},
+		{Id: "ship-5", Name: "Chaloupe", Size: 2, Start: &piratesv1.Coordinate{X: 0, Y: 8}, Horizontal: true},
+	}
+}
+
+func TestAllPlayersReady(t *testing.T) {
 	g := NewGame("game-1", "player-1", "player-2")
 	ships := createTestShips()
 
 	g.PlaceShips("player-1", ships)
-	if g.BothPlayersReady() {
+	if g.AllPlayersReady() {
 		t.Error("should not be ready with only one player")
 	}
 
 	g.PlaceShips("player-2", ships)
-	if !g.BothPlayersReady() {
+	if !g.AllPlayersReady() {
 		t.Error("should be ready with both players")
 	}
 }
@@ -162,6 +269,317 @@ func TestAttackSinkShip(t *testing.T) {
 	}
 }
 
+func TestAttackSinkShipPowersDisabled(t *testing.T) {
+	rules := DefaultRules()
+	rules.Powers = false
+	g := NewGameWithRules("game-1", "player-1", "player-2", rules)
+	ships := createTestShips()
+
+	g.PlaceShips("player-1", ships)
+	g.PlaceShips("player-2", ships)
+	g.StartGame()
+
+	g.Attack("player-1", 0, 4)
+	g.NextTurn()
+	g.Attack("player-2", 5, 5)
+	g.NextTurn()
+
+	result, _ := g.Attack("player-1", 1, 4)
+	if result.SunkShip == nil {
+		t.Fatal("Chaloupe should be sunk")
+	}
+	if result.PowerGained != nil {
+		t.Errorf("expected no power gained, got %v", result.PowerGained)
+	}
+	if len(g.GetPlayerPowers("player-2")) != 0 {
+		t.Error("player-2 should not gain powers when powers are disabled")
+	}
+}
+
+func TestRulesFromProto(t *testing.T) {
+	if got := RulesFromProto(nil); got != DefaultRules() {
+		t.Errorf("expected default rules for nil, got %+v", got)
+	}
+
+	got := RulesFromProto(&piratesv1.GameRules{PowersDisabled: true})
+	if got.Powers {
+		t.Error("expected powers to be disabled")
+	}
+	if got.RuleSet != piratesv1.RuleSet_RULE_SET_CLASSIC {
+		t.Errorf("expected unset rule set to default to classic, got %v", got.RuleSet)
+	}
+
+	t.Run("bounds", func(t *testing.T) {
+		got := RulesFromProto(&piratesv1.GameRules{TurnSeconds: 1, SeriesLength: 4, Unranked: true})
+		if got.TurnTime != MinTurnTime || got.SeriesLength != 5 || got.Ranked {
+			t.Errorf("unexpected rules: %+v", got)
+		}
+		got = RulesFromProto(&piratesv1.GameRules{TurnSeconds: 3600, SeriesLength: 99})
+		if got.TurnTime != MaxTurnTime || got.SeriesLength != MaxSeriesLength {
+			t.Errorf("unexpected rules: %+v", got)
+		}
+		if back := RulesFromProto(got.ToProto()); back != got {
+			t.Errorf("expected %+v to survive a round trip, got %+v", got, back)
+		}
+	})
+}
+
+func TestTimeOutTurn(t *testing.T) {
+	rules := DefaultRules()
+	rules.TurnTime = 30 * time.Second
+	g := NewGameForPlayers("game-1", []string{"player-1", "player-2", "player-3"}, rules)
+	for _, playerID := range g.PlayerIDs {
+		g.PlaceShips(playerID, createTestShips())
+	}
+	g.StartGame()
+
+	deadline := g.GetTurnDeadline()
+	if turn := g.TurnStartedFor("player-2"); turn.DeadlineUnixMs != deadline.UnixMilli() {
+		t.Errorf("expected the deadline in the turn, got %d", turn.DeadlineUnixMs)
+	}
+	if _, ok := g.TimeOutTurn(deadline.Add(-time.Second)); ok {
+		t.Fatal("expected no timeout before the deadline")
+	}
+
+	playerID, ok := g.TimeOutTurn(deadline)
+	if !ok || playerID != "player-1" {
+		t.Fatalf("expected player-1 timed out, got %q", playerID)
+	}
+	if !g.IsEliminated("player-1") || g.GetCurrentTurn() != "player-2" {
+		t.Error("expected player-1 out and the turn passed on")
+	}
+	if !g.GetTurnDeadline().After(deadline) {
+		t.Error("expected a new deadline for player-2")
+	}
+
+	g.TimeOutTurn(g.GetTurnDeadline())
+	if g.GetStatus() != StatusFinished || g.GetWinner() != "player-3" {
+		t.Errorf("expected player-3 to win, got %q", g.GetWinner())
+	}
+	if o := g.Outcomes()[0]; o.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_TURN_TIMEOUT {
+		t.Errorf("unexpected outcome: %+v", o)
+	}
+}
+
+func TestTimeOutPlacement(t *testing.T) {
+	players := []string{"player-1", "player-2", "player-3"}
+
+	t.Run("late players are out and the others play", func(t *testing.T) {
+		g := NewGameForPlayers("game-1", players, DefaultRules())
+		g.PlaceShips("player-2", createTestShips())
+		g.PlaceShips("player-3", createTestShips())
+
+		if late := g.TimeOutPlacement(); len(late) != 1 || late[0] != "player-1" {
+			t.Fatalf("expected player-1 timed out, got %v", late)
+		}
+		if g.GetStatus() == StatusFinished || g.GetCurrentTurn() != "player-2" {
+			t.Errorf("expected player-2 to open the battle, got %q", g.GetCurrentTurn())
+		}
+		if late := g.TimeOutPlacement(); late != nil {
+			t.Errorf("expected nothing to do once the battle started, got %v", late)
+		}
+	})
+
+	t.Run("a single side left wins", func(t *testing.T) {
+		g := NewGameForPlayers("game-1", players, DefaultRules())
+		g.PlaceShips("player-3", createTestShips())
+
+		g.TimeOutPlacement()
+		if g.GetStatus() != StatusFinished || g.GetWinner() != "player-3" {
+			t.Errorf("expected player-3 to win, got %q", g.GetWinner())
+		}
+		if over := g.GameOverFor("player-1"); over.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_PLACEMENT_TIMEOUT {
+			t.Errorf("expected PLACEMENT_TIMEOUT, got %v", over.Reason)
+		}
+	})
+
+	t.Run("nobody ready ends the game without a winner", func(t *testing.T) {
+		g := NewGameForPlayers("game-1", players, DefaultRules())
+
+		if late := g.TimeOutPlacement(); len(late) != 3 {
+			t.Fatalf("expected every player timed out, got %v", late)
+		}
+		if g.GetStatus() != StatusFinished || g.GetWinner() != "" {
+			t.Errorf("expected no winner, got %q", g.GetWinner())
+		}
+	})
+}
+
+func newSalvoGame(shots int) *Game {
+	rules := DefaultRules()
+	rules.RuleSet = piratesv1.RuleSet_RULE_SET_SALVO
+	rules.SalvoShots = shots
+	g := NewGameWithRules("game-1", "player-1", "player-2", rules)
+	g.PlaceShips("player-1", createTestShips())
+	g.PlaceShips("player-2", createTestShips())
+	g.StartGame()
+	return g
+}
+
+func coords(xy ...int) []*piratesv1.Coordinate {
+	var targets []*piratesv1.Coordinate
+	for i := 0; i+1 < len(xy); i += 2 {
+		targets = append(targets, &piratesv1.Coordinate{X: int32(xy[i]), Y: int32(xy[i+1])})
+	}
+	return targets
+}
+
+func TestAttackSalvo(t *testing.T) {
+	t.Run("one shot per surviving ship by default", func(t *testing.T) {
+		g := newSalvoGame(0)
+		if g.SalvoShots != 5 {
+			t.Fatalf("expected 5 salvo shots, got %d", g.SalvoShots)
+		}
+
+		result, err := g.AttackSalvo("player-1", coords(0, 0, 9, 9, 9, 8, 9, 7, 9, 6))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Shots) != 5 {
+			t.Errorf("expected 5 shot results, got %d", len(result.Shots))
+		}
+		if !result.Shots[0].Hit || result.Shots[1].Hit {
+			t.Error("expected first shot to hit and second to miss")
+		}
+	})
+
+	t.Run("single attacks are rejected", func(t *testing.T) {
+		g := newSalvoGame(0)
+		if _, err := g.Attack("player-1", 0, 0); err != ErrSalvoRequired {
+			t.Errorf("expected ErrSalvoRequired, got %v", err)
+		}
+	})
+
+	t.Run("invalid salvo fires nothing", func(t *testing.T) {
+		g := newSalvoGame(2)
+
+		if _, err := g.AttackSalvo("player-1", coords(0, 0)); !errors.Is(err, ErrWrongSalvoSize) {
+			t.Errorf("expected ErrWrongSalvoSize, got %v", err)
+		}
+		if _, err := g.AttackSalvo("player-1", coords(0, 0, 0, 0)); err != ErrDuplicateTarget {
+			t.Errorf("expected ErrDuplicateTarget, got %v", err)
+		}
+		if _, err := g.AttackSalvo("player-1", coords(0, 0, 10, 0)); err != ErrInvalidTarget {
+			t.Errorf("expected ErrInvalidTarget, got %v", err)
+		}
+		if g.Player2State.Grid[0][0].Hit {
+			t.Error("expected rejected salvo to leave the grid untouched")
+		}
+	})
+
+	t.Run("several sinks in one salvo grant stacked powers", func(t *testing.T) {
+		g := newSalvoGame(6)
+
+		// Brick and Corvette, both size 3
+		result, err := g.AttackSalvo("player-1", coords(0, 2, 1, 2, 2, 2, 0, 3, 1, 3, 2, 3))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.SunkShips) != 2 {
+			t.Errorf("expected 2 sunk ships, got %d", len(result.SunkShips))
+		}
+		if len(result.PowersGained) != 2 {
+			t.Errorf("expected 2 powers gained, got %d", len(result.PowersGained))
+		}
+
+		triples := 0
+		for _, p := range g.GetPlayerPowers("player-2") {
+			if p.Type == piratesv1.PowerType_POWER_TYPE_TRIPLE {
+				triples++
+			}
+		}
+		if triples != 2 {
+			t.Errorf("expected 2 stacked Triple powers, got %d", triples)
+		}
+	})
+
+	t.Run("next turn counts the new player's surviving ships", func(t *testing.T) {
+		g := newSalvoGame(0)
+
+		// Sink player-2's Chaloupe
+		g.AttackSalvo("player-1", coords(0, 4, 1, 4, 9, 9, 9, 8, 9, 7))
+		g.NextTurn()
+
+		if g.SalvoShots != 4 {
+			t.Errorf("expected 4 salvo shots for player-2, got %d", g.SalvoShots)
+		}
+		if turn := g.TurnStartedFor("player-2"); turn.SalvoShots != 4 {
+			t.Errorf("expected TurnStarted to announce 4 shots, got %d", turn.SalvoShots)
+		}
+	})
+}
+
+func newBonusGame(rule piratesv1.BonusTurn, maxBonusTurns int) *Game {
+	rules := DefaultRules()
+	rules.BonusTurn = rule
+	rules.MaxBonusTurns = maxBonusTurns
+	g := NewGameWithRules("game-1", "player-1", "player-2", rules)
+	g.PlaceShips("player-1", createTestShips())
+	g.PlaceShips("player-2", createTestShips())
+	g.StartGame()
+	return g
+}
+
+func TestFinishTurn(t *testing.T) {
+	t.Run("without bonus rule the turn passes", func(t *testing.T) {
+		g := newBonusGame(piratesv1.BonusTurn_BONUS_TURN_UNSPECIFIED, 0)
+		g.Attack("player-1", 0, 0)
+		g.FinishTurn()
+		if g.CurrentTurn != "player-2" {
+			t.Errorf("expected player-2's turn, got %s", g.CurrentTurn)
+		}
+	})
+
+	t.Run("hit plays again", func(t *testing.T) {
+		g := newBonusGame(piratesv1.BonusTurn_BONUS_TURN_ON_HIT, 0)
+		g.Attack("player-1", 0, 0)
+		g.FinishTurn()
+		if g.CurrentTurn != "player-1" || !g.BonusTurn {
+			t.Fatal("expected a bonus turn for player-1")
+		}
+		if !g.TurnStartedFor("player-1").BonusTurn {
+			t.Error("expected TurnStarted to signal the bonus turn")
+		}
+
+		g.Attack("player-1", 9, 9)
+		g.FinishTurn()
+		if g.CurrentTurn != "player-2" || g.BonusTurn {
+			t.Error("expected a miss to pass the turn")
+		}
+	})
+
+	t.Run("sink rule ignores plain hits", func(t *testing.T) {
+		g := newBonusGame(piratesv1.BonusTurn_BONUS_TURN_ON_SINK, 0)
+		g.Attack("player-1", 0, 4)
+		g.FinishTurn()
+		if g.CurrentTurn != "player-2" {
+			t.Fatal("expected a plain hit to pass the turn")
+		}
+
+		g.Attack("player-2", 9, 9)
+		g.FinishTurn()
+		g.Attack("player-1", 1, 4) // Sinks the Chaloupe
+		g.FinishTurn()
+		if g.CurrentTurn != "player-1" {
+			t.Error("expected a sink to play again")
+		}
+	})
+
+	t.Run("consecutive bonus turns are capped", func(t *testing.T) {
+		g := newBonusGame(piratesv1.BonusTurn_BONUS_TURN_ON_HIT, 2)
+		for x := 0; x < 3; x++ {
+			if g.CurrentTurn != "player-1" {
+				t.Fatalf("expected player-1 to still play on shot %d", x+1)
+			}
+			g.Attack("player-1", x, 0)
+			g.FinishTurn()
+		}
+		if g.CurrentTurn != "player-2" {
+			t.Error("expected the turn to pass after 2 bonus turns")
+		}
+	})
+}
+
 func TestCheckVictory(t *testing.T) {
 	g := NewGame("game-1", "player-1", "player-2")
 
@@ -183,13 +601,18 @@ func TestCheckVictory(t *testing.T) {
 		ship.Hits = ship.Size
 	}
 
-	gameOver := g.CheckVictory()
-	if gameOver == nil {
-		t.Error("game should be over")
+	if !g.CheckVictory() {
+		t.Fatal("game should be over")
 	}
-	if !gameOver.YouWon {
+	if !g.GameOverFor("player-1").YouWon {
 		t.Error("player-1 should have won")
 	}
+	if g.GameOverFor("player-2").YouWon {
+		t.Error("player-2 should have lost")
+	}
+	if g.EndReason != piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK {
+		t.Errorf("expected ALL_SHIPS_SUNK, got %v", g.EndReason)
+	}
 }
 
 func TestGetOpponentID(t *testing.T) {
@@ -211,11 +634,610 @@ func TestForfeit(t *testing.T) {
 	g.PlaceShips("player-2", ships)
 	g.StartGame()
 
-	gameOver := g.Forfeit("player-1")
-	if gameOver.Reason != "opponent_forfeit" {
-		t.Errorf("expected opponent_forfeit, got %s", gameOver.Reason)
+	if err := g.Forfeit("player-1"); err != nil {
+		t.Fatalf("Forfeit failed: %v", err)
+	}
+	gameOver := g.GameOverFor("player-2")
+	if gameOver.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT {
+		t.Errorf("expected FORFEIT, got %v", gameOver.Reason)
 	}
 	if g.Winner != "player-2" {
 		t.Error("player-2 should be the winner")
 	}
 }
+
+func TestOutcomes(t *testing.T) {
+	g := NewGameForPlayers("game-1", []string{"player-1", "player-2", "player-3"}, DefaultRules())
+	for _, id := range g.PlayerIDs {
+		g.PlaceShips(id, createTestShips())
+	}
+	g.StartGame()
+
+	g.Forfeit("player-2")
+	// Player 1 sinks player 3's Galion while player 3 misses.
+	for x := 0; x < 5; x++ {
+		g.Attack("player-1", x, 0)
+		g.FinishTurn()
+		g.Attack("player-3", 9, x)
+		g.FinishTurn()
+	}
+	g.Leave("player-3", piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT)
+
+	outcomes := g.Outcomes()
+	if len(outcomes) != 3 {
+		t.Fatalf("expected 3 outcomes, got %d", len(outcomes))
+	}
+	if o := outcomes[0]; !o.Won || o.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT || o.Stats.ShotsFired != 5 {
+		t.Errorf("unexpected winner outcome: %+v", o)
+	}
+	if o := outcomes[1]; o.Won || o.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT {
+		t.Errorf("unexpected outcome for player-2: %+v", o)
+	}
+	if o := outcomes[2]; o.Won || o.Reason != piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT || o.Team != 2 || o.ShipsLost != 1 {
+		t.Errorf("unexpected outcome for player-3: %+v", o)
+	}
+}
+
+func TestForfeitInvalidPlayer(t *testing.T) {
+	g := NewGame("game-1", "player-1", "player-2")
+
+	if err := g.Forfeit("invalid-player"); err != ErrInvalidPlayer {
+		t.Errorf("expected ErrInvalidPlayer, got %v", err)
+	}
+	if g.GetStatus() == StatusFinished {
+		t.Error("game should not be finished")
+	}
+}
+
+func TestGameOverSummary(t *testing.T) {
+	g := NewGame("game-1", "player-1", "player-2")
+	ships := createTestShips()
+
+	g.PlaceShips("player-1", ships)
+	g.PlaceShips("player-2", ships)
+	g.StartGame()
+
+	g.Attack("player-1", 0, 4) // hit Chaloupe
+	g.NextTurn()
+	g.Attack("player-2", 9, 9) // miss
+	g.NextTurn()
+	g.Attack("player-1", 1, 4) // sink Chaloupe
+	g.NextTurn()
+	g.Attack("player-2", 9, 8) // miss
+	g.NextTurn()
+	g.Attack("player-1", 9, 9) // miss
+
+	g.Forfeit("player-2")
+
+	gameOver := g.GameOverFor("player-1")
+	if !gameOver.YouWon {
+		t.Error("player-1 should have won")
+	}
+
+	summary := gameOver.Summary
+	if summary == nil {
+		t.Fatal("expected a summary")
+	}
+	if len(summary.YourFleet) != 5 || len(summary.OpponentFleet) != 5 {
+		t.Fatalf("expected both fleets revealed, got %d and %d ships", len(summary.YourFleet), len(summary.OpponentFleet))
+	}
+
+	stats := summary.YourStats
+	if stats.ShotsFired != 3 || stats.Hits != 2 || stats.ShipsSunk != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	if stats.Accuracy < 0.66 || stats.Accuracy > 0.67 {
+		t.Errorf("expected accuracy 2/3, got %f", stats.Accuracy)
+	}
+	if summary.OpponentStats.ShotsFired != 2 || summary.OpponentStats.Hits != 0 {
+		t.Errorf("unexpected opponent stats: %+v", summary.OpponentStats)
+	}
+
+	sunk := 0
+	for _, fs := range summary.OpponentFleet {
+		if fs.Sunk {
+			sunk++
+			if fs.Ship.Name != "Chaloupe" {
+				t.Errorf("expected Chaloupe to be sunk, got %s", fs.Ship.Name)
+			}
+		}
+	}
+	if sunk != 1 {
+		t.Errorf("expected 1 sunk ship in opponent fleet, got %d", sunk)
+	}
+}
+
+func newDefensiveGame() *Game {
+	rules := DefaultRules()
+	rules.DefensivePowers = true
+	g := NewGameWithRules("game-1", "player-1", "player-2", rules)
+	g.PlaceShips("player-1", createTestShips())
+	g.PlaceShips("player-2", createTestShips())
+	g.StartGame()
+	return g
+}
+
+func TestDefensivePowers(t *testing.T) {
+	shield := piratesv1.PowerType_POWER_TYPE_SHIELD
+	repair := piratesv1.PowerType_POWER_TYPE_REPAIR
+
+	t.Run("granted by the rule", func(t *testing.T) {
+		g := newDefensiveGame()
+		powers := g.GetPlayerPowers("player-2")
+		if len(powers) != 3 || powers[0].Type != shield || powers[1].Type != repair ||
+			powers[2].Type != piratesv1.PowerType_POWER_TYPE_DECOY {
+			t.Errorf("expected a Shield, a Repair and a Decoy, got %v", powers)
+		}
+	})
+
+	t.Run("granted once per team", func(t *testing.T) {
+		rules := DefaultRules()
+		rules.DefensivePowers = true
+		rules.Teams = true
+		g := NewGameForPlayers("game-1", []string{"a-1", "b-1", "a-2", "b-2"}, rules)
+		for _, playerID := range g.PlayerIDs {
+			if powers := g.GetPlayerPowers(playerID); len(powers) != 3 {
+				t.Errorf("expected %s's team to share 3 powers, got %v", playerID, powers)
+			}
+		}
+	})
+
+	t.Run("a teammate's power comes out of the team's pool", func(t *testing.T) {
+		rules := DefaultRules()
+		rules.DefensivePowers = true
+		rules.Teams = true
+		g := NewGameForPlayers("game-1", []string{"a-1", "b-1", "a-2", "b-2"}, rules)
+		for _, playerID := range g.PlayerIDs {
+			g.PlaceShips(playerID, createTestShips())
+		}
+		g.StartGame()
+		g.NextTurn()
+		g.NextTurn()
+		if _, err := g.UsePower("a-2", shield, 1, 1, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+
+		for _, playerID := range []string{"a-1", "a-2"} {
+			powers := g.GetPlayerPowers(playerID)
+			if len(powers) != 2 || slices.ContainsFunc(powers, func(p *piratesv1.Power) bool { return p.Type == shield }) {
+				t.Errorf("expected %s's team to have spent its shield, got %v", playerID, powers)
+			}
+		}
+		if powers := g.GetPlayerPowers("b-1"); len(powers) != 3 {
+			t.Errorf("expected the other team to keep 3 powers, got %v", powers)
+		}
+	})
+
+	t.Run("shield absorbs the next action then falls", func(t *testing.T) {
+		g := newDefensiveGame()
+		g.NextTurn()
+		if _, err := g.UsePower("player-2", shield, 1, 1, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if _, err := g.UsePower("player-2", shield, 5, 5, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); err != ErrPowerNotAvailable {
+			t.Errorf("expected the only shield to be used up, got %v", err)
+		}
+		g.NextTurn()
+
+		result, err := g.Attack("player-1", 0, 0)
+		if err != nil {
+			t.Fatalf("Attack failed: %v", err)
+		}
+		if !result.Shielded || result.Hit || g.Player2State.Grid[0][0].Hit {
+			t.Errorf("expected the shield to absorb the shot, got %v", result)
+		}
+		if g.Player2State.Shield != nil {
+			t.Error("expected the shield to fall after absorbing a shot")
+		}
+
+		g.NextTurn()
+		g.NextTurn()
+		if result, _ := g.Attack("player-1", 0, 0); !result.Hit {
+			t.Errorf("expected the cell to be exposed again, got %v", result)
+		}
+	})
+
+	t.Run("shield absorbs the covered part of a power", func(t *testing.T) {
+		g := newDefensiveGame()
+		g.Player2State.Shield = &Coordinate{X: 1, Y: 1}
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, 2, 1, piratesv1.Orientation_ORIENTATION_EAST)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		states := []piratesv1.CellState{
+			piratesv1.CellState_CELL_STATE_SHIELDED,
+			piratesv1.CellState_CELL_STATE_SHIELDED,
+			piratesv1.CellState_CELL_STATE_HIT,
+		}
+		for i, cell := range result.CellsAffected {
+			if cell.State != states[i] {
+				t.Errorf("cell %d: expected %v, got %v", i, states[i], cell.State)
+			}
+		}
+		if g.Player2State.Shield != nil {
+			t.Error("expected the shield to fall")
+		}
+	})
+
+	t.Run("shield position is hidden from the opponent", func(t *testing.T) {
+		g := newDefensiveGame()
+		g.NextTurn()
+		result, err := g.UsePower("player-2", shield, 4, 4, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if !result.OwnGrid || len(result.CellsAffected) != 9 {
+			t.Errorf("expected the 3x3 shielded area, got %v", result)
+		}
+		if view := opponentViewOfPower(result); len(view.CellsAffected) != 0 {
+			t.Errorf("expected the opponent not to see the shield, got %v", view.CellsAffected)
+		}
+	})
+
+	t.Run("repair restores a hit cell", func(t *testing.T) {
+		g := newDefensiveGame()
+		g.Attack("player-1", 1, 0)
+		g.NextTurn()
+
+		result, err := g.UsePower("player-2", repair, 1, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if result.CellsAffected[0].State != piratesv1.CellState_CELL_STATE_REPAIRED {
+			t.Errorf("expected a repaired cell, got %v", result.CellsAffected)
+		}
+		if g.Player2State.Grid[1][0].Hit || g.Player2State.Ships["ship-1"].Hits != 0 {
+			t.Error("expected the Galion to be intact again")
+		}
+	})
+
+	t.Run("repair rejects undamaged, sunk and mined cells", func(t *testing.T) {
+		g := newDefensiveGame()
+		g.Player2State.Ships["ship-5"].Hits = 2
+		for _, c := range g.Player2State.Ships["ship-5"].Cells {
+			g.Player2State.Grid[c.X][c.Y].Hit = true
+			g.Player2State.Grid[c.X][c.Y].Sunk = true
+		}
+		g.Player2State.Grid[0][3].Hit = true
+		g.Player2State.Grid[0][3].MineDamage = true
+		g.NextTurn()
+
+		for _, c := range []Coordinate{{X: 9, Y: 9}, {X: 0, Y: 0}, {X: 0, Y: 4}, {X: 0, Y: 3}} {
+			if _, err := g.UsePower("player-2", repair, c.X, c.Y, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); !errors.Is(err, ErrNothingToRepair) {
+				t.Errorf("repair at %v: expected ErrNothingToRepair, got %v", c, err)
+			}
+		}
+	})
+}
+
+func TestDecoy(t *testing.T) {
+	decoy := piratesv1.PowerType_POWER_TYPE_DECOY
+
+	// newDecoyGame places player 2's decoy at (9, 9) and hands the turn to
+	// player 1.
+	newDecoyGame := func(t *testing.T) *Game {
+		g := newDefensiveGame()
+		g.NextTurn()
+		result, err := g.UsePower("player-2", decoy, 9, 9, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if view := opponentViewOfPower(result); len(view.CellsAffected) != 0 {
+			t.Errorf("expected the decoy's position to be hidden, got %v", view.CellsAffected)
+		}
+		g.NextTurn()
+		return g
+	}
+
+	t.Run("only on an empty cell", func(t *testing.T) {
+		g := newDefensiveGame()
+		g.NextTurn()
+		if _, err := g.UsePower("player-2", decoy, 0, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); !errors.Is(err, ErrInvalidTarget) {
+			t.Errorf("expected ErrInvalidTarget on a ship, got %v", err)
+		}
+	})
+
+	t.Run("shows as a ship to sonar", func(t *testing.T) {
+		g := newDecoyGame(t)
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_SONAR] = 1
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_SONAR, 9, 8, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		for _, cell := range result.CellsAffected {
+			if cell.Position.X == 9 && cell.Position.Y == 9 && cell.State != piratesv1.CellState_CELL_STATE_REVEALED {
+				t.Errorf("expected the decoy to be revealed as a ship, got %v", cell.State)
+			}
+		}
+	})
+
+	t.Run("shows as a hit and never sinks", func(t *testing.T) {
+		g := newDecoyGame(t)
+		result, err := g.Attack("player-1", 9, 9)
+		if err != nil {
+			t.Fatalf("Attack failed: %v", err)
+		}
+		if !result.Hit || result.SunkShip != nil {
+			t.Errorf("expected a plain hit, got %v", result)
+		}
+	})
+
+	t.Run("does not count toward victory", func(t *testing.T) {
+		g := newDecoyGame(t)
+		for _, ship := range g.Player2State.Ships {
+			ship.Hits = ship.Size
+		}
+		if !g.Player2State.AllShipsSunk() {
+			t.Error("expected the fleet to be sunk despite the decoy")
+		}
+		if !g.CheckVictory() || g.GetWinner() != "player-1" {
+			t.Error("expected player 1 to win")
+		}
+		decoys := g.GameOverFor("player-1").Summary.OpponentDecoys
+		if len(decoys) != 1 || decoys[0].X != 9 || decoys[0].Y != 9 {
+			t.Errorf("expected the decoy to be unmasked in the summary, got %v", decoys)
+		}
+	})
+}
+
+func newFreeForAll() *Game {
+	g := NewGameForPlayers("game-1", []string{"player-1", "player-2", "player-3"}, DefaultRules())
+	for _, playerID := range g.PlayerIDs {
+		g.PlaceShips(playerID, createTestShips())
+	}
+	g.StartGame()
+	return g
+}
+
+// sinkFleet sinks every ship of ps but one cell of the Chaloupe at (1, 4).
+func sinkFleet(ps *PlayerState) {
+	for _, ship := range ps.Ships {
+		for _, c := range ship.Cells {
+			if c != (Coordinate{X: 1, Y: 4}) {
+				ps.Grid[c.X][c.Y].Hit = true
+				ship.Hits++
+			}
+		}
+	}
+}
+
+func TestFreeForAll(t *testing.T) {
+	t.Run("turns go around the table", func(t *testing.T) {
+		g := newFreeForAll()
+		for _, want := range []string{"player-1", "player-2", "player-3", "player-1"} {
+			if g.CurrentTurn != want {
+				t.Fatalf("expected %s to play, got %s", want, g.CurrentTurn)
+			}
+			g.NextTurn()
+		}
+	})
+
+	t.Run("attacks hit the chosen opponent", func(t *testing.T) {
+		g := newFreeForAll()
+		result, err := g.AttackOn("player-1", "player-3", 0, 0)
+		if err != nil || !result.Hit {
+			t.Fatalf("expected a hit, got %v, %v", result, err)
+		}
+		p2, _ := g.GetPlayerState("player-2")
+		p3, _ := g.GetPlayerState("player-3")
+		if p2.Grid[0][0].Hit || !p3.Grid[0][0].Hit {
+			t.Error("expected only player-3's grid to be hit")
+		}
+	})
+
+	t.Run("an empty target is the next opponent", func(t *testing.T) {
+		g := newFreeForAll()
+		g.Attack("player-1", 0, 0)
+		if p2, _ := g.GetPlayerState("player-2"); !p2.Grid[0][0].Hit {
+			t.Error("expected player-2's grid to be hit")
+		}
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		g := newFreeForAll()
+		if _, err := g.AttackOn("player-1", "player-1", 0, 0); err != ErrInvalidOpponent {
+			t.Errorf("expected ErrInvalidOpponent for yourself, got %v", err)
+		}
+		if _, err := g.AttackOn("player-1", "nobody", 0, 0); err != ErrInvalidOpponent {
+			t.Errorf("expected ErrInvalidOpponent for a stranger, got %v", err)
+		}
+	})
+
+	t.Run("sunk fleets are eliminated and skipped", func(t *testing.T) {
+		g := newFreeForAll()
+		p2, _ := g.GetPlayerState("player-2")
+		sinkFleet(p2)
+
+		result, _ := g.AttackOn("player-1", "player-2", 1, 4)
+		if result.SunkShip == nil {
+			t.Fatal("expected the last ship to sink")
+		}
+		if got := g.EliminateSunkFleets(); len(got) != 1 || got[0] != "player-2" {
+			t.Fatalf("expected player-2 eliminated, got %v", got)
+		}
+		if g.CheckVictory() {
+			t.Fatal("the game should go on with two players left")
+		}
+		g.FinishTurn()
+		if g.CurrentTurn != "player-3" {
+			t.Errorf("expected player-3 to play, got %s", g.CurrentTurn)
+		}
+		if _, err := g.AttackOn("player-3", "player-2", 5, 5); err != ErrPlayerEliminated {
+			t.Errorf("expected ErrPlayerEliminated, got %v", err)
+		}
+		if turn := g.TurnStartedFor("player-2"); !turn.Eliminated || turn.CurrentPlayerId != "player-3" {
+			t.Errorf("expected player-2 to spectate player-3's turn, got %+v", turn)
+		}
+	})
+
+	t.Run("powers go to the owner of the sunk ship", func(t *testing.T) {
+		g := newFreeForAll()
+		g.AttackOn("player-1", "player-3", 0, 4)
+		g.AttackOn("player-1", "player-3", 1, 4)
+
+		for playerID, want := range map[string]int{"player-1": 0, "player-2": 0, "player-3": 1} {
+			ps, _ := g.GetPlayerState(playerID)
+			if got := ps.Powers[piratesv1.PowerType_POWER_TYPE_INSTAKILL]; got != want {
+				t.Errorf("expected %s to have %d instakill, got %d", playerID, want, got)
+			}
+		}
+	})
+
+	t.Run("leaving passes the turn on", func(t *testing.T) {
+		g := newFreeForAll()
+		if err := g.Forfeit("player-1"); err != nil {
+			t.Fatalf("Forfeit failed: %v", err)
+		}
+		if g.GetStatus() == StatusFinished {
+			t.Fatal("the game should go on with two players left")
+		}
+		if g.CurrentTurn != "player-2" {
+			t.Errorf("expected player-2 to play, got %s", g.CurrentTurn)
+		}
+	})
+
+	t.Run("a player out cannot forfeit again", func(t *testing.T) {
+		g := newFreeForAll()
+		g.Forfeit("player-3")
+		if err := g.Forfeit("player-3"); err != ErrPlayerEliminated {
+			t.Errorf("expected ErrPlayerEliminated, got %v", err)
+		}
+		if err := g.Leave("player-3", piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT); err != nil {
+			t.Errorf("Leave failed: %v", err)
+		}
+		if ps, _ := g.GetPlayerState("player-3"); ps.EliminationReason != piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT {
+			t.Errorf("expected the forfeit to stand, got %v", ps.EliminationReason)
+		}
+		if g.GetStatus() == StatusFinished || g.CurrentTurn != "player-1" {
+			t.Errorf("expected player-1 still to play, got %s", g.CurrentTurn)
+		}
+	})
+
+	t.Run("the last player standing wins", func(t *testing.T) {
+		g := newFreeForAll()
+		g.Forfeit("player-2")
+		p3, _ := g.GetPlayerState("player-3")
+		sinkFleet(p3)
+		g.AttackOn("player-1", "player-3", 1, 4)
+
+		if !g.CheckVictory() || g.Winner != "player-1" {
+			t.Fatalf("expected player-1 to win, got %q", g.Winner)
+		}
+		gameOver := g.GameOverFor("player-3")
+		if gameOver.WinnerId != "player-1" || len(gameOver.Summary.Opponents) != 2 {
+			t.Errorf("unexpected game over: %+v", gameOver)
+		}
+		if len(gameOver.Summary.OpponentFleet) != 5 || gameOver.Summary.Opponents[0].PlayerId != "player-1" {
+			t.Error("expected the summary to describe the winner first")
+		}
+	})
+
+	t.Run("views show every opponent", func(t *testing.T) {
+		g := newFreeForAll()
+		g.AttackOn("player-1", "player-3", 0, 0)
+
+		view, _ := g.View("player-2")
+		if len(view.Opponents) != 2 || view.Opponents[0].PlayerId != "player-3" || view.Opponents[1].PlayerId != "player-1" {
+			t.Fatalf("unexpected opponents: %v", view.Opponents)
+		}
+		if cells := view.Opponents[0].Board.Cells; len(cells) != 1 || cells[0].State != piratesv1.CellState_CELL_STATE_HIT {
+			t.Errorf("expected player-2 to see the hit on player-3, got %v", cells)
+		}
+	})
+}
+
+func newTeamGame() *Game {
+	rules := DefaultRules()
+	rules.Teams = true
+	g := NewGameForPlayers("game-1", []string{"a-1", "b-1", "a-2", "b-2"}, rules)
+	for _, playerID := range g.PlayerIDs {
+		g.PlaceShips(playerID, createTestShips())
+	}
+	g.StartGame()
+	return g
+}
+
+func TestTeams(t *testing.T) {
+	t.Run("teammates take turns", func(t *testing.T) {
+		g := newTeamGame()
+		for _, want := range []string{"a-1", "b-1", "a-2", "b-2", "a-1"} {
+			if g.CurrentTurn != want {
+				t.Fatalf("expected %s to play, got %s", want, g.CurrentTurn)
+			}
+			g.NextTurn()
+		}
+	})
+
+	t.Run("allies cannot be attacked", func(t *testing.T) {
+		g := newTeamGame()
+		if _, err := g.AttackOn("a-1", "a-2", 0, 0); err != ErrInvalidOpponent {
+			t.Errorf("expected ErrInvalidOpponent, got %v", err)
+		}
+		if _, err := g.AttackOn("a-1", "b-2", 0, 0); err != nil {
+			t.Errorf("expected the attack on b-2 to succeed, got %v", err)
+		}
+	})
+
+	t.Run("eliminated teammates are skipped", func(t *testing.T) {
+		g := newTeamGame()
+		g.Forfeit("b-1")
+		g.NextTurn()
+		for _, want := range []string{"b-2", "a-2", "b-2", "a-1"} {
+			if g.CurrentTurn != want {
+				t.Fatalf("expected %s to play, got %s", want, g.CurrentTurn)
+			}
+			g.NextTurn()
+		}
+	})
+
+	t.Run("teammates share their powers", func(t *testing.T) {
+		g := newTeamGame()
+		g.AttackOn("a-1", "b-1", 0, 4)
+		g.AttackOn("a-1", "b-1", 1, 4)
+
+		b2, _ := g.GetPlayerState("b-2")
+		if got := b2.Powers[piratesv1.PowerType_POWER_TYPE_INSTAKILL]; got != 1 {
+			t.Errorf("expected b-2 to share b-1's instakill, got %d", got)
+		}
+		a2, _ := g.GetPlayerState("a-2")
+		if got := a2.Powers[piratesv1.PowerType_POWER_TYPE_INSTAKILL]; got != 0 {
+			t.Errorf("expected a-2 to have no instakill, got %d", got)
+		}
+	})
+
+	t.Run("the game goes on while a teammate stands", func(t *testing.T) {
+		g := newTeamGame()
+		g.Forfeit("b-1")
+		if g.GetStatus() == StatusFinished {
+			t.Fatal("the game should go on while b-2 stands")
+		}
+		g.Forfeit("b-2")
+		if g.GetStatus() != StatusFinished || g.Winner != "a-1" {
+			t.Fatalf("expected team a to win, got %q", g.Winner)
+		}
+		for playerID, want := range map[string]bool{"a-1": true, "a-2": true, "b-1": false, "b-2": false} {
+			if got := g.GameOverFor(playerID).YouWon; got != want {
+				t.Errorf("expected YouWon %v for %s, got %v", want, playerID, got)
+			}
+		}
+		if summary := g.GameOverFor("a-2").Summary; len(summary.Allies) != 1 || len(summary.Opponents) != 2 {
+			t.Errorf("unexpected summary: %+v", summary)
+		}
+	})
+
+	t.Run("views show the allies' boards", func(t *testing.T) {
+		g := newTeamGame()
+		view, _ := g.View("a-1")
+		if len(view.Allies) != 1 || view.Allies[0].PlayerId != "a-2" {
+			t.Fatalf("unexpected allies: %v", view.Allies)
+		}
+		if len(view.Allies[0].Board.Ships) != 5 {
+			t.Errorf("expected a-2's ships to be visible, got %v", view.Allies[0].Board.Ships)
+		}
+		if len(view.Opponents) != 2 || view.Opponents[0].PlayerId != "b-1" || view.Opponents[1].PlayerId != "b-2" {
+			t.Errorf("unexpected opponents: %v", view.Opponents)
+		}
+	})
+}
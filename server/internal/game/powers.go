@@ -0,0 +1,424 @@
+package game
+
+import (
+	"slices"
+	"strings"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// PowerEffect is what a power does to the cells it reaches.
+type PowerEffect int
+
+const (
+	// EffectDamage fires at every cell of the pattern not hit yet.
+	EffectDamage PowerEffect = iota
+	// EffectReveal shows what the cells hold without damaging them.
+	EffectReveal
+	// EffectInstakill fires like EffectDamage, and a ship it hits sinks
+	// whole.
+	EffectInstakill
+	// EffectShield raises a shield over the pattern of the user's own grid.
+	EffectShield
+	// EffectRepair restores a hit cell of the user's own fleet.
+	EffectRepair
+	// EffectDecoy places a decoy on the user's own grid.
+	EffectDecoy
+)
+
+// PowerDefinition describes a power: the cells it reaches around its target
+// and what it does to them.
+type PowerDefinition struct {
+	Name   string
+	Effect PowerEffect
+	// Pattern lists the reached cells as offsets from the target, in the
+	// order they resolve. Cells off the grid are dropped.
+	Pattern []Coordinate
+	// Orientable powers can be turned by the player. Pattern is written
+	// facing east and turned to the requested Orientation.
+	Orientable bool
+}
+
+// Defensive reports whether the power works on the user's own grid.
+func (d PowerDefinition) Defensive() bool {
+	switch d.Effect {
+	case EffectShield, EffectRepair, EffectDecoy:
+		return true
+	default:
+		return false
+	}
+}
+
+// Powers is the registry of power definitions. A power missing from it
+// cannot be used.
+var Powers = map[piratesv1.PowerType]PowerDefinition{
+	piratesv1.PowerType_POWER_TYPE_INSTAKILL: {
+		Name:    "Instakill",
+		Effect:  EffectInstakill,
+		Pattern: single(),
+	},
+	piratesv1.PowerType_POWER_TYPE_TRIPLE: {
+		Name:       "Triple",
+		Effect:     EffectDamage,
+		Pattern:    []Coordinate{{X: -1}, {}, {X: 1}},
+		Orientable: true,
+	},
+	piratesv1.PowerType_POWER_TYPE_SONAR: {
+		Name:   "Sonar",
+		Effect: EffectReveal,
+		Pattern: append(cross(5),
+			Coordinate{X: -1, Y: -1}, Coordinate{X: 1, Y: -1},
+			Coordinate{X: -1, Y: 1}, Coordinate{X: 1, Y: 1}),
+	},
+	piratesv1.PowerType_POWER_TYPE_KRAKEN: {
+		Name:    "Kraken",
+		Effect:  EffectDamage,
+		Pattern: cross(1),
+	},
+	piratesv1.PowerType_POWER_TYPE_SHIELD: {
+		Name:    "Shield",
+		Effect:  EffectShield,
+		Pattern: square(1),
+	},
+	piratesv1.PowerType_POWER_TYPE_REPAIR: {
+		Name:    "Repair",
+		Effect:  EffectRepair,
+		Pattern: single(),
+	},
+	piratesv1.PowerType_POWER_TYPE_DECOY: {
+		Name:    "Decoy",
+		Effect:  EffectDecoy,
+		Pattern: single(),
+	},
+}
+
+// single is the pattern of the target cell alone.
+func single() []Coordinate {
+	return []Coordinate{{}}
+}
+
+// cross is the target cell and the cells up to radius away from it in the
+// four directions, nearest first.
+func cross(radius int) []Coordinate {
+	pattern := single()
+	for i := 1; i <= radius; i++ {
+		pattern = append(pattern,
+			Coordinate{X: -i}, Coordinate{X: i},
+			Coordinate{Y: -i}, Coordinate{Y: i})
+	}
+	return pattern
+}
+
+// square is the (2*radius+1)-wide square centered on the target.
+func square(radius int) []Coordinate {
+	var pattern []Coordinate
+	for x := -radius; x <= radius; x++ {
+		for y := -radius; y <= radius; y++ {
+			pattern = append(pattern, Coordinate{X: x, Y: y})
+		}
+	}
+	return pattern
+}
+
+// OrientationOf resolves the orientation of a power request. Requests
+// without one fall back to the legacy horizontal flag.
+func OrientationOf(o piratesv1.Orientation, horizontal bool) piratesv1.Orientation {
+	switch {
+	case o != piratesv1.Orientation_ORIENTATION_UNSPECIFIED:
+		return o
+	case horizontal:
+		return piratesv1.Orientation_ORIENTATION_EAST
+	default:
+		return piratesv1.Orientation_ORIENTATION_SOUTH
+	}
+}
+
+func validOrientation(o piratesv1.Orientation) bool {
+	return o >= piratesv1.Orientation_ORIENTATION_UNSPECIFIED && o <= piratesv1.Orientation_ORIENTATION_SOUTH_EAST
+}
+
+// rotate turns an offset counterclockwise by the orientation's 45° steps,
+// with y growing downwards. An odd step maps east to north-east and south
+// to south-east, which keeps touching cells touching.
+func rotate(off Coordinate, o piratesv1.Orientation) Coordinate {
+	steps := max(int(o)-1, 0)
+	if steps%2 == 1 {
+		off = Coordinate{X: off.X + off.Y, Y: off.Y - off.X}
+	}
+	for i := 0; i < steps/2; i++ {
+		off = Coordinate{X: off.Y, Y: -off.X}
+	}
+	return off
+}
+
+// offsets returns the pattern turned to o. Unspecified is east.
+func (d PowerDefinition) offsets(o piratesv1.Orientation) []Coordinate {
+	if !d.Orientable {
+		return d.Pattern
+	}
+	turned := make([]Coordinate, len(d.Pattern))
+	for i, off := range d.Pattern {
+		turned[i] = rotate(off, o)
+	}
+	return turned
+}
+
+// Cells returns the cells of the grid the power reaches when aimed at
+// (x, y).
+func (d PowerDefinition) Cells(x, y int, o piratesv1.Orientation) []Coordinate {
+	var cells []Coordinate
+	for _, off := range d.offsets(o) {
+		if c := (Coordinate{X: x + off.X, Y: y + off.Y}); inGrid(c.X, c.Y) {
+			cells = append(cells, c)
+		}
+	}
+	return cells
+}
+
+// Diagram draws the pattern the way the rules doc does: [●] is the target,
+// [X] every other reached cell.
+func (d PowerDefinition) Diagram(o piratesv1.Orientation) string {
+	reached := map[Coordinate]bool{}
+	minX, maxX, minY, maxY := 0, 0, 0, 0
+	for _, off := range d.offsets(o) {
+		reached[off] = true
+		minX, maxX = min(minX, off.X), max(maxX, off.X)
+		minY, maxY = min(minY, off.Y), max(maxY, off.Y)
+	}
+
+	var b strings.Builder
+	for y := minY; y <= maxY; y++ {
+		var row strings.Builder
+		for x := minX; x <= maxX; x++ {
+			switch {
+			case x == 0 && y == 0:
+				row.WriteString("[●]")
+			case reached[Coordinate{X: x, Y: y}]:
+				row.WriteString("[X]")
+			default:
+				row.WriteString("   ")
+			}
+		}
+		b.WriteString(strings.TrimRight(row.String(), " "))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func inGrid(x, y int) bool {
+	return x >= 0 && x < GridSize && y >= 0 && y < GridSize
+}
+
+// usePower resolves def aimed at (x, y), on the opponent's grid or, for
+// defensive effects, on the player's own. opponentState is nil for
+// defensive effects.
+func (g *Game) usePower(def PowerDefinition, playerState, opponentState *PlayerState, x, y int, o piratesv1.Orientation) (*piratesv1.PowerResult, error) {
+	if !inGrid(x, y) {
+		return nil, ErrInvalidTarget
+	}
+	if !validOrientation(o) {
+		return nil, ErrInvalidOrientation
+	}
+
+	cells := def.Cells(x, y, o)
+	switch def.Effect {
+	case EffectDamage, EffectInstakill:
+		result := &piratesv1.PowerResult{}
+		for _, c := range cells {
+			g.strike(def, playerState, opponentState, c, result)
+		}
+		return result, nil
+	case EffectReveal:
+		result := &piratesv1.PowerResult{}
+		for _, c := range cells {
+			result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
+				Position: &piratesv1.Coordinate{X: int32(c.X), Y: int32(c.Y)},
+				State:    g.reveal(opponentState, c),
+			})
+		}
+		return result, nil
+	case EffectShield:
+		return g.useShield(playerState, x, y, cells)
+	case EffectRepair:
+		return g.useRepair(playerState, x, y)
+	case EffectDecoy:
+		return g.useDecoy(playerState, x, y)
+	default:
+		return nil, ErrPowerNotAvailable
+	}
+}
+
+// PreviewPower previews power aimed at the next opponent still in the game.
+func (g *Game) PreviewPower(playerID string, power piratesv1.PowerType, x, y int, o piratesv1.Orientation) (*piratesv1.PowerPreview, error) {
+	return g.PreviewPowerOn(playerID, "", power, x, y, o)
+}
+
+// PreviewPowerOn returns the cells power would touch if playerID aimed it
+// at (x, y) of targetID's grid now. Cells a damaging power would skip are
+// left out; nothing about the cells' contents is revealed.
+func (g *Game) PreviewPowerOn(playerID, targetID string, power piratesv1.PowerType, x, y int, o piratesv1.Orientation) (*piratesv1.PowerPreview, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if g.Status != StatusPlayer1Turn && g.Status != StatusPlayer2Turn {
+		return nil, ErrGameNotInProgress
+	}
+	playerState, err := g.getPlayerState(playerID)
+	if err != nil {
+		return nil, err
+	}
+	def, ok := Powers[power]
+	if !ok || playerState.Powers[power] < 1 {
+		return nil, ErrPowerNotAvailable
+	}
+	if !inGrid(x, y) {
+		return nil, ErrInvalidTarget
+	}
+	if !validOrientation(o) {
+		return nil, ErrInvalidOrientation
+	}
+
+	cells := def.Cells(x, y, o)
+	if !def.Defensive() {
+		opponentState, err := g.targetState(playerID, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if def.Effect != EffectReveal {
+			cells = slices.DeleteFunc(cells, func(c Coordinate) bool {
+				return opponentState.Grid[c.X][c.Y].Hit
+			})
+		}
+	}
+	return &piratesv1.PowerPreview{
+		Cells:   coordsToProto(cells),
+		OwnGrid: def.Defensive(),
+	}, nil
+}
+
+// strike fires a damaging power at cell c and records what it did in
+// result. Cells already hit are skipped.
+func (g *Game) strike(def PowerDefinition, playerState, opponentState *PlayerState, c Coordinate, result *piratesv1.PowerResult) {
+	if opponentState.Grid[c.X][c.Y].Hit {
+		return
+	}
+
+	s := g.shoot(playerState, opponentState, c.X, c.Y)
+	if s.blast != nil {
+		result.MineBlasts = append(result.MineBlasts, s.blast)
+	}
+
+	if def.Effect == EffectInstakill && s.ship != nil {
+		if s.sunk == nil {
+			s.ship.Hits = s.ship.Size
+			s.sunk = s.ship
+			s.powerGained = g.sink(playerState, opponentState, s.ship)
+		}
+		// The whole ship goes down, shielded cells included.
+		for _, coord := range s.ship.Cells {
+			opponentState.Grid[coord.X][coord.Y].Hit = true
+			result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
+				Position: &piratesv1.Coordinate{X: int32(coord.X), Y: int32(coord.Y)},
+				State:    piratesv1.CellState_CELL_STATE_SUNK,
+			})
+		}
+	} else {
+		result.CellsAffected = append(result.CellsAffected, &piratesv1.CellReveal{
+			Position: &piratesv1.Coordinate{X: int32(c.X), Y: int32(c.Y)},
+			State:    s.state,
+		})
+	}
+	if s.sunk != nil {
+		result.SunkShips = append(result.SunkShips, s.sunk.ToProto())
+	}
+	if s.powerGained != nil {
+		result.PowersGained = append(result.PowersGained, s.powerGained)
+	}
+}
+
+// shot is the outcome of a single shot on a cell.
+type shot struct {
+	// state is what the attacker sees on the cell.
+	state piratesv1.CellState
+	// ship is the ship hit, if any. Decoys have none.
+	ship *GameShip
+	// sunk is set when the shot sank ship.
+	sunk *GameShip
+	// powerGained is what the defender got for the sunk ship.
+	powerGained *piratesv1.Power
+	// blast is the damage a mine did to the attacker's fleet.
+	blast *piratesv1.AttackResult
+}
+
+// shoot resolves a shot on a cell of the opponent's grid that is not hit
+// yet. Attacks, salvos and damaging powers all go through it.
+func (g *Game) shoot(playerState, opponentState *PlayerState, x, y int) shot {
+	if g.absorb(opponentState, x, y) {
+		playerState.Stats.recordShot(false)
+		return shot{state: piratesv1.CellState_CELL_STATE_SHIELDED}
+	}
+
+	cell := opponentState.Grid[x][y]
+	cell.Hit = true
+	playerState.Stats.recordShot(cell.looksLikeShip())
+	g.turnHit = g.turnHit || cell.looksLikeShip()
+
+	switch {
+	case cell.Decoy:
+		// Destroyed, but the attacker only sees a hit.
+		return shot{state: piratesv1.CellState_CELL_STATE_HIT}
+	case cell.ShipID == "":
+		state, blast := g.strikeTerrain(playerState, cell)
+		return shot{state: state, blast: blast}
+	}
+
+	ship := opponentState.Ships[cell.ShipID]
+	ship.Hits++
+	if !ship.IsSunk() {
+		return shot{state: piratesv1.CellState_CELL_STATE_HIT, ship: ship}
+	}
+	return shot{
+		state:       piratesv1.CellState_CELL_STATE_SUNK,
+		ship:        ship,
+		sunk:        ship,
+		powerGained: g.sink(playerState, opponentState, ship),
+	}
+}
+
+// sink marks ship sunk and credits the attacker. The power goes to the
+// DEFENDER as compensation; it is returned, if any.
+func (g *Game) sink(playerState, opponentState *PlayerState, ship *GameShip) *piratesv1.Power {
+	for _, coord := range ship.Cells {
+		opponentState.Grid[coord.X][coord.Y].Sunk = true
+	}
+	playerState.Stats.ShipsSunk++
+	g.turnSunk = true
+	return g.compensate(opponentState, ship)
+}
+
+// reveal marks cell c of the opponent's grid revealed and returns what it
+// holds, without damaging it.
+func (g *Game) reveal(opponentState *PlayerState, c Coordinate) piratesv1.CellState {
+	if g.absorb(opponentState, c.X, c.Y) {
+		return piratesv1.CellState_CELL_STATE_SHIELDED
+	}
+	cell := opponentState.Grid[c.X][c.Y]
+	cell.Revealed = true
+
+	switch {
+	case cell.Sunk:
+		return piratesv1.CellState_CELL_STATE_SUNK
+	case cell.Hit && cell.looksLikeShip():
+		return piratesv1.CellState_CELL_STATE_HIT
+	case cell.Island:
+		return piratesv1.CellState_CELL_STATE_ISLAND
+	case cell.Mine:
+		return piratesv1.CellState_CELL_STATE_MINE
+	case cell.Hit:
+		return piratesv1.CellState_CELL_STATE_MISS
+	case cell.looksLikeShip():
+		return piratesv1.CellState_CELL_STATE_REVEALED
+	default:
+		return piratesv1.CellState_CELL_STATE_EMPTY
+	}
+}
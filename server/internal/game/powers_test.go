@@ -0,0 +1,256 @@
+package game
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func createStartedGame() *Game {
+	g := NewGame("game-1", "player-1", "player-2")
+	g.PlaceShips("player-1", createTestShips())
+	g.PlaceShips("player-2", createTestShips())
+	g.StartGame()
+	return g
+}
+
+func TestPowerCells(t *testing.T) {
+	triple := Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE]
+	if got, want := triple.Cells(4, 4, piratesv1.Orientation_ORIENTATION_SOUTH), []Coordinate{{X: 4, Y: 3}, {X: 4, Y: 4}, {X: 4, Y: 5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("vertical triple: expected %v, got %v", want, got)
+	}
+
+	kraken := Powers[piratesv1.PowerType_POWER_TYPE_KRAKEN]
+	if got := kraken.Cells(4, 4, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); len(got) != 5 {
+		t.Errorf("expected the kraken to reach 5 cells, got %v", got)
+	}
+	if got, want := kraken.Cells(0, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED), []Coordinate{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("kraken in a corner: expected %v, got %v", want, got)
+	}
+}
+
+// TestPowerDiagrams keeps the rules doc in line with the power definitions.
+func TestPowerDiagrams(t *testing.T) {
+	doc, err := os.ReadFile("../../../docs/game-rules.md")
+	if err != nil {
+		t.Fatalf("reading the rules doc: %v", err)
+	}
+
+	for _, pt := range []piratesv1.PowerType{
+		piratesv1.PowerType_POWER_TYPE_TRIPLE,
+		piratesv1.PowerType_POWER_TYPE_SONAR,
+		piratesv1.PowerType_POWER_TYPE_KRAKEN,
+	} {
+		def := Powers[pt]
+		for _, o := range []piratesv1.Orientation{piratesv1.Orientation_ORIENTATION_EAST, piratesv1.Orientation_ORIENTATION_SOUTH} {
+			if diagram := def.Diagram(o); !strings.Contains(string(doc), diagram) {
+				t.Errorf("rules doc is missing the %s diagram:\n%s", def.Name, diagram)
+			}
+		}
+	}
+}
+
+func TestUsePowerPatterns(t *testing.T) {
+	t.Run("kraken hits the target and its 4 neighbours", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_KRAKEN] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_KRAKEN, 5, 5, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if len(result.CellsAffected) != 5 {
+			t.Errorf("expected 5 cells, got %d", len(result.CellsAffected))
+		}
+		if g.Player2State.Grid[7][5].Hit {
+			t.Error("expected the kraken not to reach 2 cells away")
+		}
+	})
+
+	t.Run("damage skips cells already hit", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player2State.Grid[1][0].Hit = true
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, 1, 0, piratesv1.Orientation_ORIENTATION_EAST)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if len(result.CellsAffected) != 2 {
+			t.Errorf("expected 2 cells, got %v", result.CellsAffected)
+		}
+	})
+
+	t.Run("instakill sinks the whole ship", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_INSTAKILL] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_INSTAKILL, 2, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if len(result.SunkShips) != 1 || len(result.CellsAffected) != 5 {
+			t.Fatalf("expected the Galion to sink, got %v", result)
+		}
+		if !g.Player2State.Ships["ship-1"].IsSunk() || g.Player1State.Stats.ShipsSunk != 1 {
+			t.Error("expected the Galion to be sunk and credited")
+		}
+		if len(result.PowersGained) != 1 {
+			t.Errorf("expected the defender's power in the result, got %v", result.PowersGained)
+		}
+	})
+
+	t.Run("damage reports the powers its sinks grant", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, 1, 4, piratesv1.Orientation_ORIENTATION_EAST)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if len(result.SunkShips) != 1 || len(result.PowersGained) != 1 {
+			t.Errorf("expected the Chaloupe to sink and grant a power, got %v", result)
+		}
+	})
+
+	t.Run("instakill on a hit cell is wasted", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player2State.Grid[2][0].Hit = true
+		g.Player2State.Ships["ship-1"].Hits = 1
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_INSTAKILL] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_INSTAKILL, 2, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if len(result.SunkShips) != 0 || g.Player2State.Ships["ship-1"].IsSunk() {
+			t.Errorf("expected nothing to sink, got %v", result)
+		}
+		if g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_INSTAKILL] != 0 {
+			t.Error("expected the power to be spent")
+		}
+	})
+
+	t.Run("sonar reveals without counting as a hit", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player2State.Grid[0][0].Hit = true
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_SONAR] = 1
+
+		if _, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_SONAR, 0, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if g.turnHit {
+			t.Error("expected a sonar sweep over a known hit not to count as a hit")
+		}
+	})
+
+	t.Run("targets off the grid are rejected", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		if _, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, -1, 0, piratesv1.Orientation_ORIENTATION_EAST); err != ErrInvalidTarget {
+			t.Errorf("expected ErrInvalidTarget, got %v", err)
+		}
+	})
+}
+
+func TestPowerOrientation(t *testing.T) {
+	// An L-shaped airstrike, as a variant could define it.
+	airstrike := PowerDefinition{
+		Name:       "Airstrike",
+		Effect:     EffectDamage,
+		Pattern:    []Coordinate{{}, {X: 1}, {X: 2}, {Y: 1}},
+		Orientable: true,
+	}
+	tests := []struct {
+		o    piratesv1.Orientation
+		want []Coordinate
+	}{
+		{piratesv1.Orientation_ORIENTATION_EAST, []Coordinate{{X: 4, Y: 4}, {X: 5, Y: 4}, {X: 6, Y: 4}, {X: 4, Y: 5}}},
+		{piratesv1.Orientation_ORIENTATION_NORTH, []Coordinate{{X: 4, Y: 4}, {X: 4, Y: 3}, {X: 4, Y: 2}, {X: 5, Y: 4}}},
+		{piratesv1.Orientation_ORIENTATION_WEST, []Coordinate{{X: 4, Y: 4}, {X: 3, Y: 4}, {X: 2, Y: 4}, {X: 4, Y: 3}}},
+		{piratesv1.Orientation_ORIENTATION_SOUTH, []Coordinate{{X: 4, Y: 4}, {X: 4, Y: 5}, {X: 4, Y: 6}, {X: 3, Y: 4}}},
+		{piratesv1.Orientation_ORIENTATION_NORTH_EAST, []Coordinate{{X: 4, Y: 4}, {X: 5, Y: 3}, {X: 6, Y: 2}, {X: 5, Y: 5}}},
+		{piratesv1.Orientation_ORIENTATION_SOUTH_WEST, []Coordinate{{X: 4, Y: 4}, {X: 3, Y: 5}, {X: 2, Y: 6}, {X: 3, Y: 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.o.String(), func(t *testing.T) {
+			if got := airstrike.Cells(4, 4, tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("triple fires a diagonal broadside", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, 1, 1, piratesv1.Orientation_ORIENTATION_SOUTH_EAST)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		for i, cell := range result.CellsAffected {
+			if cell.Position.X != int32(i) || cell.Position.Y != int32(i) {
+				t.Errorf("cell %d: expected (%d, %d), got %v", i, i, i, cell.Position)
+			}
+		}
+	})
+
+	t.Run("unknown orientations are rejected", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		if _, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, 1, 1, 42); err != ErrInvalidOrientation {
+			t.Errorf("expected ErrInvalidOrientation, got %v", err)
+		}
+	})
+
+	t.Run("legacy horizontal flag", func(t *testing.T) {
+		if o := OrientationOf(piratesv1.Orientation_ORIENTATION_UNSPECIFIED, false); o != piratesv1.Orientation_ORIENTATION_SOUTH {
+			t.Errorf("expected vertical to mean south, got %v", o)
+		}
+		if o := OrientationOf(piratesv1.Orientation_ORIENTATION_WEST, false); o != piratesv1.Orientation_ORIENTATION_WEST {
+			t.Errorf("expected the orientation to win over the flag, got %v", o)
+		}
+	})
+}
+
+func TestPreviewPower(t *testing.T) {
+	t.Run("lists the cells without firing", func(t *testing.T) {
+		g := createStartedGame()
+		g.Player2State.Grid[0][1].Hit = true
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_KRAKEN] = 1
+
+		preview, err := g.PreviewPower("player-1", piratesv1.PowerType_POWER_TYPE_KRAKEN, 0, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("PreviewPower failed: %v", err)
+		}
+		if len(preview.Cells) != 2 || preview.OwnGrid {
+			t.Errorf("expected the 2 cells not hit yet, got %v", preview)
+		}
+		if g.Player2State.Grid[0][0].Hit || g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_KRAKEN] != 1 {
+			t.Error("expected the preview not to fire the power")
+		}
+	})
+
+	t.Run("defensive powers preview the own grid", func(t *testing.T) {
+		g := newDefensiveGame()
+		preview, err := g.PreviewPower("player-2", piratesv1.PowerType_POWER_TYPE_SHIELD, 0, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("PreviewPower failed: %v", err)
+		}
+		if len(preview.Cells) != 4 || !preview.OwnGrid {
+			t.Errorf("expected the shield's 4 cells in the corner, got %v", preview)
+		}
+	})
+
+	t.Run("requires the power", func(t *testing.T) {
+		g := createStartedGame()
+		if _, err := g.PreviewPower("player-1", piratesv1.PowerType_POWER_TYPE_SONAR, 0, 0, piratesv1.Orientation_ORIENTATION_UNSPECIFIED); err != ErrPowerNotAvailable {
+			t.Errorf("expected ErrPowerNotAvailable, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package game
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// PuzzleFleetID seats the hidden fleet of a puzzle game.
+const PuzzleFleetID = "puzzle-fleet"
+
+// RandomFleet places the required ships at random, drawing from rng so that
+// the same generator state always yields the same fleet.
+func RandomFleet(rng *rand.Rand) []*piratesv1.Ship {
+	occupied := make(map[Coordinate]bool)
+	ships := make([]*piratesv1.Ship, 0, len(RequiredShips))
+	for i, def := range RequiredShips {
+		for {
+			ship := &piratesv1.Ship{
+				Id:         fmt.Sprintf("ship-%d", i+1),
+				Name:       def.Name,
+				Size:       int32(def.Size),
+				Horizontal: rng.IntN(2) == 0,
+			}
+			width, height := GridSize, GridSize
+			if ship.Horizontal {
+				width -= def.Size - 1
+			} else {
+				height -= def.Size - 1
+			}
+			ship.Start = &piratesv1.Coordinate{X: int32(rng.IntN(width)), Y: int32(rng.IntN(height))}
+
+			cells := shipCells(ship)
+			if slices.ContainsFunc(cells, func(c Coordinate) bool { return occupied[c] }) {
+				continue
+			}
+			for _, c := range cells {
+				occupied[c] = true
+			}
+			ships = append(ships, ship)
+			break
+		}
+	}
+	return ships
+}
+
+// NewPuzzleGame starts a game of playerID against fleet alone. The fleet
+// never plays, so the turn stays with the player until it is sunk. The
+// player has no fleet of their own, and starts with powers.
+func NewPuzzleGame(id, playerID string, fleet []*piratesv1.Ship, powers map[piratesv1.PowerType]int) (*Game, error) {
+	rules := DefaultRules()
+	rules.Powers = false
+
+	g := NewGameForPlayers(id, []string{playerID, PuzzleFleetID}, rules)
+	if err := g.PlaceShips(PuzzleFleetID, fleet); err != nil {
+		return nil, err
+	}
+	maps.Copy(g.Player1State.Powers, powers)
+	g.StartGame()
+	return g, nil
+}
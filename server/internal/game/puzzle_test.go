@@ -0,0 +1,62 @@
+package game
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func TestRandomFleet(t *testing.T) {
+	t.Run("places a valid fleet", func(t *testing.T) {
+		for seed := uint64(0); seed < 50; seed++ {
+			g := NewGame("game-1", "player-1", "player-2")
+			fleet := RandomFleet(rand.New(rand.NewPCG(seed, seed)))
+			if err := g.PlaceShips("player-1", fleet); err != nil {
+				t.Fatalf("seed %d: %v", seed, err)
+			}
+		}
+	})
+
+	t.Run("same seed same fleet", func(t *testing.T) {
+		a := RandomFleet(rand.New(rand.NewPCG(1, 2)))
+		b := RandomFleet(rand.New(rand.NewPCG(1, 2)))
+		if !reflect.DeepEqual(a, b) {
+			t.Error("same seed should place the same fleet")
+		}
+	})
+}
+
+func TestNewPuzzleGame(t *testing.T) {
+	powers := map[piratesv1.PowerType]int{piratesv1.PowerType_POWER_TYPE_SONAR: 1}
+	g, err := NewPuzzleGame("puzzle-1", "player-1", createTestShips(), powers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.GetCurrentTurn() != "player-1" {
+		t.Fatalf("expected player-1 to play, got %s", g.GetCurrentTurn())
+	}
+	if g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_SONAR] != 1 {
+		t.Error("expected the puzzle powers")
+	}
+
+	// Every cell of the test fleet: rows 0 to 4 from x = 0.
+	for y, size := range []int{5, 4, 3, 3, 2} {
+		for x := 0; x < size; x++ {
+			if _, err := g.Attack("player-1", x, y); err != nil {
+				t.Fatalf("attack (%d, %d): %v", x, y, err)
+			}
+			if g.GetCurrentTurn() != "player-1" {
+				t.Fatal("the fleet should never take the turn")
+			}
+		}
+	}
+	if !g.CheckVictory() || g.GetWinner() != "player-1" {
+		t.Fatal("sinking the fleet should solve the puzzle")
+	}
+	if g.Player1State.Stats.ShotsFired != 17 {
+		t.Errorf("expected 17 shots, got %d", g.Player1State.Stats.ShotsFired)
+	}
+}
@@ -0,0 +1,119 @@
+package game
+
+import (
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+const (
+	// MinTurnTime and MaxTurnTime bound the time allowed per turn.
+	MinTurnTime = 10 * time.Second
+	MaxTurnTime = 5 * time.Minute
+	// MaxSeriesLength is the longest series of games a match can be.
+	MaxSeriesLength = 7
+)
+
+// Rules are the options a game is played with.
+type Rules struct {
+	RuleSet piratesv1.RuleSet
+	// Powers grants the defender a power when one of their ships is sunk.
+	Powers bool
+	// SalvoShots is the number of shots per salvo. Zero means one shot per
+	// surviving ship of the attacker.
+	SalvoShots int
+	// BonusTurn lets the attacker play again after a hit or a sink.
+	BonusTurn piratesv1.BonusTurn
+	// MaxBonusTurns caps consecutive bonus turns. Zero means no cap.
+	MaxBonusTurns int
+	// NoTouch forbids ships from touching, even diagonally.
+	NoTouch bool
+	// Terrain adds islands and hidden mines to the boards.
+	Terrain bool
+	// TerrainSeed seeds the terrain. Zero picks a random seed. It is never
+	// sent to clients, as it would reveal the mines.
+	TerrainSeed int64
+	// DefensivePowers gives each player a Shield, a Repair and a Decoy at
+	// the start. Teammates share theirs, granted once per team.
+	DefensivePowers bool
+	// Teams plays 2v2: the players of the first and third seats against
+	// those of the second and fourth. Teammates share their powers and see
+	// each other's boards.
+	Teams bool
+	// TurnTime is the time allowed per turn. A player who runs out of time
+	// is out of the game. Zero means no limit.
+	TurnTime time.Duration
+	// SeriesLength plays the match as a best-of series of that many games.
+	// Zero or one means a single game.
+	SeriesLength int
+	// Ranked games update their players' ratings.
+	Ranked bool
+}
+
+func DefaultRules() Rules {
+	return Rules{
+		RuleSet: piratesv1.RuleSet_RULE_SET_CLASSIC,
+		Powers:  true,
+		Ranked:  true,
+	}
+}
+
+// RulesFromProto converts client-provided rules, treating nil and unset
+// fields as the defaults. The turn time is brought within its bounds, and
+// the series length to an odd number of games no longer than the maximum.
+func RulesFromProto(r *piratesv1.GameRules) Rules {
+	rules := DefaultRules()
+	if r == nil {
+		return rules
+	}
+	if r.RuleSet != piratesv1.RuleSet_RULE_SET_UNSPECIFIED {
+		rules.RuleSet = r.RuleSet
+	}
+	rules.Powers = !r.PowersDisabled
+	if r.SalvoShots > 0 {
+		rules.SalvoShots = int(r.SalvoShots)
+	}
+	rules.BonusTurn = r.BonusTurn
+	if r.MaxBonusTurns > 0 {
+		rules.MaxBonusTurns = int(r.MaxBonusTurns)
+	}
+	rules.NoTouch = r.NoTouch
+	rules.Terrain = r.Terrain
+	rules.DefensivePowers = r.DefensivePowers
+	rules.Teams = r.Teams
+	if r.TurnSeconds > 0 {
+		rules.TurnTime = min(max(time.Duration(r.TurnSeconds)*time.Second, MinTurnTime), MaxTurnTime)
+	}
+	if r.SeriesLength > 1 {
+		rules.SeriesLength = min(int(r.SeriesLength)|1, MaxSeriesLength)
+	}
+	rules.Ranked = !r.Unranked
+	return rules
+}
+
+// Team returns the team of the player in seat, numbered from 0. Seats
+// alternate between two teams in team games; otherwise every seat is its
+// own team.
+func (r Rules) Team(seat int) int {
+	if r.Teams {
+		return seat % 2
+	}
+	return seat
+}
+
+func (r Rules) ToProto() *piratesv1.GameRules {
+	return &piratesv1.GameRules{
+		RuleSet:         r.RuleSet,
+		PowersDisabled:  !r.Powers,
+		SalvoShots:      int32(r.SalvoShots),
+		BonusTurn:       r.BonusTurn,
+		MaxBonusTurns:   int32(r.MaxBonusTurns),
+		NoTouch:         r.NoTouch,
+		Terrain:         r.Terrain,
+		DefensivePowers: r.DefensivePowers,
+		Teams:           r.Teams,
+		TurnSeconds:     int32(r.TurnTime / time.Second),
+		SeriesLength:    int32(r.SeriesLength),
+		Unranked:        !r.Ranked,
+	}
+}
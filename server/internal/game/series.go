@@ -0,0 +1,146 @@
+package game
+
+import (
+	"slices"
+	"sync"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// Series is a match played as a best-of series of games between the same
+// players. It is over once a side has won most of its games, or when a
+// player forfeits or disconnects, which gives up the series.
+type Series struct {
+	mu        sync.Mutex
+	length    int
+	playerIDs []string
+	wins      map[string]int
+	games     int
+	over      bool
+	winner    string
+}
+
+func NewSeries(playerIDs []string, length int) *Series {
+	return &Series{
+		length:    length,
+		playerIDs: slices.Clone(playerIDs),
+		wins:      make(map[string]int, len(playerIDs)),
+	}
+}
+
+// Record counts the finished game g and reports whether the series is over.
+func (s *Series) Record(g *Game) bool {
+	outcomes := g.Outcomes()
+	winner := g.GetWinner()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.over {
+		return true
+	}
+	s.games++
+	gaveUp := false
+	for _, o := range outcomes {
+		if o.Won {
+			s.wins[o.PlayerID]++
+		}
+		left := o.Reason == piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT ||
+			o.Reason == piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT
+		if left && !o.Won {
+			gaveUp = true
+		}
+	}
+
+	switch {
+	case gaveUp && winner != "":
+		s.over, s.winner = true, winner
+	case winner != "" && s.wins[winner] > s.length/2:
+		s.over, s.winner = true, winner
+	case s.games >= s.length:
+		// Games without a winner leave the series undecided: the most wins
+		// take it, and a tie has no winner.
+		s.over = true
+		for _, id := range s.playerIDs {
+			if s.winner == "" || s.wins[id] > s.wins[s.winner] {
+				s.winner = id
+			}
+		}
+		for _, id := range s.playerIDs {
+			if id != s.winner && s.wins[id] == s.wins[s.winner] && !g.allied(id, s.winner) {
+				s.winner = ""
+				break
+			}
+		}
+	}
+	return s.over
+}
+
+// GiveUp ends the series after its game g, because absentIDs cannot take
+// their seat in the next one. They and their teammates lose the series:
+// the best of the other players takes it, and a tie has no winner.
+func (s *Series) GiveUp(g *Game, absentIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.over {
+		return
+	}
+	s.over, s.winner = true, ""
+	var candidates []string
+	for _, id := range s.playerIDs {
+		if !slices.ContainsFunc(absentIDs, func(absentID string) bool { return g.allied(id, absentID) }) {
+			candidates = append(candidates, id)
+		}
+	}
+	for _, id := range candidates {
+		if s.winner == "" || s.wins[id] > s.wins[s.winner] {
+			s.winner = id
+		}
+	}
+	for _, id := range candidates {
+		if id != s.winner && s.wins[id] == s.wins[s.winner] && !g.allied(id, s.winner) {
+			s.winner = ""
+			break
+		}
+	}
+}
+
+// NextSeats seats the players of the next game: the first seat moves on
+// each game, so players take turns going first.
+func (s *Series) NextSeats() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := s.games % len(s.playerIDs)
+	return append(slices.Clone(s.playerIDs[n:]), s.playerIDs[:n]...)
+}
+
+// ToProto describes the series as of its current game: the one just
+// recorded, or the next to start when gameStarting is set. A nil series,
+// a single game, has none.
+func (s *Series) ToProto(gameStarting bool) *piratesv1.Series {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series := &piratesv1.Series{
+		Length:     int32(s.length),
+		GameNumber: int32(s.games),
+		Over:       s.over,
+		WinnerId:   s.winner,
+	}
+	if gameStarting {
+		series.GameNumber++
+	}
+	for _, id := range s.playerIDs {
+		series.Scores = append(series.Scores, &piratesv1.SeriesScore{
+			PlayerId: id,
+			Wins:     int32(s.wins[id]),
+		})
+	}
+	return series
+}
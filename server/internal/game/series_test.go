@@ -0,0 +1,72 @@
+package game
+
+import (
+	"slices"
+	"testing"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// finishedGame is a game between playerIDs won by winnerID, whose
+// opponents left for reason.
+func finishedGame(playerIDs []string, winnerID string, reason piratesv1.GameOverReason) *Game {
+	g := NewGameForPlayers("game-1", playerIDs, DefaultRules())
+	for _, id := range playerIDs {
+		g.PlaceShips(id, createTestShips())
+	}
+	g.StartGame()
+	for _, id := range playerIDs {
+		if id != winnerID {
+			g.Leave(id, reason)
+		}
+	}
+	return g
+}
+
+func TestSeries(t *testing.T) {
+	players := []string{"player-1", "player-2"}
+	sunk := piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK
+
+	t.Run("most wins take the series", func(t *testing.T) {
+		s := NewSeries(players, 3)
+		if started := s.ToProto(true); started.GameNumber != 1 || len(started.Scores) != 2 {
+			t.Errorf("unexpected series: %v", started)
+		}
+		if s.Record(finishedGame(players, "player-1", sunk)) {
+			t.Fatal("expected the series to go on")
+		}
+		if seats := s.NextSeats(); !slices.Equal(seats, []string{"player-2", "player-1"}) {
+			t.Errorf("expected player-2 to go first, got %v", seats)
+		}
+		if s.Record(finishedGame(players, "player-2", sunk)) {
+			t.Fatal("expected the series to go on")
+		}
+		if !s.Record(finishedGame(players, "player-2", sunk)) {
+			t.Fatal("expected the series over")
+		}
+		series := s.ToProto(false)
+		if series.GameNumber != 3 || !series.Over || series.WinnerId != "player-2" || series.Scores[1].Wins != 2 {
+			t.Errorf("unexpected series: %v", series)
+		}
+	})
+
+	t.Run("an absent player gives up the series", func(t *testing.T) {
+		s := NewSeries(players, 5)
+		g := finishedGame(players, "player-1", sunk)
+		s.Record(g)
+		s.GiveUp(g, []string{"player-1"})
+		if series := s.ToProto(false); !series.Over || series.WinnerId != "player-2" {
+			t.Errorf("expected player-2 to take the series, got %v", series)
+		}
+	})
+
+	t.Run("forfeiting gives up the series", func(t *testing.T) {
+		s := NewSeries(players, 5)
+		if !s.Record(finishedGame(players, "player-2", piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT)) {
+			t.Fatal("expected the series over")
+		}
+		if series := s.ToProto(false); series.WinnerId != "player-2" {
+			t.Errorf("unexpected series: %v", series)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package game
+
+import (
+	"math/rand/v2"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+const (
+	// IslandCount is the number of island cells of a terrain game, shared
+	// by every board.
+	IslandCount = 4
+	// MinesPerBoard is the number of hidden mines on each player's board.
+	MinesPerBoard = 2
+)
+
+// generateTerrain lays out islands and mines from the game's seed. The same
+// seed always yields the same terrain.
+func (g *Game) generateTerrain(seed int64) {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	g.TerrainSeed = seed
+	g.rng = rand.New(rand.NewPCG(uint64(seed), 0))
+
+	states := g.seatStates()
+	for _, c := range g.randomCells(IslandCount, func(Coordinate) bool { return true }) {
+		for _, ps := range states {
+			ps.Grid[c.X][c.Y].Island = true
+		}
+	}
+	for _, ps := range states {
+		free := func(c Coordinate) bool { return !ps.Grid[c.X][c.Y].Island }
+		for _, c := range g.randomCells(MinesPerBoard, free) {
+			ps.Grid[c.X][c.Y].Mine = true
+		}
+	}
+}
+
+// randomCells picks n distinct cells accepted by ok.
+func (g *Game) randomCells(n int, ok func(Coordinate) bool) []Coordinate {
+	var candidates []Coordinate
+	for x := 0; x < GridSize; x++ {
+		for y := 0; y < GridSize; y++ {
+			if c := (Coordinate{X: x, Y: y}); ok(c) {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	g.rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	return candidates[:min(n, len(candidates))]
+}
+
+// Islands returns the grid's island cells in row-major order.
+func (gr *Grid) Islands() []Coordinate {
+	return gr.cells(func(c *Cell) bool { return c.Island })
+}
+
+// Mines returns the grid's mine cells in row-major order, spent or not.
+func (gr *Grid) Mines() []Coordinate {
+	return gr.cells(func(c *Cell) bool { return c.Mine })
+}
+
+// Decoys returns the grid's decoy cells in row-major order, destroyed or
+// not.
+func (gr *Grid) Decoys() []Coordinate {
+	return gr.cells(func(c *Cell) bool { return c.Decoy })
+}
+
+func (gr *Grid) cells(match func(*Cell) bool) []Coordinate {
+	var coords []Coordinate
+	for y := 0; y < GridSize; y++ {
+		for x := 0; x < GridSize; x++ {
+			if match(gr[x][y]) {
+				coords = append(coords, Coordinate{X: x, Y: y})
+			}
+		}
+	}
+	return coords
+}
+
+// TerrainFor returns the terrain as seen by playerID: the islands and their
+// own mines. It returns nil when the game has no terrain.
+func (g *Game) TerrainFor(playerID string) *piratesv1.Terrain {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if !g.Rules.Terrain {
+		return nil
+	}
+	ps, err := g.getPlayerState(playerID)
+	if err != nil {
+		return nil
+	}
+	return &piratesv1.Terrain{
+		Islands:   coordsToProto(ps.Grid.Islands()),
+		YourMines: coordsToProto(ps.Grid.Mines()),
+	}
+}
+
+func coordsToProto(coords []Coordinate) []*piratesv1.Coordinate {
+	out := make([]*piratesv1.Coordinate, 0, len(coords))
+	for _, c := range coords {
+		out = append(out, &piratesv1.Coordinate{X: int32(c.X), Y: int32(c.Y)})
+	}
+	return out
+}
+
+// strikeTerrain resolves a shot on a cell without a ship. Islands waste the
+// shot; a mine goes off and damages the attacker's fleet, returned as
+// blast.
+func (g *Game) strikeTerrain(attackerState *PlayerState, cell *Cell) (state piratesv1.CellState, blast *piratesv1.AttackResult) {
+	switch {
+	case cell.Island:
+		return piratesv1.CellState_CELL_STATE_ISLAND, nil
+	case cell.Mine:
+		return piratesv1.CellState_CELL_STATE_MINE, g.detonate(attackerState)
+	default:
+		return piratesv1.CellState_CELL_STATE_MISS, nil
+	}
+}
+
+// detonate hits a random intact cell of victimState's fleet. The owner of a
+// ship sunk this way is compensated as usual. It returns nil when the whole
+// fleet is already sunk.
+func (g *Game) detonate(victimState *PlayerState) *piratesv1.AttackResult {
+	var intact []Coordinate
+	for x := 0; x < GridSize; x++ {
+		for y := 0; y < GridSize; y++ {
+			if cell := victimState.Grid[x][y]; cell.ShipID != "" && !cell.Hit {
+				intact = append(intact, Coordinate{X: x, Y: y})
+			}
+		}
+	}
+	if len(intact) == 0 {
+		return nil
+	}
+
+	target := intact[g.rng.IntN(len(intact))]
+	cell := victimState.Grid[target.X][target.Y]
+	cell.Hit = true
+	cell.MineDamage = true
+
+	ship := victimState.Ships[cell.ShipID]
+	ship.Hits++
+	result := &piratesv1.AttackResult{
+		Target: &piratesv1.Coordinate{X: int32(target.X), Y: int32(target.Y)},
+		Hit:    true,
+	}
+	if ship.IsSunk() {
+		for _, coord := range ship.Cells {
+			victimState.Grid[coord.X][coord.Y].Sunk = true
+		}
+		result.SunkShip = ship.ToProto()
+		result.PowerGained = g.compensate(victimState, ship)
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func terrainRules(seed int64) Rules {
+	rules := DefaultRules()
+	rules.Terrain = true
+	rules.TerrainSeed = seed
+	return rules
+}
+
+// clearTerrain removes the generated terrain so tests can lay out their own.
+func clearTerrain(g *Game) {
+	for _, ps := range []*PlayerState{g.Player1State, g.Player2State} {
+		for x := 0; x < GridSize; x++ {
+			for y := 0; y < GridSize; y++ {
+				ps.Grid[x][y].Island = false
+				ps.Grid[x][y].Mine = false
+			}
+		}
+	}
+}
+
+// newTerrainGame starts a terrain game with a known layout: an island at
+// (9, 9) on both boards and a mine at (9, 0) on player 2's board.
+func newTerrainGame() *Game {
+	g := NewGameWithRules("game-1", "player-1", "player-2", terrainRules(42))
+	clearTerrain(g)
+	g.Player1State.Grid[9][9].Island = true
+	g.Player2State.Grid[9][9].Island = true
+	g.Player2State.Grid[9][0].Mine = true
+
+	g.PlaceShips("player-1", createTestShips())
+	g.PlaceShips("player-2", createTestShips())
+	g.StartGame()
+	return g
+}
+
+func TestGenerateTerrain(t *testing.T) {
+	g1 := NewGameWithRules("game-1", "player-1", "player-2", terrainRules(7))
+	g2 := NewGameWithRules("game-2", "player-1", "player-2", terrainRules(7))
+
+	islands := g1.Player1State.Grid.Islands()
+	if len(islands) != IslandCount {
+		t.Fatalf("expected %d islands, got %d", IslandCount, len(islands))
+	}
+	if !reflect.DeepEqual(islands, g1.Player2State.Grid.Islands()) {
+		t.Error("expected both boards to share the islands")
+	}
+	if !reflect.DeepEqual(islands, g2.Player1State.Grid.Islands()) ||
+		!reflect.DeepEqual(g1.Player2State.Grid.Mines(), g2.Player2State.Grid.Mines()) {
+		t.Error("expected the same seed to generate the same terrain")
+	}
+
+	for _, ps := range []*PlayerState{g1.Player1State, g1.Player2State} {
+		mines := ps.Grid.Mines()
+		if len(mines) != MinesPerBoard {
+			t.Fatalf("expected %d mines, got %d", MinesPerBoard, len(mines))
+		}
+		for _, m := range mines {
+			if ps.Grid[m.X][m.Y].Island {
+				t.Errorf("mine at %v is on an island", m)
+			}
+		}
+	}
+
+	if terrain := NewGame("game-3", "player-1", "player-2").TerrainFor("player-1"); terrain != nil {
+		t.Errorf("expected no terrain without the rule, got %v", terrain)
+	}
+}
+
+func TestTerrainFor(t *testing.T) {
+	g := newTerrainGame()
+
+	terrain := g.TerrainFor("player-2")
+	if len(terrain.Islands) != 1 || len(terrain.YourMines) != 1 {
+		t.Fatalf("expected one island and one mine, got %v", terrain)
+	}
+	if mines := g.TerrainFor("player-1").YourMines; len(mines) != 0 {
+		t.Errorf("expected player 1 to see none of player 2's mines, got %v", mines)
+	}
+}
+
+func TestPlaceShipsOnTerrain(t *testing.T) {
+	g := NewGameWithRules("game-1", "player-1", "player-2", terrainRules(42))
+	clearTerrain(g)
+	g.Player1State.Grid[2][3].Island = true
+
+	err := g.PlaceShips("player-1", createTestShips())
+	if !errors.Is(err, ErrInvalidPlacement) {
+		t.Fatalf("expected ErrInvalidPlacement, got %v", err)
+	}
+	if want := "Corvette is on an island at (2, 3)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("expected error to contain %q, got %q", want, err.Error())
+	}
+}
+
+func TestAttackTerrain(t *testing.T) {
+	t.Run("island wastes the shot", func(t *testing.T) {
+		g := newTerrainGame()
+
+		result, err := g.Attack("player-1", 9, 9)
+		if err != nil {
+			t.Fatalf("Attack failed: %v", err)
+		}
+		if !result.Island || result.Hit {
+			t.Errorf("expected a wasted shot on the island, got %v", result)
+		}
+
+		g.NextTurn()
+		g.NextTurn()
+		if _, err := g.Attack("player-1", 9, 9); err != ErrAlreadyHit {
+			t.Errorf("expected ErrAlreadyHit on the same island, got %v", err)
+		}
+	})
+
+	t.Run("mine damages the attacker", func(t *testing.T) {
+		g := newTerrainGame()
+
+		result, err := g.Attack("player-1", 9, 0)
+		if err != nil {
+			t.Fatalf("Attack failed: %v", err)
+		}
+		if !result.Mine || result.MineBlast == nil {
+			t.Fatalf("expected the mine to go off, got %v", result)
+		}
+
+		blast := result.MineBlast
+		cell := g.Player1State.Grid[blast.Target.X][blast.Target.Y]
+		if !blast.Hit || cell.ShipID == "" || !cell.Hit {
+			t.Errorf("expected the blast to hit one of player 1's ships, got %v", blast)
+		}
+
+		hidden := opponentViewOfAttack(result)
+		if hidden.MineBlast.Target != nil {
+			t.Errorf("expected the mine owner not to learn the damaged cell, got %v", hidden.MineBlast)
+		}
+		if result.MineBlast.Target == nil {
+			t.Error("expected hiding not to modify the attacker's result")
+		}
+	})
+
+	t.Run("powers set off mines", func(t *testing.T) {
+		g := newTerrainGame()
+		g.Player1State.Powers[piratesv1.PowerType_POWER_TYPE_TRIPLE] = 1
+
+		result, err := g.UsePower("player-1", piratesv1.PowerType_POWER_TYPE_TRIPLE, 9, 1, piratesv1.Orientation_ORIENTATION_SOUTH)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		if len(result.MineBlasts) != 1 {
+			t.Fatalf("expected one mine blast, got %d", len(result.MineBlasts))
+		}
+		if state := result.CellsAffected[0].State; state != piratesv1.CellState_CELL_STATE_MINE {
+			t.Errorf("expected the mine cell to be reported as a mine, got %v", state)
+		}
+	})
+}
@@ -0,0 +1,287 @@
+package game
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// View returns the boards as playerID is allowed to see them: their own
+// board and their teammates' in full, and the opponents' reduced to what
+// has been learned about them. Everything fired at a board is public to
+// every opponent, sonar reveals included. Everything a player is told
+// about the boards must agree with it.
+func (g *Game) View(playerID string) (*piratesv1.GameView, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.viewLocked(playerID)
+}
+
+func (g *Game) viewLocked(playerID string) (*piratesv1.GameView, error) {
+	ps, err := g.getPlayerState(playerID)
+	if err != nil {
+		return nil, err
+	}
+	view := &piratesv1.GameView{
+		YourBoard:     ps.ownBoard(),
+		OpponentBoard: g.states[g.nextOpponentLocked(playerID)].opponentBoard(),
+	}
+	for _, opponentID := range g.OpponentIDs(playerID) {
+		other := g.states[opponentID]
+		view.Opponents = append(view.Opponents, &piratesv1.PlayerBoard{
+			PlayerId:   opponentID,
+			Board:      other.opponentBoard(),
+			Eliminated: other.Eliminated,
+		})
+	}
+	for _, allyID := range g.AllyIDs(playerID) {
+		ally := g.states[allyID]
+		view.Allies = append(view.Allies, &piratesv1.PlayerBoard{
+			PlayerId:   allyID,
+			Board:      ally.ownBoard(),
+			Eliminated: ally.Eliminated,
+		})
+	}
+	return view, nil
+}
+
+// ownBoard is the player's board as they see it: their fleet, terrain and
+// decoys, every shot they took and the cells the opponent has seen.
+func (ps *PlayerState) ownBoard() *piratesv1.BoardView {
+	board := &piratesv1.BoardView{}
+	for _, ship := range ps.sortedShips() {
+		board.Ships = append(board.Ships, ship.ToProto())
+	}
+	board.Cells = ps.Grid.known(func(cell *Cell) piratesv1.CellState {
+		switch {
+		case cell.Sunk:
+			return piratesv1.CellState_CELL_STATE_SUNK
+		case cell.Hit && cell.looksLikeShip():
+			return piratesv1.CellState_CELL_STATE_HIT
+		case cell.Island:
+			return piratesv1.CellState_CELL_STATE_ISLAND
+		case cell.Mine:
+			return piratesv1.CellState_CELL_STATE_MINE
+		case cell.Hit:
+			return piratesv1.CellState_CELL_STATE_MISS
+		case cell.Decoy:
+			return piratesv1.CellState_CELL_STATE_DECOY
+		case cell.Revealed:
+			return piratesv1.CellState_CELL_STATE_REVEALED
+		default:
+			return piratesv1.CellState_CELL_STATE_UNKNOWN
+		}
+	})
+	return board
+}
+
+// opponentBoard is the player's board as their opponent sees it: shots
+// fired, sonar reveals and sunk ships. Mine damage stays hidden until the
+// ship sinks.
+func (ps *PlayerState) opponentBoard() *piratesv1.BoardView {
+	board := &piratesv1.BoardView{}
+	for _, ship := range ps.sortedShips() {
+		if ship.IsSunk() {
+			board.Ships = append(board.Ships, ship.ToProto())
+		}
+	}
+	board.Cells = ps.Grid.known(func(cell *Cell) piratesv1.CellState {
+		shotAt := cell.Hit && !cell.MineDamage
+		switch {
+		case cell.Sunk:
+			return piratesv1.CellState_CELL_STATE_SUNK
+		case shotAt && cell.looksLikeShip():
+			return piratesv1.CellState_CELL_STATE_HIT
+		case cell.Island:
+			// Islands are public.
+			return piratesv1.CellState_CELL_STATE_ISLAND
+		case cell.Mine && (shotAt || cell.Revealed):
+			return piratesv1.CellState_CELL_STATE_MINE
+		case shotAt:
+			return piratesv1.CellState_CELL_STATE_MISS
+		case cell.Revealed && cell.looksLikeShip():
+			return piratesv1.CellState_CELL_STATE_REVEALED
+		case cell.Revealed:
+			return piratesv1.CellState_CELL_STATE_EMPTY
+		default:
+			return piratesv1.CellState_CELL_STATE_UNKNOWN
+		}
+	})
+	return board
+}
+
+// AttackFor returns attackerID's attack on targetID as told to viewerID,
+// any player but the attacker, eliminated ones watching included.
+func (g *Game) AttackFor(viewerID, attackerID, targetID string, result *piratesv1.AttackResult) (*piratesv1.OpponentAction, error) {
+	return g.actionFor(viewerID, attackerID, targetID, &piratesv1.OpponentAction{
+		Action: &piratesv1.OpponentAction_Attack{Attack: opponentViewOfAttack(result)},
+	}, gridUpdatesOfAttack(result))
+}
+
+// SalvoFor is AttackFor for a salvo.
+func (g *Game) SalvoFor(viewerID, attackerID, targetID string, result *piratesv1.SalvoResult) (*piratesv1.OpponentAction, error) {
+	return g.actionFor(viewerID, attackerID, targetID, &piratesv1.OpponentAction{
+		Action: &piratesv1.OpponentAction_Salvo{Salvo: opponentViewOfSalvo(result)},
+	}, gridUpdatesOfSalvo(result))
+}
+
+// PowerFor is AttackFor for a power.
+func (g *Game) PowerFor(viewerID, attackerID, targetID string, result *piratesv1.PowerResult) (*piratesv1.OpponentAction, error) {
+	return g.actionFor(viewerID, attackerID, targetID, &piratesv1.OpponentAction{
+		Action: &piratesv1.OpponentAction_Power{Power: opponentViewOfPower(result)},
+	}, gridUpdatesOfPower(result))
+}
+
+// actionFor completes told for viewerID. Only the target is told what the
+// action did to their grid: the cells it touched, as their View shows them.
+// Shots a shield absorbed are reported as such, since the view keeps no
+// trace of them.
+func (g *Game) actionFor(viewerID, attackerID, targetID string, told *piratesv1.OpponentAction, updates []*piratesv1.CellReveal) (*piratesv1.OpponentAction, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	view, err := g.viewLocked(viewerID)
+	if err != nil {
+		return nil, err
+	}
+	told.AttackerId = attackerID
+	told.TargetPlayerId = targetID
+	if viewerID != targetID {
+		return told, nil
+	}
+
+	known := make(map[Coordinate]piratesv1.CellState, len(view.YourBoard.Cells))
+	for _, cell := range view.YourBoard.Cells {
+		known[Coordinate{X: int(cell.Position.X), Y: int(cell.Position.Y)}] = cell.State
+	}
+	for _, update := range updates {
+		state, ok := known[Coordinate{X: int(update.Position.X), Y: int(update.Position.Y)}]
+		if !ok || update.State == piratesv1.CellState_CELL_STATE_SHIELDED {
+			state = update.State
+		}
+		told.YourGridUpdates = append(told.YourGridUpdates, &piratesv1.CellReveal{
+			Position: update.Position,
+			State:    state,
+		})
+	}
+	return told, nil
+}
+
+// known lists the cells state reports anything but unknown for, in
+// row-major order.
+func (gr *Grid) known(state func(*Cell) piratesv1.CellState) []*piratesv1.CellReveal {
+	var cells []*piratesv1.CellReveal
+	for y := 0; y < GridSize; y++ {
+		for x := 0; x < GridSize; x++ {
+			if s := state(gr[x][y]); s != piratesv1.CellState_CELL_STATE_UNKNOWN {
+				cells = append(cells, &piratesv1.CellReveal{
+					Position: &piratesv1.Coordinate{X: int32(x), Y: int32(y)},
+					State:    s,
+				})
+			}
+		}
+	}
+	return cells
+}
+
+// gridUpdatesOfAttack returns what an attack did to the defender's grid.
+func gridUpdatesOfAttack(result *piratesv1.AttackResult) []*piratesv1.CellReveal {
+	return []*piratesv1.CellReveal{{Position: result.Target, State: shotState(result)}}
+}
+
+// gridUpdatesOfSalvo is gridUpdatesOfAttack for every shot of a salvo.
+func gridUpdatesOfSalvo(result *piratesv1.SalvoResult) []*piratesv1.CellReveal {
+	var updates []*piratesv1.CellReveal
+	for _, shot := range result.Shots {
+		updates = append(updates, gridUpdatesOfAttack(shot)...)
+	}
+	return updates
+}
+
+// gridUpdatesOfPower returns what a power did to the defender's grid. Cells
+// a sonar scanned are reported as revealed, whatever they hold. Defensive
+// powers leave the defender's grid alone.
+func gridUpdatesOfPower(result *piratesv1.PowerResult) []*piratesv1.CellReveal {
+	if result.OwnGrid {
+		return nil
+	}
+	scan := Powers[result.PowerUsed].Effect == EffectReveal
+
+	var updates []*piratesv1.CellReveal
+	for _, cell := range result.CellsAffected {
+		state := cell.State
+		if scan && state != piratesv1.CellState_CELL_STATE_SHIELDED {
+			state = piratesv1.CellState_CELL_STATE_REVEALED
+		}
+		updates = append(updates, &piratesv1.CellReveal{Position: cell.Position, State: state})
+	}
+	return updates
+}
+
+func shotState(shot *piratesv1.AttackResult) piratesv1.CellState {
+	switch {
+	case shot.SunkShip != nil:
+		return piratesv1.CellState_CELL_STATE_SUNK
+	case shot.Hit:
+		return piratesv1.CellState_CELL_STATE_HIT
+	case shot.Island:
+		return piratesv1.CellState_CELL_STATE_ISLAND
+	case shot.Mine:
+		return piratesv1.CellState_CELL_STATE_MINE
+	case shot.Shielded:
+		return piratesv1.CellState_CELL_STATE_SHIELDED
+	default:
+		return piratesv1.CellState_CELL_STATE_MISS
+	}
+}
+
+// opponentViewOfAttack returns the attack as told to the defender. A mine's
+// owner learns that it went off and what it sank, but not which cell of the
+// attacker's fleet it damaged. Results without a blast are returned as is.
+func opponentViewOfAttack(result *piratesv1.AttackResult) *piratesv1.AttackResult {
+	if result.MineBlast == nil {
+		return result
+	}
+	hidden := proto.Clone(result).(*piratesv1.AttackResult)
+	hidden.MineBlast = hideBlast(hidden.MineBlast)
+	return hidden
+}
+
+// opponentViewOfSalvo is opponentViewOfAttack for every shot of a salvo.
+func opponentViewOfSalvo(result *piratesv1.SalvoResult) *piratesv1.SalvoResult {
+	hidden := proto.Clone(result).(*piratesv1.SalvoResult)
+	for _, shot := range hidden.Shots {
+		shot.MineBlast = hideBlast(shot.MineBlast)
+	}
+	return hidden
+}
+
+// opponentViewOfPower is opponentViewOfAttack for a power. The defender is
+// also not told where a shield was raised or a decoy placed: they only find
+// out when their fire runs into it.
+func opponentViewOfPower(result *piratesv1.PowerResult) *piratesv1.PowerResult {
+	// Where a shield or a decoy went is the whole point of using one.
+	secret := result.PowerUsed == piratesv1.PowerType_POWER_TYPE_SHIELD ||
+		result.PowerUsed == piratesv1.PowerType_POWER_TYPE_DECOY
+	if len(result.MineBlasts) == 0 && !secret {
+		return result
+	}
+	hidden := proto.Clone(result).(*piratesv1.PowerResult)
+	for i, blast := range hidden.MineBlasts {
+		hidden.MineBlasts[i] = hideBlast(blast)
+	}
+	if secret {
+		hidden.CellsAffected = nil
+	}
+	return hidden
+}
+
+// hideBlast drops the damaged cell unless the blast sank the ship, which
+// reveals it anyway.
+func hideBlast(blast *piratesv1.AttackResult) *piratesv1.AttackResult {
+	if blast == nil || blast.SunkShip != nil {
+		return blast
+	}
+	return &piratesv1.AttackResult{Hit: blast.Hit}
+}
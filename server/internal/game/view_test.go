@@ -0,0 +1,268 @@
+package game
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func TestView(t *testing.T) {
+	g := createStartedGame()
+	g.Attack("player-1", 0, 4)
+	g.Attack("player-1", 1, 4)
+	g.Attack("player-1", 9, 9)
+	g.Attack("player-1", 0, 0)
+
+	view, err := g.View("player-1")
+	if err != nil {
+		t.Fatalf("View failed: %v", err)
+	}
+	if len(view.YourBoard.Ships) != 5 {
+		t.Errorf("expected the whole own fleet, got %d ships", len(view.YourBoard.Ships))
+	}
+
+	opponent := view.OpponentBoard
+	if len(opponent.Ships) != 1 || opponent.Ships[0].Name != "Chaloupe" {
+		t.Errorf("expected only the sunk Chaloupe, got %v", opponent.Ships)
+	}
+	want := map[Coordinate]piratesv1.CellState{
+		{X: 0, Y: 0}: piratesv1.CellState_CELL_STATE_HIT,
+		{X: 0, Y: 4}: piratesv1.CellState_CELL_STATE_SUNK,
+		{X: 1, Y: 4}: piratesv1.CellState_CELL_STATE_SUNK,
+		{X: 9, Y: 9}: piratesv1.CellState_CELL_STATE_MISS,
+	}
+	if len(opponent.Cells) != len(want) {
+		t.Errorf("expected %d known cells, got %v", len(want), opponent.Cells)
+	}
+	for _, cell := range opponent.Cells {
+		c := Coordinate{X: int(cell.Position.X), Y: int(cell.Position.Y)}
+		if cell.State != want[c] {
+			t.Errorf("cell %v: expected %v, got %v", c, want[c], cell.State)
+		}
+	}
+
+	defender, _ := g.View("player-2")
+	if len(defender.YourBoard.Cells) != 4 || len(defender.OpponentBoard.Cells) != 0 {
+		t.Errorf("expected player 2 to see the 4 shots on their board only, got %v", defender)
+	}
+
+	if _, err := g.View("invalid-player"); err != ErrInvalidPlayer {
+		t.Errorf("expected ErrInvalidPlayer, got %v", err)
+	}
+}
+
+// TestViewNeverExposesShips plays random games with every power and terrain
+// and checks after each action that neither the views nor what the defender
+// is told expose a ship cell the attacker has not found.
+func TestViewNeverExposesShips(t *testing.T) {
+	rules := terrainRules(1)
+	rules.DefensivePowers = true
+
+	for seed := uint64(0); seed < 20; seed++ {
+		rng := rand.New(rand.NewPCG(seed, 0))
+		g := NewGameWithRules("game-1", "player-1", "player-2", rules)
+		clearTerrain(g)
+		for _, ps := range []*PlayerState{g.Player1State, g.Player2State} {
+			ps.Grid[9][9].Island = true
+			ps.Grid[9][0].Mine = true
+			ps.Grid[5][7].Mine = true
+		}
+		g.PlaceShips("player-1", createTestShips())
+		g.PlaceShips("player-2", createTestShips())
+		g.StartGame()
+		for _, ps := range []*PlayerState{g.Player1State, g.Player2State} {
+			for pt := range Powers {
+				ps.Powers[pt] = 2
+			}
+		}
+
+		for step := 0; step < 200 && g.GetStatus() != StatusFinished; step++ {
+			attackerID := g.GetCurrentTurn()
+			defenderID := g.GetOpponentID(attackerID)
+			x, y := rng.IntN(GridSize), rng.IntN(GridSize)
+
+			if powers := g.GetPlayerPowers(attackerID); len(powers) > 0 && rng.IntN(3) == 0 {
+				power := powers[rng.IntN(len(powers))].Type
+				o := piratesv1.Orientation(rng.IntN(9))
+				if result, err := g.UsePower(attackerID, power, x, y, o); err == nil {
+					for _, blast := range opponentViewOfPower(result).MineBlasts {
+						checkBlast(t, blast)
+					}
+				}
+			} else if result, err := g.Attack(attackerID, x, y); err == nil {
+				checkBlast(t, opponentViewOfAttack(result).MineBlast)
+			}
+
+			for _, viewerID := range []string{attackerID, defenderID} {
+				view, _ := g.View(viewerID)
+				opponentState, _ := g.GetPlayerState(g.GetOpponentID(viewerID))
+				checkOpponentBoard(t, view.OpponentBoard, opponentState)
+			}
+
+			if !g.CheckVictory() {
+				g.FinishTurn()
+			}
+		}
+	}
+}
+
+// checkBlast fails if a mine blast told to the mine's owner points at a
+// cell of a ship still afloat.
+func checkBlast(t *testing.T, blast *piratesv1.AttackResult) {
+	t.Helper()
+	if blast != nil && blast.Target != nil && blast.SunkShip == nil {
+		t.Errorf("mine blast exposes %v", blast.Target)
+	}
+}
+
+// checkOpponentBoard fails if board tells anything about a cell of ps that
+// the viewer has not found out, or lies about it.
+func checkOpponentBoard(t *testing.T, board *piratesv1.BoardView, ps *PlayerState) {
+	t.Helper()
+	for _, reveal := range board.Cells {
+		cell := ps.Grid[reveal.Position.X][reveal.Position.Y]
+		found := cell.Sunk || (cell.Hit && !cell.MineDamage) || cell.Revealed
+		if cell.ShipID != "" && !found {
+			t.Fatalf("unrevealed ship cell %v exposed as %v", reveal.Position, reveal.State)
+		}
+		switch reveal.State {
+		case piratesv1.CellState_CELL_STATE_EMPTY, piratesv1.CellState_CELL_STATE_MISS:
+			if cell.looksLikeShip() {
+				t.Fatalf("ship cell %v reported as %v", reveal.Position, reveal.State)
+			}
+		}
+	}
+	for _, ship := range board.Ships {
+		if !ps.Ships[ship.Id].IsSunk() {
+			t.Fatalf("ship %s exposed before it sank", ship.Name)
+		}
+	}
+}
+
+func TestSonarReveals(t *testing.T) {
+	sonar := piratesv1.PowerType_POWER_TYPE_SONAR
+	scan := func(t *testing.T, g *Game) *piratesv1.PowerResult {
+		t.Helper()
+		g.Player1State.Powers[sonar]++
+		result, err := g.UsePower("player-1", sonar, 1, 5, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("UsePower failed: %v", err)
+		}
+		return result
+	}
+	stateAt := func(cells []*piratesv1.CellReveal, x, y int) piratesv1.CellState {
+		for _, cell := range cells {
+			if cell.Position.X == int32(x) && cell.Position.Y == int32(y) {
+				return cell.State
+			}
+		}
+		return piratesv1.CellState_CELL_STATE_UNKNOWN
+	}
+
+	t.Run("persist in the view", func(t *testing.T) {
+		g := createStartedGame()
+		scan(t, g)
+
+		view, _ := g.View("player-1")
+		if state := stateAt(view.OpponentBoard.Cells, 1, 4); state != piratesv1.CellState_CELL_STATE_REVEALED {
+			t.Errorf("expected the Chaloupe cell to stay revealed, got %v", state)
+		}
+		if state := stateAt(view.OpponentBoard.Cells, 1, 6); state != piratesv1.CellState_CELL_STATE_EMPTY {
+			t.Errorf("expected the empty cell to stay scanned, got %v", state)
+		}
+
+		defender, _ := g.View("player-2")
+		if state := stateAt(defender.YourBoard.Cells, 1, 6); state != piratesv1.CellState_CELL_STATE_REVEALED {
+			t.Errorf("expected the defender to see the scanned cell, got %v", state)
+		}
+	})
+
+	t.Run("reported to the defender", func(t *testing.T) {
+		g := createStartedGame()
+		result := scan(t, g)
+
+		updates := gridUpdatesOfPower(result)
+		if len(updates) != len(result.CellsAffected) {
+			t.Fatalf("expected an update per scanned cell, got %d", len(updates))
+		}
+		for _, update := range updates {
+			if update.State != piratesv1.CellState_CELL_STATE_REVEALED {
+				t.Errorf("expected %v to be reported as revealed, got %v", update.Position, update.State)
+			}
+		}
+	})
+
+	t.Run("rescans report the current state", func(t *testing.T) {
+		g := createStartedGame()
+		first := scan(t, g)
+		g.NextTurn()
+		g.NextTurn()
+		again := scan(t, g)
+		if !reflect.DeepEqual(first.CellsAffected, again.CellsAffected) {
+			t.Error("expected a rescan of unchanged cells to report the same states")
+		}
+
+		g.NextTurn()
+		g.NextTurn()
+		g.Attack("player-1", 1, 4)
+		g.NextTurn()
+		g.NextTurn()
+		after := scan(t, g)
+		if state := stateAt(after.CellsAffected, 1, 4); state != piratesv1.CellState_CELL_STATE_HIT {
+			t.Errorf("expected the revealed cell to read as hit since, got %v", state)
+		}
+	})
+}
+
+func TestGridUpdates(t *testing.T) {
+	g := createStartedGame()
+	result, _ := g.Attack("player-1", 0, 0)
+	if updates := gridUpdatesOfAttack(result); len(updates) != 1 || updates[0].State != piratesv1.CellState_CELL_STATE_HIT {
+		t.Errorf("expected the hit cell, got %v", updates)
+	}
+
+	g = newDefensiveGame()
+	g.NextTurn()
+	power, err := g.UsePower("player-2", piratesv1.PowerType_POWER_TYPE_DECOY, 9, 9, piratesv1.Orientation_ORIENTATION_UNSPECIFIED)
+	if err != nil {
+		t.Fatalf("UsePower failed: %v", err)
+	}
+	if updates := gridUpdatesOfPower(power); updates != nil {
+		t.Errorf("expected a defensive power to leave the opponent's grid alone, got %v", updates)
+	}
+}
+
+func TestActionFor(t *testing.T) {
+	g := NewGameForPlayers("game-1", []string{"p1", "p2", "p3"}, DefaultRules())
+	for _, playerID := range g.PlayerIDs {
+		g.PlaceShips(playerID, createTestShips())
+	}
+	g.StartGame()
+
+	result, err := g.AttackOn("p1", "p2", 0, 4)
+	if err != nil {
+		t.Fatalf("AttackOn failed: %v", err)
+	}
+
+	told, err := g.AttackFor("p2", "p1", "p2", result)
+	if err != nil {
+		t.Fatalf("AttackFor failed: %v", err)
+	}
+	if len(told.YourGridUpdates) != 1 || told.YourGridUpdates[0].State != piratesv1.CellState_CELL_STATE_HIT {
+		t.Errorf("expected the target to see the hit, got %v", told.YourGridUpdates)
+	}
+	if told.AttackerId != "p1" || told.TargetPlayerId != "p2" {
+		t.Errorf("unexpected action: %v", told)
+	}
+
+	watched, _ := g.AttackFor("p3", "p1", "p2", result)
+	if len(watched.YourGridUpdates) != 0 || !watched.GetAttack().Hit {
+		t.Errorf("expected p3 to see the hit but no grid update, got %v", watched)
+	}
+
+	if _, err := g.AttackFor("invalid-player", "p1", "p2", result); err != ErrInvalidPlayer {
+		t.Errorf("expected ErrInvalidPlayer, got %v", err)
+	}
+}
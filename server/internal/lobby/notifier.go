@@ -0,0 +1,92 @@
+package lobby
+
+import (
+	"sync"
+	"time"
+)
+
+// Notifier coalesces lobby changes so that a busy lobby produces at most one
+// push per interval instead of one event per change. Changes are collected
+// until the interval has elapsed since the first change of a batch, then
+// handed to the callbacks in a single flush.
+type Notifier struct {
+	interval time.Duration
+
+	// OnPresence receives the IDs of every player whose presence changed
+	// since the previous flush.
+	OnPresence func(playerIDs []string)
+	// OnQueue is called when the matchmaking queue changed since the
+	// previous flush.
+	OnQueue func()
+
+	mu         sync.Mutex
+	players    map[string]struct{}
+	queueDirty bool
+	timer      *time.Timer
+	stopped    bool
+}
+
+func NewNotifier(interval time.Duration) *Notifier {
+	return &Notifier{
+		interval: interval,
+		players:  make(map[string]struct{}),
+	}
+}
+
+// PlayerChanged records that a player connected, disconnected or changed
+// status.
+func (n *Notifier) PlayerChanged(playerID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.players[playerID] = struct{}{}
+	n.scheduleLocked()
+}
+
+// QueueChanged records that the matchmaking queue changed.
+func (n *Notifier) QueueChanged() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.queueDirty = true
+	n.scheduleLocked()
+}
+
+// Stop cancels any pending flush. Changes recorded afterwards are dropped.
+func (n *Notifier) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.stopped = true
+	if n.timer != nil {
+		n.timer.Stop()
+		n.timer = nil
+	}
+}
+
+func (n *Notifier) scheduleLocked() {
+	if n.stopped || n.timer != nil {
+		return
+	}
+	n.timer = time.AfterFunc(n.interval, n.flush)
+}
+
+func (n *Notifier) flush() {
+	n.mu.Lock()
+	players := make([]string, 0, len(n.players))
+	for id := range n.players {
+		players = append(players, id)
+	}
+	n.players = make(map[string]struct{})
+	queueDirty := n.queueDirty
+	n.queueDirty = false
+	n.timer = nil
+	n.mu.Unlock()
+
+	if len(players) > 0 && n.OnPresence != nil {
+		n.OnPresence(players)
+	}
+	if queueDirty && n.OnQueue != nil {
+		n.OnQueue()
+	}
+}
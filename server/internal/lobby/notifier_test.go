@@ -0,0 +1,72 @@
+package lobby
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNotifier_CoalescesPresenceChanges(t *testing.T) {
+	n := NewNotifier(20 * time.Millisecond)
+	defer n.Stop()
+
+	var mu sync.Mutex
+	var batches [][]string
+	n.OnPresence = func(ids []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Strings(ids)
+		batches = append(batches, ids)
+	}
+
+	n.PlayerChanged("player1")
+	n.PlayerChanged("player2")
+	n.PlayerChanged("player1")
+
+	time.Sleep(60 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(batches) != 1 {
+		t.Fatalf("expected 1 batch, got %d", len(batches))
+	}
+	if len(batches[0]) != 2 || batches[0][0] != "player1" || batches[0][1] != "player2" {
+		t.Errorf("expected [player1 player2], got %v", batches[0])
+	}
+}
+
+func TestNotifier_QueueChanged(t *testing.T) {
+	n := NewNotifier(10 * time.Millisecond)
+	defer n.Stop()
+
+	calls := make(chan struct{}, 10)
+	n.OnQueue = func() { calls <- struct{}{} }
+	n.OnPresence = func([]string) { t.Error("unexpected presence flush") }
+
+	n.QueueChanged()
+	n.QueueChanged()
+
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("expected a queue flush")
+	}
+
+	select {
+	case <-calls:
+		t.Error("expected queue changes to be coalesced")
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestNotifier_Stop(t *testing.T) {
+	n := NewNotifier(10 * time.Millisecond)
+	n.OnPresence = func([]string) { t.Error("unexpected flush after Stop") }
+
+	n.PlayerChanged("player1")
+	n.Stop()
+	n.PlayerChanged("player2")
+
+	time.Sleep(30 * time.Millisecond)
+}
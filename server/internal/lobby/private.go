@@ -0,0 +1,136 @@
+package lobby
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+// codeAlphabet omits characters that are easily confused when read aloud or
+// typed (0/O, 1/I/L).
+const (
+	codeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	codeLength   = 6
+)
+
+var ErrLobbyNotFound = errors.New("private lobby not found")
+
+// PrivateLobby is a game hosted by a player and joinable only with its code.
+type PrivateLobby struct {
+	Code      string
+	HostID    string
+	Rules     game.Rules
+	CreatedAt time.Time
+}
+
+// PrivateLobbies indexes private lobbies by code and by host. A player hosts
+// at most one lobby at a time.
+type PrivateLobbies struct {
+	mu     sync.RWMutex
+	byCode map[string]*PrivateLobby
+	byHost map[string]*PrivateLobby
+}
+
+func NewPrivateLobbies() *PrivateLobbies {
+	return &PrivateLobbies{
+		byCode: make(map[string]*PrivateLobby),
+		byHost: make(map[string]*PrivateLobby),
+	}
+}
+
+// Create opens a private lobby for hostID, replacing any lobby the host
+// already had.
+func (l *PrivateLobbies) Create(hostID string, rules game.Rules) (*PrivateLobby, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closeLocked(hostID)
+
+	var code string
+	for {
+		var err error
+		code, err = generateCode()
+		if err != nil {
+			return nil, err
+		}
+		if _, taken := l.byCode[code]; !taken {
+			break
+		}
+	}
+
+	lobby := &PrivateLobby{
+		Code:      code,
+		HostID:    hostID,
+		Rules:     rules,
+		CreatedAt: time.Now(),
+	}
+	l.byCode[code] = lobby
+	l.byHost[hostID] = lobby
+	return lobby, nil
+}
+
+// Get looks a lobby up by code. Codes are case-insensitive and may be typed
+// with spaces or dashes.
+func (l *PrivateLobbies) Get(code string) (*PrivateLobby, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	lobby, ok := l.byCode[NormalizeCode(code)]
+	if !ok {
+		return nil, ErrLobbyNotFound
+	}
+	return lobby, nil
+}
+
+func (l *PrivateLobbies) HostedBy(hostID string) (*PrivateLobby, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	lobby, ok := l.byHost[hostID]
+	return lobby, ok
+}
+
+// Close removes the lobby hosted by hostID and reports whether there was one.
+func (l *PrivateLobbies) Close(hostID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.closeLocked(hostID)
+}
+
+func (l *PrivateLobbies) closeLocked(hostID string) bool {
+	lobby, ok := l.byHost[hostID]
+	if !ok {
+		return false
+	}
+	delete(l.byHost, hostID)
+	delete(l.byCode, lobby.Code)
+	return true
+}
+
+func NormalizeCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
+
+func generateCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := 0; i < codeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(codeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,59 @@
+package lobby
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+func TestPrivateLobbies_CreateAndGet(t *testing.T) {
+	l := NewPrivateLobbies()
+
+	lobby, err := l.Create("host", game.DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(lobby.Code) != codeLength {
+		t.Errorf("expected %d-character code, got %q", codeLength, lobby.Code)
+	}
+
+	typed := strings.ToLower(lobby.Code[:3]) + "-" + lobby.Code[3:]
+	found, err := l.Get(typed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found.HostID != "host" {
+		t.Errorf("expected host 'host', got %q", found.HostID)
+	}
+}
+
+func TestPrivateLobbies_CreateReplacesPrevious(t *testing.T) {
+	l := NewPrivateLobbies()
+
+	first, _ := l.Create("host", game.DefaultRules())
+	second, _ := l.Create("host", game.DefaultRules())
+
+	if _, err := l.Get(first.Code); err != ErrLobbyNotFound && first.Code != second.Code {
+		t.Errorf("expected previous lobby to be closed, got %v", err)
+	}
+	if hosted, ok := l.HostedBy("host"); !ok || hosted.Code != second.Code {
+		t.Error("expected host to have the new lobby")
+	}
+}
+
+func TestPrivateLobbies_Close(t *testing.T) {
+	l := NewPrivateLobbies()
+
+	lobby, _ := l.Create("host", game.DefaultRules())
+
+	if !l.Close("host") {
+		t.Error("expected lobby to be closed")
+	}
+	if l.Close("host") {
+		t.Error("expected second close to report no lobby")
+	}
+	if _, err := l.Get(lobby.Code); err != ErrLobbyNotFound {
+		t.Errorf("expected ErrLobbyNotFound, got %v", err)
+	}
+}
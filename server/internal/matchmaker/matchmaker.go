@@ -2,10 +2,13 @@ package matchmaker
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
 )
 
 type MatchStatus int
@@ -20,32 +23,63 @@ const (
 type QueueEntry struct {
 	PlayerID string
 	JoinedAt time.Time
+	// PartyID is set for players queued by their party, whose members
+	// are matched together and never against each other.
+	PartyID string
+	// Queue is the name of the queue the player waits in.
+	Queue string
 }
 
 type Match struct {
-	ID          string
+	ID string
+	// PlayerIDs lists every player of the match, the initiator first.
+	// Player1ID and Player2ID are the first two.
+	PlayerIDs   []string
 	Player1ID   string
 	Player2ID   string
 	InitiatedBy string
 	Status      MatchStatus
 	ExpiresAt   time.Time
-	responses   map[string]bool
+	Rules       game.Rules
+	// TournamentMatchID is the ID of the tournament match this match is
+	// played for, if any.
+	TournamentMatchID string
+	responses         map[string]bool
 }
 
 type OnMatchProposed func(playerID string, match *Match)
 type OnMatchResult func(playerID string, match *Match)
-type OnGameCreated func(player1ID, player2ID, gameID string)
+type OnGameCreated func(match *Match, gameID string)
+type OnQueueChanged func()
+
+// ErrPlayerUnavailable hides from a player that someone blocked them.
+var ErrPlayerUnavailable = errors.New("player unavailable")
 
 type Matchmaker struct {
 	mu           sync.RWMutex
 	queue        []QueueEntry
+	queues       map[string]*queueState
+	queueNames   []string
 	matches      map[string]*Match
 	playerMatch  map[string]string
+	parties      map[string]*Party
+	playerParty  map[string]string
 	matchTimeout time.Duration
 
-	OnMatchProposed OnMatchProposed
-	OnMatchResult   OnMatchResult
-	OnGameCreated   OnGameCreated
+	OnMatchProposed  OnMatchProposed
+	OnMatchResult    OnMatchResult
+	OnGameCreated    OnGameCreated
+	OnQueueChanged   OnQueueChanged
+	OnPartyInvite    OnPartyInvite
+	OnPartyChanged   OnPartyChanged
+	OnPartyDisbanded OnPartyDisbanded
+
+	// Blocked reports whether either player blocked the other. Such players
+	// are never matched together. It is called with the lock held.
+	Blocked func(a, b string) bool
+	// Rating returns a player's rating, which StrategyRating queues match
+	// players on. It is called with the lock held.
+	Rating func(playerID string) int
 
 	stopCh chan struct{}
 	wg     sync.WaitGroup
@@ -56,9 +90,12 @@ func NewMatchmaker(matchTimeout time.Duration) *Matchmaker {
 		queue:        make([]QueueEntry, 0),
 		matches:      make(map[string]*Match),
 		playerMatch:  make(map[string]string),
+		parties:      make(map[string]*Party),
+		playerParty:  make(map[string]string),
 		matchTimeout: matchTimeout,
 		stopCh:       make(chan struct{}),
 	}
+	m.SetQueues(DefaultQueues())
 	m.wg.Add(1)
 	go m.runAutoMatch()
 	return m
@@ -69,98 +106,209 @@ func (m *Matchmaker) Stop() {
 	m.wg.Wait()
 }
 
-func (m *Matchmaker) JoinQueue(playerID string) (position int, total int) {
+// JoinQueue queues playerID alone in the named queue, taking them out of
+// the one they waited in. In a team queue, they are teamed up with another
+// player queued alone. The position and total are within the queue.
+func (m *Matchmaker) JoinQueue(playerID, queue string) (position int, total int, err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for i, entry := range m.queue {
-		if entry.PlayerID == playerID {
-			return i + 1, len(m.queue)
+	if m.queues[queue] == nil {
+		return 0, 0, ErrQueueNotFound
+	}
+	if i := m.indexLocked(playerID); i >= 0 {
+		if m.queue[i].Queue == queue {
+			position, total = m.positionLocked(playerID)
+			return position, total, nil
 		}
+		m.removeFromQueueLocked(playerID)
 	}
 
 	m.queue = append(m.queue, QueueEntry{
 		PlayerID: playerID,
 		JoinedAt: time.Now(),
+		Queue:    queue,
 	})
+	m.notifyQueueChangedLocked()
 
-	return len(m.queue), len(m.queue)
+	position, total = m.positionLocked(playerID)
+	return position, total, nil
 }
 
+// LeaveQueue takes playerID out of the queue, along with their party if
+// it queued them.
 func (m *Matchmaker) LeaveQueue(playerID string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for i, entry := range m.queue {
-		if entry.PlayerID == playerID {
-			m.queue = append(m.queue[:i], m.queue[i+1:]...)
+	for _, entry := range m.queue {
+		if entry.PlayerID == playerID && entry.PartyID != "" {
+			m.removePartyFromQueueLocked(entry.PartyID)
 			return
 		}
 	}
+	m.removeFromQueueLocked(playerID)
 }
 
 func (m *Matchmaker) IsInQueue(playerID string) bool {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	for _, entry := range m.queue {
-		if entry.PlayerID == playerID {
-			return true
-		}
+	return m.indexLocked(playerID) >= 0
+}
+
+// QueuedPlayers returns the IDs of queued players in queue order.
+func (m *Matchmaker) QueuedPlayers() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := make([]string, len(m.queue))
+	for i, entry := range m.queue {
+		ids[i] = entry.PlayerID
 	}
-	return false
+	return ids
+}
+
+// QueueEntries returns every queue entry, in queue order.
+func (m *Matchmaker) QueueEntries() []QueueEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return slices.Clone(m.queue)
 }
 
+// GetQueuePosition returns playerID's position in their queue and the
+// number of players in it. The position is zero when they are not queued.
 func (m *Matchmaker) GetQueuePosition(playerID string) (position int, total int) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	for i, entry := range m.queue {
-		if entry.PlayerID == playerID {
-			return i + 1, len(m.queue)
+	return m.positionLocked(playerID)
+}
+
+func (m *Matchmaker) indexLocked(playerID string) int {
+	return slices.IndexFunc(m.queue, func(entry QueueEntry) bool { return entry.PlayerID == playerID })
+}
+
+func (m *Matchmaker) positionLocked(playerID string) (position int, total int) {
+	i := m.indexLocked(playerID)
+	if i < 0 {
+		return 0, len(m.queue)
+	}
+	for j, entry := range m.queue {
+		if entry.Queue != m.queue[i].Queue {
+			continue
+		}
+		total++
+		if j <= i {
+			position++
 		}
 	}
-	return 0, len(m.queue)
+	return position, total
 }
 
 func (m *Matchmaker) Challenge(challengerID, targetID string) (*Match, error) {
+	return m.ProposeMatch(challengerID, targetID, game.DefaultRules())
+}
+
+// ProposeMatch proposes a match initiated by challengerID against targetID,
+// to be played with the given rules.
+func (m *Matchmaker) ProposeMatch(challengerID, targetID string, rules game.Rules) (*Match, error) {
+	return m.ProposeGroupMatch(challengerID, []string{targetID}, rules)
+}
+
+// ProposeTournamentMatch proposes the game of the tournament match
+// tournamentMatchID between player1ID and player2ID.
+func (m *Matchmaker) ProposeTournamentMatch(player1ID, player2ID string, rules game.Rules, tournamentMatchID string) (*Match, error) {
+	return m.propose(player1ID, []string{player2ID}, rules, tournamentMatchID)
+}
+
+// ProposeGroupMatch proposes a match initiated by challengerID against every
+// player of targetIDs: a free-for-all when there are several, or 2v2 when
+// the rules make teams, with seats alternating between them. The game is
+// created once all of them accept; a single refusal cancels the match.
+func (m *Matchmaker) ProposeGroupMatch(challengerID string, targetIDs []string, rules game.Rules) (*Match, error) {
+	return m.propose(challengerID, targetIDs, rules, "")
+}
+
+func (m *Matchmaker) propose(challengerID string, targetIDs []string, rules game.Rules, tournamentMatchID string) (*Match, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if challengerID == targetID {
+	if len(targetIDs) == 0 || len(targetIDs) >= game.MaxPlayers {
+		return nil, fmt.Errorf("a match has 2 to %d players", game.MaxPlayers)
+	}
+	if rules.Teams && len(targetIDs) != 3 {
+		return nil, errors.New("a team match has 4 players")
+	}
+
+	if slices.Contains(targetIDs, challengerID) {
 		return nil, errors.New("cannot challenge yourself")
 	}
+	for i, id := range targetIDs {
+		if slices.Contains(targetIDs[:i], id) {
+			return nil, errors.New("player invited twice")
+		}
+	}
+	playerIDs := append([]string{challengerID}, targetIDs...)
+	if m.anyBlockedLocked(playerIDs) {
+		return nil, ErrPlayerUnavailable
+	}
 
 	if _, exists := m.playerMatch[challengerID]; exists {
 		return nil, errors.New("challenger already has a pending match")
 	}
-	if _, exists := m.playerMatch[targetID]; exists {
-		return nil, errors.New("target already has a pending match")
+	for _, id := range targetIDs {
+		if _, exists := m.playerMatch[id]; exists {
+			return nil, errors.New("target already has a pending match")
+		}
+	}
+
+	match := m.newMatchLocked(playerIDs, challengerID, rules)
+	match.TournamentMatchID = tournamentMatchID
+	for _, id := range playerIDs {
+		m.removeFromQueueLocked(id)
 	}
+	m.proposeLocked(match)
+
+	return match, nil
+}
 
+// newMatchLocked registers a pending match between playerIDs.
+func (m *Matchmaker) newMatchLocked(playerIDs []string, initiatedBy string, rules game.Rules) *Match {
 	match := &Match{
 		ID:          uuid.New().String(),
-		Player1ID:   challengerID,
-		Player2ID:   targetID,
-		InitiatedBy: challengerID,
+		PlayerIDs:   playerIDs,
+		Player1ID:   playerIDs[0],
+		Player2ID:   playerIDs[1],
+		InitiatedBy: initiatedBy,
 		Status:      MatchStatusPending,
 		ExpiresAt:   time.Now().Add(m.matchTimeout),
+		Rules:       rules,
 		responses:   make(map[string]bool),
 	}
 
 	m.matches[match.ID] = match
-	m.playerMatch[challengerID] = match.ID
-	m.playerMatch[targetID] = match.ID
-
-	m.removeFromQueueLocked(challengerID)
-	m.removeFromQueueLocked(targetID)
+	for _, id := range playerIDs {
+		m.playerMatch[id] = match.ID
+	}
+	return match
+}
 
+func (m *Matchmaker) proposeLocked(match *Match) {
 	if m.OnMatchProposed != nil {
-		go m.OnMatchProposed(challengerID, match)
-		go m.OnMatchProposed(targetID, match)
+		for _, id := range match.PlayerIDs {
+			go m.OnMatchProposed(id, match)
+		}
 	}
+}
 
-	return match, nil
+func (m *Matchmaker) notifyResultLocked(match *Match) {
+	if m.OnMatchResult != nil {
+		for _, id := range match.PlayerIDs {
+			go m.OnMatchResult(id, match)
+		}
+	}
 }
 
 func (m *Matchmaker) RespondToMatch(matchID string, playerID string, accepted bool) (*Match, error) {
@@ -172,7 +320,7 @@ func (m *Matchmaker) RespondToMatch(matchID string, playerID string, accepted bo
 		return nil, errors.New("match not found")
 	}
 
-	if match.Player1ID != playerID && match.Player2ID != playerID {
+	if !slices.Contains(match.PlayerIDs, playerID) {
 		return nil, errors.New("player not part of this match")
 	}
 
@@ -191,23 +339,19 @@ func (m *Matchmaker) RespondToMatch(matchID string, playerID string, accepted bo
 	if !accepted {
 		match.Status = MatchStatusRejected
 		m.cleanupMatch(match)
-		if m.OnMatchResult != nil {
-			go m.OnMatchResult(match.Player1ID, match)
-			go m.OnMatchResult(match.Player2ID, match)
-		}
+		m.notifyResultLocked(match)
 		return match, nil
 	}
 
-	if len(match.responses) == 2 && match.responses[match.Player1ID] && match.responses[match.Player2ID] {
+	// Refusals end the match right away, so every response recorded is an
+	// acceptance.
+	if len(match.responses) == len(match.PlayerIDs) {
 		match.Status = MatchStatusAccepted
 		gameID := m.CreateGame(match)
 		m.cleanupMatch(match)
-		if m.OnMatchResult != nil {
-			go m.OnMatchResult(match.Player1ID, match)
-			go m.OnMatchResult(match.Player2ID, match)
-		}
+		m.notifyResultLocked(match)
 		if m.OnGameCreated != nil {
-			go m.OnGameCreated(match.Player1ID, match.Player2ID, gameID)
+			go m.OnGameCreated(match, gameID)
 		}
 	}
 
@@ -243,15 +387,33 @@ func (m *Matchmaker) removeFromQueueLocked(playerID string) {
 	for i, entry := range m.queue {
 		if entry.PlayerID == playerID {
 			m.queue = append(m.queue[:i], m.queue[i+1:]...)
+			m.notifyQueueChangedLocked()
 			return
 		}
 	}
 }
 
+func (m *Matchmaker) removePartyFromQueueLocked(partyID string) {
+	n := len(m.queue)
+	m.queue = slices.DeleteFunc(m.queue, func(entry QueueEntry) bool {
+		return entry.PartyID == partyID
+	})
+	if len(m.queue) != n {
+		m.notifyQueueChangedLocked()
+	}
+}
+
+func (m *Matchmaker) notifyQueueChangedLocked() {
+	if m.OnQueueChanged != nil {
+		go m.OnQueueChanged()
+	}
+}
+
 func (m *Matchmaker) cleanupMatch(match *Match) {
 	delete(m.matches, match.ID)
-	delete(m.playerMatch, match.Player1ID)
-	delete(m.playerMatch, match.Player2ID)
+	for _, id := range match.PlayerIDs {
+		delete(m.playerMatch, id)
+	}
 }
 
 func (m *Matchmaker) runAutoMatch() {
@@ -277,14 +439,16 @@ func (m *Matchmaker) cleanupExpiredQueueEntries() {
 	defer m.mu.Unlock()
 
 	now := time.Now()
-	queueTimeout := 30 * time.Second
 
 	newQueue := make([]QueueEntry, 0, len(m.queue))
 	for _, entry := range m.queue {
-		if now.Sub(entry.JoinedAt) < queueTimeout {
+		if now.Sub(entry.JoinedAt) < m.queues[entry.Queue].Timeout {
 			newQueue = append(newQueue, entry)
 		}
 	}
+	if len(newQueue) != len(m.queue) {
+		m.notifyQueueChangedLocked()
+	}
 	m.queue = newQueue
 }
 
@@ -292,42 +456,180 @@ func (m *Matchmaker) tryAutoMatch() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if len(m.queue) < 2 {
-		return
+	now := time.Now()
+	for _, name := range m.queueNames {
+		q := m.queues[name]
+		switch {
+		case q.Rules.Teams:
+			m.matchTeamsLocked(q, now)
+		case q.Strategy == StrategyRating:
+			m.matchRatingLocked(q, now)
+		default:
+			m.matchPairLocked(q, now)
+		}
+	}
+}
+
+// waitingLocked returns the entries of the queue whose player has no
+// pending match, in queue order.
+func (m *Matchmaker) waitingLocked(queue string) []QueueEntry {
+	var waiting []QueueEntry
+	for _, entry := range m.queue {
+		if _, exists := m.playerMatch[entry.PlayerID]; !exists && entry.Queue == queue {
+			waiting = append(waiting, entry)
+		}
 	}
+	return waiting
+}
 
-	player1 := m.queue[0]
-	player2 := m.queue[1]
+// canFaceLocked reports whether a and b may play a one-on-one match: they
+// are not in the same party and neither blocked the other.
+func (m *Matchmaker) canFaceLocked(a, b QueueEntry) bool {
+	if a.PartyID != "" && a.PartyID == b.PartyID {
+		return false
+	}
+	return !m.blockedLocked(a.PlayerID, b.PlayerID)
+}
 
-	if _, exists := m.playerMatch[player1.PlayerID]; exists {
+// matchPairLocked proposes a one-on-one match between the player waiting
+// the longest and the next one outside their party.
+func (m *Matchmaker) matchPairLocked(q *queueState, now time.Time) {
+	waiting := m.waitingLocked(q.Name)
+	if len(waiting) < 2 {
 		return
 	}
-	if _, exists := m.playerMatch[player2.PlayerID]; exists {
+
+	for i, entry := range waiting {
+		for _, first := range waiting[:i] {
+			if m.canFaceLocked(first, entry) {
+				m.autoMatchLocked(q, []QueueEntry{first, entry}, now)
+				return
+			}
+		}
+	}
+}
+
+// matchRatingLocked proposes a one-on-one match between the player waiting
+// the longest and the closest rated player within their rating range. A
+// player nobody is close enough to lets the next one be matched first.
+func (m *Matchmaker) matchRatingLocked(q *queueState, now time.Time) {
+	waiting := m.waitingLocked(q.Name)
+	if len(waiting) < 2 {
 		return
 	}
 
-	m.queue = m.queue[2:]
+	for i, first := range waiting {
+		maxGap := ratingRange + ratingRangePerSecond*int(now.Sub(first.JoinedAt)/time.Second)
+		rating := m.ratingLocked(first.PlayerID)
+		closest, closestGap := -1, 0
+		for j, entry := range waiting {
+			if i == j || !m.canFaceLocked(first, entry) {
+				continue
+			}
+			gap := abs(m.ratingLocked(entry.PlayerID) - rating)
+			if gap <= maxGap && (closest < 0 || gap < closestGap) {
+				closest, closestGap = j, gap
+			}
+		}
+		if closest >= 0 {
+			m.autoMatchLocked(q, []QueueEntry{first, waiting[closest]}, now)
+			return
+		}
+	}
+}
 
-	match := &Match{
-		ID:          uuid.New().String(),
-		Player1ID:   player1.PlayerID,
-		Player2ID:   player2.PlayerID,
-		InitiatedBy: "",
-		Status:      MatchStatusPending,
-		ExpiresAt:   time.Now().Add(m.matchTimeout),
-		responses:   make(map[string]bool),
+func (m *Matchmaker) ratingLocked(playerID string) int {
+	if m.Rating == nil {
+		return 0
 	}
+	return m.Rating(playerID)
+}
 
-	m.matches[match.ID] = match
-	m.playerMatch[player1.PlayerID] = match.ID
-	m.playerMatch[player2.PlayerID] = match.ID
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
 
-	if m.OnMatchProposed != nil {
-		go m.OnMatchProposed(player1.PlayerID, match)
-		go m.OnMatchProposed(player2.PlayerID, match)
+// matchTeamsLocked proposes a 2v2 match between the first two teams made
+// of waiting players: a party of two, or two players queued alone.
+func (m *Matchmaker) matchTeamsLocked(q *queueState, now time.Time) {
+	var teams [][]QueueEntry
+	var alone []QueueEntry
+	partners := make(map[string]QueueEntry)
+	for _, entry := range m.waitingLocked(q.Name) {
+		if entry.PartyID != "" {
+			partner, ok := partners[entry.PartyID]
+			if !ok {
+				partners[entry.PartyID] = entry
+				continue
+			}
+			teams = append(teams, []QueueEntry{partner, entry})
+			continue
+		}
+		i := slices.IndexFunc(alone, func(other QueueEntry) bool {
+			return !m.blockedLocked(other.PlayerID, entry.PlayerID)
+		})
+		if i < 0 {
+			alone = append(alone, entry)
+			continue
+		}
+		teams = append(teams, []QueueEntry{alone[i], entry})
+		alone = slices.Delete(alone, i, i+1)
+	}
+
+	for i, team := range teams {
+		for _, first := range teams[:i] {
+			// Seats alternate between the teams.
+			entries := []QueueEntry{first[0], team[0], first[1], team[1]}
+			if m.anyBlockedLocked(playerIDsOf(entries)) {
+				continue
+			}
+			m.autoMatchLocked(q, entries, now)
+			return
+		}
 	}
 }
 
+func playerIDsOf(entries []QueueEntry) []string {
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.PlayerID
+	}
+	return ids
+}
+
+func (m *Matchmaker) blockedLocked(a, b string) bool {
+	return m.Blocked != nil && m.Blocked(a, b)
+}
+
+// anyBlockedLocked reports whether any of playerIDs blocked another.
+func (m *Matchmaker) anyBlockedLocked(playerIDs []string) bool {
+	for i, a := range playerIDs {
+		for _, b := range playerIDs[:i] {
+			if m.blockedLocked(a, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// autoMatchLocked takes the players of entries out of q and proposes them
+// a match nobody initiated, played with the rules of q.
+func (m *Matchmaker) autoMatchLocked(q *queueState, entries []QueueEntry, now time.Time) {
+	for _, entry := range entries {
+		q.recordWait(now.Sub(entry.JoinedAt))
+		m.removeFromQueueLocked(entry.PlayerID)
+	}
+	match := m.newMatchLocked(playerIDsOf(entries), "", q.Rules)
+	if q.AcceptTimeout > 0 {
+		match.ExpiresAt = now.Add(q.AcceptTimeout)
+	}
+	m.proposeLocked(match)
+}
+
 func (m *Matchmaker) cleanupExpiredMatches() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -336,10 +638,7 @@ func (m *Matchmaker) cleanupExpiredMatches() {
 	for _, match := range m.matches {
 		if match.Status == MatchStatusPending && now.After(match.ExpiresAt) {
 			match.Status = MatchStatusExpired
-			if m.OnMatchResult != nil {
-				go m.OnMatchResult(match.Player1ID, match)
-				go m.OnMatchResult(match.Player2ID, match)
-			}
+			m.notifyResultLocked(match)
 			m.cleanupMatch(match)
 		}
 	}
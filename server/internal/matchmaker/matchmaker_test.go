@@ -1,8 +1,11 @@
 package matchmaker
 
 import (
+	"slices"
 	"testing"
 	"time"
+
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
 )
 
 func newTestMatchmaker() *Matchmaker {
@@ -10,9 +13,12 @@ func newTestMatchmaker() *Matchmaker {
 		queue:        make([]QueueEntry, 0),
 		matches:      make(map[string]*Match),
 		playerMatch:  make(map[string]string),
+		parties:      make(map[string]*Party),
+		playerParty:  make(map[string]string),
 		matchTimeout: 30 * time.Second,
 		stopCh:       make(chan struct{}),
 	}
+	m.SetQueues(DefaultQueues())
 	return m
 }
 
@@ -20,21 +26,21 @@ func TestMatchmaker_JoinQueue(t *testing.T) {
 	m := newTestMatchmaker()
 
 	t.Run("first player joins", func(t *testing.T) {
-		pos, total := m.JoinQueue("player1")
+		pos, total, _ := m.JoinQueue("player1", DefaultQueue)
 		if pos != 1 || total != 1 {
 			t.Errorf("expected position 1, total 1, got %d, %d", pos, total)
 		}
 	})
 
 	t.Run("second player joins", func(t *testing.T) {
-		pos, total := m.JoinQueue("player2")
+		pos, total, _ := m.JoinQueue("player2", DefaultQueue)
 		if pos != 2 || total != 2 {
 			t.Errorf("expected position 2, total 2, got %d, %d", pos, total)
 		}
 	})
 
 	t.Run("same player joins again returns existing position", func(t *testing.T) {
-		pos, total := m.JoinQueue("player1")
+		pos, total, _ := m.JoinQueue("player1", DefaultQueue)
 		if pos != 1 || total != 2 {
 			t.Errorf("expected position 1, total 2, got %d, %d", pos, total)
 		}
@@ -43,8 +49,8 @@ func TestMatchmaker_JoinQueue(t *testing.T) {
 
 func TestMatchmaker_LeaveQueue(t *testing.T) {
 	m := newTestMatchmaker()
-	m.JoinQueue("player1")
-	m.JoinQueue("player2")
+	m.JoinQueue("player1", DefaultQueue)
+	m.JoinQueue("player2", DefaultQueue)
 
 	m.LeaveQueue("player1")
 
@@ -63,7 +69,7 @@ func TestMatchmaker_IsInQueue(t *testing.T) {
 		t.Error("expected player1 to not be in queue initially")
 	}
 
-	m.JoinQueue("player1")
+	m.JoinQueue("player1", DefaultQueue)
 
 	if !m.IsInQueue("player1") {
 		t.Error("expected player1 to be in queue after joining")
@@ -72,8 +78,8 @@ func TestMatchmaker_IsInQueue(t *testing.T) {
 
 func TestMatchmaker_GetQueuePosition(t *testing.T) {
 	m := newTestMatchmaker()
-	m.JoinQueue("player1")
-	m.JoinQueue("player2")
+	m.JoinQueue("player1", DefaultQueue)
+	m.JoinQueue("player2", DefaultQueue)
 
 	t.Run("player in queue", func(t *testing.T) {
 		pos, total := m.GetQueuePosition("player2")
@@ -125,8 +131,8 @@ func TestMatchmaker_Challenge(t *testing.T) {
 
 	t.Run("removes players from queue", func(t *testing.T) {
 		m2 := newTestMatchmaker()
-		m2.JoinQueue("p1")
-		m2.JoinQueue("p2")
+		m2.JoinQueue("p1", DefaultQueue)
+		m2.JoinQueue("p2", DefaultQueue)
 		m2.Challenge("p1", "p2")
 
 		if m2.IsInQueue("p1") || m2.IsInQueue("p2") {
@@ -184,6 +190,82 @@ func TestMatchmaker_RespondToMatch(t *testing.T) {
 	})
 }
 
+func TestMatchmaker_ProposeGroupMatch(t *testing.T) {
+	t.Run("every player must accept", func(t *testing.T) {
+		m := newTestMatchmaker()
+		created := make(chan []string, 1)
+		m.OnGameCreated = func(match *Match, gameID string) {
+			created <- match.PlayerIDs
+		}
+
+		match, err := m.ProposeGroupMatch("player1", []string{"player2", "player3"}, game.DefaultRules())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, id := range []string{"player1", "player2"} {
+			if result, _ := m.RespondToMatch(match.ID, id, true); result.Status != MatchStatusPending {
+				t.Fatalf("expected the match to wait for player3, got %v", result.Status)
+			}
+		}
+		result, err := m.RespondToMatch(match.ID, "player3", true)
+		if err != nil || result.Status != MatchStatusAccepted {
+			t.Fatalf("expected the match accepted, got %v, %v", result.Status, err)
+		}
+
+		select {
+		case playerIDs := <-created:
+			if !slices.Equal(playerIDs, []string{"player1", "player2", "player3"}) {
+				t.Errorf("unexpected players: %v", playerIDs)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected a game to be created")
+		}
+	})
+
+	t.Run("one refusal cancels the match", func(t *testing.T) {
+		m := newTestMatchmaker()
+		match, _ := m.ProposeGroupMatch("player1", []string{"player2", "player3"}, game.DefaultRules())
+
+		m.RespondToMatch(match.ID, "player2", true)
+		result, _ := m.RespondToMatch(match.ID, "player3", false)
+		if result.Status != MatchStatusRejected {
+			t.Errorf("expected status Rejected, got %v", result.Status)
+		}
+		for _, id := range []string{"player1", "player2", "player3"} {
+			if m.GetPendingMatch(id) != nil {
+				t.Errorf("expected %s to be free again", id)
+			}
+		}
+	})
+
+	t.Run("invalid player lists", func(t *testing.T) {
+		m := newTestMatchmaker()
+		invalid := [][]string{
+			nil,
+			{"p2", "p3", "p4", "p5"},
+			{"p2", "p2"},
+			{"p2", "p1"},
+		}
+		for _, targets := range invalid {
+			if _, err := m.ProposeGroupMatch("p1", targets, game.DefaultRules()); err == nil {
+				t.Errorf("expected an error for targets %v", targets)
+			}
+		}
+	})
+
+	t.Run("team matches have four players", func(t *testing.T) {
+		m := newTestMatchmaker()
+		rules := game.DefaultRules()
+		rules.Teams = true
+		if _, err := m.ProposeGroupMatch("p1", []string{"p2", "p3"}, rules); err == nil {
+			t.Error("expected an error for a team match of three")
+		}
+		if _, err := m.ProposeGroupMatch("p1", []string{"p2", "p3", "p4"}, rules); err != nil {
+			t.Errorf("expected the team match to be proposed, got %v", err)
+		}
+	})
+}
+
 func TestMatchmaker_GetPendingMatch(t *testing.T) {
 	m := newTestMatchmaker()
 
@@ -206,3 +288,54 @@ func TestMatchmaker_GetPendingMatch(t *testing.T) {
 		}
 	})
 }
+
+func TestMatchmaker_Blocked(t *testing.T) {
+	newBlockingMatchmaker := func() *Matchmaker {
+		m := newTestMatchmaker()
+		m.Blocked = func(a, b string) bool {
+			return a == "p1" && b == "p2" || a == "p2" && b == "p1"
+		}
+		return m
+	}
+
+	t.Run("blocked players are not matched", func(t *testing.T) {
+		m := newBlockingMatchmaker()
+		m.JoinQueue("p1", DefaultQueue)
+		m.JoinQueue("p2", DefaultQueue)
+		m.tryAutoMatch()
+		if m.GetPendingMatch("p1") != nil {
+			t.Fatal("expected no match between blocked players")
+		}
+
+		m.JoinQueue("p3", DefaultQueue)
+		m.tryAutoMatch()
+		match := m.GetPendingMatch("p3")
+		if match == nil || !slices.Equal(match.PlayerIDs, []string{"p1", "p3"}) {
+			t.Fatalf("expected p1 to face p3, got %+v", match)
+		}
+	})
+
+	t.Run("blocked players are not teamed", func(t *testing.T) {
+		m := newBlockingMatchmaker()
+		for _, id := range []string{"p1", "p2", "p3", "p4"} {
+			m.JoinQueue(id, QueueTeams)
+		}
+		m.tryAutoMatch()
+		if m.GetPendingMatch("p1") != nil {
+			t.Fatal("expected no match with blocked players on the field")
+		}
+	})
+
+	t.Run("blocked players cannot challenge", func(t *testing.T) {
+		m := newBlockingMatchmaker()
+		if _, err := m.ProposeMatch("p2", "p1", game.DefaultRules()); err != ErrPlayerUnavailable {
+			t.Errorf("expected ErrPlayerUnavailable, got %v", err)
+		}
+		if _, err := m.ProposeGroupMatch("p3", []string{"p1", "p2"}, game.DefaultRules()); err != ErrPlayerUnavailable {
+			t.Errorf("expected ErrPlayerUnavailable, got %v", err)
+		}
+		if _, err := m.InviteToParty("p1", "p2"); err != ErrPlayerUnavailable {
+			t.Errorf("expected ErrPlayerUnavailable, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,243 @@
+package matchmaker
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+var ErrPartyNotFound = errors.New("party not found")
+
+// Party is a group of players who queue together. Its leader invites the
+// other members and queues the whole party.
+type Party struct {
+	ID       string
+	LeaderID string
+	// MemberIDs lists the members in joining order, the leader first.
+	MemberIDs []string
+	invited   map[string]bool
+}
+
+type OnPartyInvite func(playerID string, party *Party)
+
+// OnPartyChanged and OnPartyDisbanded are called with every player
+// concerned: the members, and whoever just left.
+type OnPartyChanged func(playerIDs []string, party *Party)
+type OnPartyDisbanded func(playerIDs []string, party *Party)
+
+// snapshot copies the party for callers outside the lock.
+func (p *Party) snapshot() *Party {
+	return &Party{
+		ID:        p.ID,
+		LeaderID:  p.LeaderID,
+		MemberIDs: slices.Clone(p.MemberIDs),
+	}
+}
+
+// InviteToParty invites inviteeID into inviterID's party, which is created
+// with inviterID as its leader if they have none. Only the leader invites.
+func (m *Matchmaker) InviteToParty(inviterID, inviteeID string) (*Party, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if inviterID == inviteeID {
+		return nil, errors.New("cannot invite yourself")
+	}
+	party := m.partyOfLocked(inviterID)
+	if party != nil && party.LeaderID != inviterID {
+		return nil, errors.New("only the party leader can invite")
+	}
+	if _, exists := m.playerParty[inviteeID]; exists {
+		return nil, errors.New("player already in a party")
+	}
+	members := []string{inviterID}
+	if party != nil {
+		members = party.MemberIDs
+	}
+	if slices.ContainsFunc(members, func(id string) bool { return m.blockedLocked(id, inviteeID) }) {
+		return nil, ErrPlayerUnavailable
+	}
+	if party != nil && len(party.MemberIDs) >= game.MaxPlayers {
+		return nil, errors.New("party is full")
+	}
+
+	if party == nil {
+		party = &Party{
+			ID:        uuid.New().String(),
+			LeaderID:  inviterID,
+			MemberIDs: []string{inviterID},
+			invited:   make(map[string]bool),
+		}
+		m.parties[party.ID] = party
+		m.playerParty[inviterID] = party.ID
+	}
+	party.invited[inviteeID] = true
+
+	if m.OnPartyInvite != nil {
+		go m.OnPartyInvite(inviteeID, party.snapshot())
+	}
+	return party.snapshot(), nil
+}
+
+// RespondToPartyInvite accepts or declines playerID's invitation into
+// partyID. A party that gains a member leaves the queue: its leader
+// queues it anew.
+func (m *Matchmaker) RespondToPartyInvite(partyID, playerID string, accepted bool) (*Party, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	party, exists := m.parties[partyID]
+	if !exists {
+		return nil, ErrPartyNotFound
+	}
+	if !party.invited[playerID] {
+		return nil, errors.New("player not invited to this party")
+	}
+	delete(party.invited, playerID)
+
+	if !accepted {
+		return party.snapshot(), nil
+	}
+	if _, exists := m.playerParty[playerID]; exists {
+		return nil, errors.New("player already in a party")
+	}
+	if len(party.MemberIDs) >= game.MaxPlayers {
+		return nil, errors.New("party is full")
+	}
+	if slices.ContainsFunc(party.MemberIDs, func(id string) bool { return m.blockedLocked(id, playerID) }) {
+		return nil, ErrPlayerUnavailable
+	}
+
+	m.removePartyFromQueueLocked(party.ID)
+	m.removeFromQueueLocked(playerID)
+	party.MemberIDs = append(party.MemberIDs, playerID)
+	m.playerParty[playerID] = party.ID
+	m.notifyPartyChangedLocked(party.MemberIDs, party)
+
+	return party.snapshot(), nil
+}
+
+// LeaveParty takes playerID out of their party, which leaves the queue.
+// The next member in joining order leads once the leader is gone, and a
+// party left with a single member is disbanded.
+func (m *Matchmaker) LeaveParty(playerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	party := m.partyOfLocked(playerID)
+	if party == nil {
+		return ErrPartyNotFound
+	}
+
+	concerned := party.MemberIDs
+	party.MemberIDs = slices.DeleteFunc(slices.Clone(party.MemberIDs), func(id string) bool {
+		return id == playerID
+	})
+	delete(m.playerParty, playerID)
+	if len(party.MemberIDs) < 2 {
+		m.disbandLocked(party, concerned)
+		return nil
+	}
+
+	m.removePartyFromQueueLocked(party.ID)
+	party.LeaderID = party.MemberIDs[0]
+	m.notifyPartyChangedLocked(concerned, party)
+	return nil
+}
+
+// DisbandParty breaks up the party led by leaderID.
+func (m *Matchmaker) DisbandParty(leaderID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	party := m.partyOfLocked(leaderID)
+	if party == nil {
+		return ErrPartyNotFound
+	}
+	if party.LeaderID != leaderID {
+		return errors.New("only the party leader can disband it")
+	}
+	m.disbandLocked(party, party.MemberIDs)
+	return nil
+}
+
+// GetParty returns the party playerID belongs to, or nil.
+func (m *Matchmaker) GetParty(playerID string) *Party {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if party := m.partyOfLocked(playerID); party != nil {
+		return party.snapshot()
+	}
+	return nil
+}
+
+// QueueParty queues every member of the party led by leaderID in the named
+// queue. They are matched as one team in a team queue, which takes a party
+// of two, and never against each other in one-on-one games otherwise.
+func (m *Matchmaker) QueueParty(leaderID, queue string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	party := m.partyOfLocked(leaderID)
+	if party == nil {
+		return ErrPartyNotFound
+	}
+	if party.LeaderID != leaderID {
+		return errors.New("only the party leader can queue")
+	}
+	q := m.queues[queue]
+	if q == nil {
+		return ErrQueueNotFound
+	}
+	if q.Rules.Teams && len(party.MemberIDs) != 2 {
+		return errors.New("only a party of two can queue as a team")
+	}
+	for _, id := range party.MemberIDs {
+		if _, exists := m.playerMatch[id]; exists {
+			return errors.New("party member already has a pending match")
+		}
+	}
+
+	m.removePartyFromQueueLocked(party.ID)
+	joinedAt := time.Now()
+	for _, id := range party.MemberIDs {
+		m.removeFromQueueLocked(id)
+		m.queue = append(m.queue, QueueEntry{
+			PlayerID: id,
+			JoinedAt: joinedAt,
+			PartyID:  party.ID,
+			Queue:    queue,
+		})
+	}
+	m.notifyQueueChangedLocked()
+	return nil
+}
+
+func (m *Matchmaker) partyOfLocked(playerID string) *Party {
+	partyID, exists := m.playerParty[playerID]
+	if !exists {
+		return nil
+	}
+	return m.parties[partyID]
+}
+
+func (m *Matchmaker) disbandLocked(party *Party, concerned []string) {
+	m.removePartyFromQueueLocked(party.ID)
+	delete(m.parties, party.ID)
+	for _, id := range party.MemberIDs {
+		delete(m.playerParty, id)
+	}
+	if m.OnPartyDisbanded != nil {
+		go m.OnPartyDisbanded(slices.Clone(concerned), party.snapshot())
+	}
+}
+
+func (m *Matchmaker) notifyPartyChangedLocked(playerIDs []string, party *Party) {
+	if m.OnPartyChanged != nil {
+		go m.OnPartyChanged(slices.Clone(playerIDs), party.snapshot())
+	}
+}
@@ -0,0 +1,180 @@
+package matchmaker
+
+import (
+	"slices"
+	"testing"
+)
+
+// newTestParty returns a party led by leaderID with every member accepted.
+func newTestParty(t *testing.T, m *Matchmaker, leaderID string, memberIDs ...string) *Party {
+	t.Helper()
+	var party *Party
+	for _, id := range memberIDs {
+		invited, err := m.InviteToParty(leaderID, id)
+		if err != nil {
+			t.Fatalf("InviteToParty failed: %v", err)
+		}
+		if party, err = m.RespondToPartyInvite(invited.ID, id, true); err != nil {
+			t.Fatalf("RespondToPartyInvite failed: %v", err)
+		}
+	}
+	return party
+}
+
+func TestMatchmaker_Parties(t *testing.T) {
+	t.Run("invited players join the party", func(t *testing.T) {
+		m := newTestMatchmaker()
+		party := newTestParty(t, m, "p1", "p2", "p3")
+		if party.LeaderID != "p1" || !slices.Equal(party.MemberIDs, []string{"p1", "p2", "p3"}) {
+			t.Errorf("unexpected party: %+v", party)
+		}
+		if got := m.GetParty("p3"); got == nil || got.ID != party.ID {
+			t.Errorf("expected p3 to be in the party, got %+v", got)
+		}
+	})
+
+	t.Run("invitations", func(t *testing.T) {
+		m := newTestMatchmaker()
+		party := newTestParty(t, m, "p1", "p2")
+		if _, err := m.InviteToParty("p2", "p3"); err == nil {
+			t.Error("expected only the leader to invite")
+		}
+		if _, err := m.InviteToParty("p1", "p1"); err == nil {
+			t.Error("expected an error when inviting yourself")
+		}
+		if _, err := m.InviteToParty("p3", "p2"); err == nil {
+			t.Error("expected an error when inviting a member of another party")
+		}
+		if _, err := m.RespondToPartyInvite(party.ID, "p4", true); err == nil {
+			t.Error("expected an error for a player who was not invited")
+		}
+
+		m.InviteToParty("p1", "p4")
+		m.RespondToPartyInvite(party.ID, "p4", false)
+		if m.GetParty("p4") != nil {
+			t.Error("expected p4 to stay out after declining")
+		}
+	})
+
+	t.Run("the next member leads once the leader leaves", func(t *testing.T) {
+		m := newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2", "p3")
+		if err := m.LeaveParty("p1"); err != nil {
+			t.Fatalf("LeaveParty failed: %v", err)
+		}
+		party := m.GetParty("p2")
+		if party == nil || party.LeaderID != "p2" || len(party.MemberIDs) != 2 {
+			t.Errorf("expected p2 to lead what is left, got %+v", party)
+		}
+		if m.GetParty("p1") != nil {
+			t.Error("expected p1 out of the party")
+		}
+	})
+
+	t.Run("a party of one is disbanded", func(t *testing.T) {
+		m := newTestMatchmaker()
+		disbanded := make(chan []string, 1)
+		m.OnPartyDisbanded = func(playerIDs []string, party *Party) { disbanded <- playerIDs }
+		newTestParty(t, m, "p1", "p2")
+		m.LeaveParty("p2")
+
+		if got := <-disbanded; !slices.Equal(got, []string{"p1", "p2"}) {
+			t.Errorf("expected both players to be told, got %v", got)
+		}
+		if m.GetParty("p1") != nil {
+			t.Error("expected the party to be gone")
+		}
+	})
+
+	t.Run("only the leader disbands", func(t *testing.T) {
+		m := newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2", "p3")
+		if err := m.DisbandParty("p2"); err == nil {
+			t.Error("expected an error for a member")
+		}
+		if err := m.DisbandParty("p1"); err != nil {
+			t.Fatalf("DisbandParty failed: %v", err)
+		}
+		if m.GetParty("p2") != nil {
+			t.Error("expected the party to be gone")
+		}
+	})
+}
+
+func TestMatchmaker_QueueParty(t *testing.T) {
+	t.Run("the leader queues every member", func(t *testing.T) {
+		m := newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2")
+		if err := m.QueueParty("p2", DefaultQueue); err == nil {
+			t.Error("expected only the leader to queue")
+		}
+		if err := m.QueueParty("p1", DefaultQueue); err != nil {
+			t.Fatalf("QueueParty failed: %v", err)
+		}
+		if !slices.Equal(m.QueuedPlayers(), []string{"p1", "p2"}) {
+			t.Errorf("expected both members queued, got %v", m.QueuedPlayers())
+		}
+
+		m.LeaveQueue("p2")
+		if len(m.QueuedPlayers()) != 0 {
+			t.Errorf("expected the party to leave together, got %v", m.QueuedPlayers())
+		}
+	})
+
+	t.Run("membership changes take the party out of the queue", func(t *testing.T) {
+		m := newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2", "p3")
+		m.QueueParty("p1", DefaultQueue)
+		m.LeaveParty("p3")
+		if len(m.QueuedPlayers()) != 0 {
+			t.Errorf("expected the party out of the queue, got %v", m.QueuedPlayers())
+		}
+	})
+
+	t.Run("members are not matched against each other", func(t *testing.T) {
+		m := newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2")
+		m.QueueParty("p1", DefaultQueue)
+		m.tryAutoMatch()
+		if m.GetPendingMatch("p1") != nil {
+			t.Fatal("expected no match within the party")
+		}
+
+		m.JoinQueue("p3", DefaultQueue)
+		m.tryAutoMatch()
+		match := m.GetPendingMatch("p3")
+		if match == nil || !slices.Equal(match.PlayerIDs, []string{"p1", "p3"}) {
+			t.Fatalf("expected p1 to face p3, got %+v", match)
+		}
+		if !slices.Equal(m.QueuedPlayers(), []string{"p2"}) {
+			t.Errorf("expected p2 to keep waiting, got %v", m.QueuedPlayers())
+		}
+	})
+
+	t.Run("a party of two plays as a team", func(t *testing.T) {
+		m := newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2", "p3")
+		if err := m.QueueParty("p1", QueueTeams); err == nil {
+			t.Error("expected a party of three not to queue as a team")
+		}
+
+		m = newTestMatchmaker()
+		newTestParty(t, m, "p1", "p2")
+		m.JoinQueue("p3", QueueTeams)
+		m.QueueParty("p1", QueueTeams)
+		m.JoinQueue("p4", QueueTeams)
+		m.JoinQueue("p5", DefaultQueue)
+		m.tryAutoMatch()
+
+		match := m.GetPendingMatch("p1")
+		if match == nil || !match.Rules.Teams {
+			t.Fatalf("expected a team match, got %+v", match)
+		}
+		if !slices.Equal(match.PlayerIDs, []string{"p1", "p3", "p2", "p4"}) {
+			t.Errorf("expected the party to share a team, got %v", match.PlayerIDs)
+		}
+		if !slices.Equal(m.QueuedPlayers(), []string{"p5"}) {
+			t.Errorf("expected p5 to keep waiting, got %v", m.QueuedPlayers())
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package matchmaker
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+// Names of the default queues.
+const (
+	QueueCasual = "casual"
+	QueueRanked = "ranked"
+	QueueSalvo  = "salvo"
+	QueueQuick  = "quick"
+	QueueTeams  = "teams"
+
+	// DefaultQueue is where players go when they name no queue.
+	DefaultQueue = QueueRanked
+)
+
+const (
+	// ratingRange is how far apart the ratings of two players may be for
+	// StrategyRating to match them right away. It widens by
+	// ratingRangePerSecond for each second the first of them waited.
+	ratingRange          = 100
+	ratingRangePerSecond = 10
+
+	// waitSamples is the number of recent waits the estimated wait of a
+	// queue is averaged over.
+	waitSamples = 10
+)
+
+var ErrQueueNotFound = errors.New("queue not found")
+
+// Strategy is how a queue picks the players of its one-on-one matches.
+// Team queues always pair their teams in queue order.
+type Strategy int
+
+const (
+	// StrategyFirstCome matches the player waiting the longest with the
+	// next one.
+	StrategyFirstCome Strategy = iota
+	// StrategyRating matches the player waiting the longest with the
+	// closest rated player, within a rating range that widens as they wait.
+	StrategyRating
+)
+
+// Queue is a named matchmaking queue. Its matches are played with Rules,
+// 2v2 when Rules.Teams is set.
+type Queue struct {
+	Name     string
+	Rules    game.Rules
+	Strategy Strategy
+	// Timeout is how long a player waits in the queue before dropping out.
+	Timeout time.Duration
+	// AcceptTimeout is how long the players of a match have to accept it.
+	// Zero uses the matchmaker's match timeout.
+	AcceptTimeout time.Duration
+}
+
+// DefaultQueues returns the queues every matchmaker starts with.
+func DefaultQueues() []Queue {
+	casual := game.DefaultRules()
+	casual.Ranked = false
+
+	salvo := game.DefaultRules()
+	salvo.RuleSet = piratesv1.RuleSet_RULE_SET_SALVO
+
+	quick := casual
+	quick.TurnTime = 15 * time.Second
+
+	teams := game.DefaultRules()
+	teams.Teams = true
+
+	return []Queue{
+		{Name: QueueCasual, Rules: casual, Timeout: 30 * time.Second},
+		{Name: QueueRanked, Rules: game.DefaultRules(), Strategy: StrategyRating, Timeout: 2 * time.Minute},
+		{Name: QueueSalvo, Rules: salvo, Timeout: 30 * time.Second},
+		{Name: QueueQuick, Rules: quick, Timeout: 30 * time.Second, AcceptTimeout: 10 * time.Second},
+		{Name: QueueTeams, Rules: teams, Timeout: time.Minute},
+	}
+}
+
+// QueueInfo describes a queue as it is now.
+type QueueInfo struct {
+	Queue
+	Players int
+	// EstimatedWait is the average wait of the players it matched last.
+	// Zero means it matched no one yet.
+	EstimatedWait time.Duration
+}
+
+type queueState struct {
+	Queue
+	avgWait time.Duration
+	matched int
+}
+
+// recordWait counts the wait of a player the queue just matched.
+func (q *queueState) recordWait(wait time.Duration) {
+	q.matched = min(q.matched+1, waitSamples)
+	q.avgWait += (wait - q.avgWait) / time.Duration(q.matched)
+}
+
+// SetQueues replaces the queues. Players waiting in a queue that is gone
+// drop out of it.
+func (m *Matchmaker) SetQueues(queues []Queue) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.queues = make(map[string]*queueState, len(queues))
+	m.queueNames = make([]string, 0, len(queues))
+	for _, q := range queues {
+		m.queues[q.Name] = &queueState{Queue: q}
+		m.queueNames = append(m.queueNames, q.Name)
+	}
+
+	n := len(m.queue)
+	m.queue = slices.DeleteFunc(m.queue, func(entry QueueEntry) bool {
+		return m.queues[entry.Queue] == nil
+	})
+	if len(m.queue) != n {
+		m.notifyQueueChangedLocked()
+	}
+}
+
+// Queues describes every queue, in the order they were set.
+func (m *Matchmaker) Queues() []QueueInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	players := make(map[string]int, len(m.queues))
+	for _, entry := range m.queue {
+		players[entry.Queue]++
+	}
+	infos := make([]QueueInfo, 0, len(m.queueNames))
+	for _, name := range m.queueNames {
+		q := m.queues[name]
+		infos = append(infos, QueueInfo{
+			Queue:         q.Queue,
+			Players:       players[name],
+			EstimatedWait: q.avgWait,
+		})
+	}
+	return infos
+}
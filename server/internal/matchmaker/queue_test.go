@@ -0,0 +1,145 @@
+package matchmaker
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// waitedFor makes playerID look like they joined their queue d ago.
+func waitedFor(m *Matchmaker, playerID string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queue[m.indexLocked(playerID)].JoinedAt = time.Now().Add(-d)
+}
+
+func TestMatchmaker_Queues(t *testing.T) {
+	t.Run("queues are matched apart", func(t *testing.T) {
+		m := newTestMatchmaker()
+		m.JoinQueue("p1", QueueCasual)
+		m.JoinQueue("p2", QueueSalvo)
+		m.tryAutoMatch()
+		if m.GetPendingMatch("p1") != nil {
+			t.Fatal("expected no match across queues")
+		}
+
+		m.JoinQueue("p3", QueueSalvo)
+		m.tryAutoMatch()
+		match := m.GetPendingMatch("p3")
+		if match == nil || !slices.Equal(match.PlayerIDs, []string{"p2", "p3"}) {
+			t.Fatalf("expected p2 to face p3, got %+v", match)
+		}
+		if match.Rules.RuleSet != piratesv1.RuleSet_RULE_SET_SALVO {
+			t.Errorf("expected the salvo rules, got %+v", match.Rules)
+		}
+	})
+
+	t.Run("joining another queue leaves the first", func(t *testing.T) {
+		m := newTestMatchmaker()
+		m.JoinQueue("p1", QueueCasual)
+		m.JoinQueue("p2", QueueCasual)
+		if pos, total, _ := m.JoinQueue("p1", QueueSalvo); pos != 1 || total != 1 {
+			t.Errorf("expected position 1, total 1, got %d, %d", pos, total)
+		}
+		if pos, total := m.GetQueuePosition("p2"); pos != 1 || total != 1 {
+			t.Errorf("expected position 1, total 1, got %d, %d", pos, total)
+		}
+	})
+
+	t.Run("unknown queue", func(t *testing.T) {
+		m := newTestMatchmaker()
+		if _, _, err := m.JoinQueue("p1", "nope"); err != ErrQueueNotFound {
+			t.Errorf("expected ErrQueueNotFound, got %v", err)
+		}
+		newTestParty(t, m, "p1", "p2")
+		if err := m.QueueParty("p1", "nope"); err != ErrQueueNotFound {
+			t.Errorf("expected ErrQueueNotFound, got %v", err)
+		}
+	})
+
+	t.Run("each queue has its timeout", func(t *testing.T) {
+		m := newTestMatchmaker()
+		m.JoinQueue("p1", QueueCasual)
+		m.JoinQueue("p2", QueueRanked)
+		waitedFor(m, "p1", time.Minute)
+		waitedFor(m, "p2", time.Minute)
+		m.cleanupExpiredQueueEntries()
+		if !slices.Equal(m.QueuedPlayers(), []string{"p2"}) {
+			t.Errorf("expected only p2 to keep waiting, got %v", m.QueuedPlayers())
+		}
+	})
+
+	t.Run("each queue has its accept timeout", func(t *testing.T) {
+		m := newTestMatchmaker()
+		m.JoinQueue("p1", QueueQuick)
+		m.JoinQueue("p2", QueueQuick)
+		m.tryAutoMatch()
+		match := m.GetPendingMatch("p1")
+		if match == nil || time.Until(match.ExpiresAt) > 10*time.Second {
+			t.Fatalf("expected a match to accept within 10s, got %+v", match)
+		}
+	})
+
+	t.Run("population and estimated wait", func(t *testing.T) {
+		m := newTestMatchmaker()
+		m.JoinQueue("p1", QueueCasual)
+		m.JoinQueue("p2", QueueCasual)
+		m.JoinQueue("p3", QueueSalvo)
+		waitedFor(m, "p1", 20*time.Second)
+		waitedFor(m, "p2", 10*time.Second)
+		m.tryAutoMatch()
+
+		infos := m.Queues()
+		names := make([]string, len(infos))
+		for i, info := range infos {
+			names[i] = info.Name
+		}
+		if !slices.Equal(names, []string{QueueCasual, QueueRanked, QueueSalvo, QueueQuick, QueueTeams}) {
+			t.Fatalf("unexpected queues: %v", names)
+		}
+		if wait := infos[0].EstimatedWait; wait < 14*time.Second || wait > 16*time.Second {
+			t.Errorf("expected casual players to wait about 15s, got %v", wait)
+		}
+		if infos[0].Players != 0 || infos[2].Players != 1 || infos[2].EstimatedWait != 0 {
+			t.Errorf("unexpected queues: %+v", infos)
+		}
+	})
+}
+
+func TestMatchmaker_RatingStrategy(t *testing.T) {
+	newRatedMatchmaker := func(ratings map[string]int) *Matchmaker {
+		m := newTestMatchmaker()
+		m.Rating = func(playerID string) int { return ratings[playerID] }
+		return m
+	}
+
+	t.Run("the closest rated player is picked", func(t *testing.T) {
+		m := newRatedMatchmaker(map[string]int{"p1": 1000, "p2": 1080, "p3": 1020})
+		for _, id := range []string{"p1", "p2", "p3"} {
+			m.JoinQueue(id, QueueRanked)
+		}
+		m.tryAutoMatch()
+		match := m.GetPendingMatch("p1")
+		if match == nil || !slices.Equal(match.PlayerIDs, []string{"p1", "p3"}) {
+			t.Fatalf("expected p1 to face p3, got %+v", match)
+		}
+	})
+
+	t.Run("the range widens with the wait", func(t *testing.T) {
+		m := newRatedMatchmaker(map[string]int{"p1": 1000, "p2": 1300})
+		m.JoinQueue("p1", QueueRanked)
+		m.JoinQueue("p2", QueueRanked)
+		m.tryAutoMatch()
+		if m.GetPendingMatch("p1") != nil {
+			t.Fatal("expected players too far apart not to be matched")
+		}
+
+		waitedFor(m, "p1", 20*time.Second)
+		m.tryAutoMatch()
+		if m.GetPendingMatch("p1") == nil {
+			t.Fatal("expected a match once p1 waited")
+		}
+	})
+}
@@ -3,12 +3,15 @@ package player
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 var pirateNamePrefixes = []string{
@@ -42,24 +45,46 @@ var pirateNameSuffixes = []string{
 	"le Mystérieux",
 }
 
+var (
+	ErrPlayerNotFound = errors.New("player not found")
+	// ErrAmbiguousName is returned when several players go by the name
+	// looked up, so that none is picked in the place of another.
+	ErrAmbiguousName = errors.New("several players go by that name")
+)
+
+// ResumeWindow is how long a removed player can come back as the same
+// player with their last session token.
+const ResumeWindow = 24 * time.Hour
+
 type Player struct {
 	Proto         *piratesv1.Player
 	SessionToken  string
 	CurrentGameID string
 	EventChannel  chan *piratesv1.GameEvent
 	LastSeen      time.Time
+	// Connected is true while the player has an open event stream.
+	Connected bool
 }
 
 type Registry struct {
 	mu            sync.RWMutex
 	players       map[string]*Player
 	tokenToPlayer map[string]*Player
+	// retired maps the session tokens of removed players to their ID, so
+	// that they can come back as the same player within ResumeWindow.
+	retired map[string]retirement
+}
+
+type retirement struct {
+	id string
+	at time.Time
 }
 
 func NewRegistry() *Registry {
 	return &Registry{
 		players:       make(map[string]*Player),
 		tokenToPlayer: make(map[string]*Player),
+		retired:       make(map[string]retirement),
 	}
 }
 
@@ -67,11 +92,32 @@ func (r *Registry) Register(displayName string) (*Player, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	return r.registerLocked(uuid.New().String(), displayName)
+}
+
+// Resume brings back the player removed while holding the session token,
+// with their ID and a new session token. A token still in use returns its
+// player as is, and an unknown one registers a new player.
+func (r *Registry) Resume(token, displayName string) (*Player, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if player, ok := r.tokenToPlayer[token]; ok {
+		return player, nil
+	}
+	id := uuid.New().String()
+	if retired, ok := r.retired[token]; ok && time.Since(retired.at) <= ResumeWindow {
+		id = retired.id
+	}
+	delete(r.retired, token)
+	return r.registerLocked(id, displayName)
+}
+
+func (r *Registry) registerLocked(id, displayName string) (*Player, error) {
 	if displayName == "" {
 		displayName = generatePirateName()
 	}
 
-	id := uuid.New().String()
 	token, err := generateSessionToken()
 	if err != nil {
 		return nil, err
@@ -102,6 +148,28 @@ func (r *Registry) GetByID(id string) (*Player, bool) {
 	return player, ok
 }
 
+// FindByName returns the player going by displayName, compared without
+// regard to case. It fails with ErrAmbiguousName when several do.
+func (r *Registry) FindByName(displayName string) (*Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var found *Player
+	for _, player := range r.players {
+		if !strings.EqualFold(player.Proto.DisplayName, displayName) {
+			continue
+		}
+		if found != nil {
+			return nil, ErrAmbiguousName
+		}
+		found = player
+	}
+	if found == nil {
+		return nil, ErrPlayerNotFound
+	}
+	return found, nil
+}
+
 func (r *Registry) GetByToken(token string) (*Player, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -110,6 +178,19 @@ func (r *Registry) GetByToken(token string) (*Player, bool) {
 	return player, ok
 }
 
+// Snapshot returns a copy of the player's public info, safe to send while the
+// registry keeps mutating the original.
+func (r *Registry) Snapshot(id string) (*piratesv1.Player, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	player, ok := r.players[id]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(player.Proto).(*piratesv1.Player), true
+}
+
 func (r *Registry) SetStatus(id string, status piratesv1.PlayerStatus) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -138,11 +219,29 @@ func (r *Registry) Remove(id string) {
 
 	if player, ok := r.players[id]; ok {
 		delete(r.tokenToPlayer, player.SessionToken)
+		r.retired[player.SessionToken] = retirement{id: id, at: time.Now()}
 		close(player.EventChannel)
 		delete(r.players, id)
 	}
 }
 
+// SendEvent delivers an event to a registered player without blocking. It is
+// a no-op once the player has been removed, so callers never send on a closed
+// channel.
+func (r *Registry) SendEvent(id string, event *piratesv1.GameEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	player, ok := r.players[id]
+	if !ok {
+		return
+	}
+	select {
+	case player.EventChannel <- event:
+	default:
+	}
+}
+
 func (r *Registry) UpdateLastSeen(id string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -152,18 +251,77 @@ func (r *Registry) UpdateLastSeen(id string) {
 	}
 }
 
-func (r *Registry) CleanupStale(timeout time.Duration) {
+// SetConnected records whether the player currently has an open event
+// stream. Connecting or disconnecting counts as activity.
+func (r *Registry) SetConnected(id string, connected bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if player, ok := r.players[id]; ok {
+		player.Connected = connected
+		player.LastSeen = time.Now()
+	}
+}
+
+// SetCurrentGame records the game the player is in, or none when gameID is
+// empty.
+func (r *Registry) SetCurrentGame(id, gameID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if player, ok := r.players[id]; ok {
+		player.CurrentGameID = gameID
+	}
+}
+
+// CurrentGame returns the ID of the game the player is in, or "".
+func (r *Registry) CurrentGame(id string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if player, ok := r.players[id]; ok {
+		return player.CurrentGameID
+	}
+	return ""
+}
+
+// IsConnected reports whether the player has an open event stream.
+func (r *Registry) IsConnected(id string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	player, ok := r.players[id]
+	return ok && player.Connected
+}
+
+// CleanupStale removes players that have been inactive for longer than
+// timeout and returns their IDs. Players with an open event stream or an
+// active game are kept: the game owns their disconnect handling. Session
+// tokens retired for longer than ResumeWindow are forgotten.
+func (r *Registry) CleanupStale(timeout time.Duration) []string {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	var removed []string
 	now := time.Now()
 	for id, player := range r.players {
+		if player.Connected || player.CurrentGameID != "" {
+			continue
+		}
 		if now.Sub(player.LastSeen) > timeout {
 			delete(r.tokenToPlayer, player.SessionToken)
+			r.retired[player.SessionToken] = retirement{id: id, at: now}
 			close(player.EventChannel)
 			delete(r.players, id)
+			removed = append(removed, id)
+		}
+	}
+	for token, retired := range r.retired {
+		if now.Sub(retired.at) > ResumeWindow {
+			delete(r.retired, token)
 		}
 	}
+	return removed
 }
 
 func generatePirateName() string {
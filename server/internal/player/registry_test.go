@@ -2,6 +2,7 @@ package player
 
 import (
 	"testing"
+	"time"
 
 	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
 )
@@ -39,6 +40,43 @@ func TestRegistry_Register(t *testing.T) {
 	})
 }
 
+func TestRegistry_Resume(t *testing.T) {
+	r := NewRegistry()
+	p, _ := r.Register("TestPlayer")
+
+	if resumed, _ := r.Resume(p.SessionToken, "Other"); resumed != p {
+		t.Error("expected a token in use to return its player")
+	}
+
+	r.Remove(p.Proto.Id)
+	resumed, err := r.Resume(p.SessionToken, "TestPlayer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resumed.Proto.Id != p.Proto.Id || resumed.SessionToken == p.SessionToken {
+		t.Errorf("expected the same player with a new session, got %v", resumed.Proto)
+	}
+
+	if other, _ := r.Resume("unknown-token", "Other"); other.Proto.Id == p.Proto.Id {
+		t.Error("expected an unknown token to register a new player")
+	}
+
+	t.Run("tokens expire", func(t *testing.T) {
+		r.Remove(resumed.Proto.Id)
+		expired := r.retired[resumed.SessionToken]
+		expired.at = time.Now().Add(-ResumeWindow - time.Minute)
+		r.retired[resumed.SessionToken] = expired
+
+		r.CleanupStale(30 * time.Second)
+		if _, ok := r.retired[resumed.SessionToken]; ok {
+			t.Error("expected the expired token to be forgotten")
+		}
+		if again, _ := r.Resume(resumed.SessionToken, "TestPlayer"); again.Proto.Id == p.Proto.Id {
+			t.Error("expected an expired token not to resume")
+		}
+	})
+}
+
 func TestRegistry_GetByID(t *testing.T) {
 	r := NewRegistry()
 	p, _ := r.Register("TestPlayer")
@@ -83,6 +121,31 @@ func TestRegistry_GetByToken(t *testing.T) {
 	})
 }
 
+func TestRegistry_FindByName(t *testing.T) {
+	r := NewRegistry()
+	p, _ := r.Register("TestPlayer")
+
+	t.Run("any case", func(t *testing.T) {
+		found, err := r.FindByName("testplayer")
+		if err != nil || found.Proto.Id != p.Proto.Id {
+			t.Error("expected to find player")
+		}
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		if _, err := r.FindByName("Nobody"); err != ErrPlayerNotFound {
+			t.Errorf("expected ErrPlayerNotFound, got %v", err)
+		}
+	})
+
+	t.Run("name shared by several players", func(t *testing.T) {
+		r.Register("TESTPLAYER")
+		if _, err := r.FindByName("TestPlayer"); err != ErrAmbiguousName {
+			t.Errorf("expected ErrAmbiguousName, got %v", err)
+		}
+	})
+}
+
 func TestRegistry_SetStatus(t *testing.T) {
 	r := NewRegistry()
 	p, _ := r.Register("TestPlayer")
@@ -127,3 +190,32 @@ func TestRegistry_Remove(t *testing.T) {
 		t.Error("expected token mapping to be removed")
 	}
 }
+
+func TestRegistry_CleanupStale(t *testing.T) {
+	r := NewRegistry()
+	idle, _ := r.Register("Idle")
+	connected, _ := r.Register("Connected")
+	inGame, _ := r.Register("InGame")
+
+	r.SetConnected(connected.Proto.Id, true)
+	r.SetCurrentGame(inGame.Proto.Id, "game-1")
+
+	for _, p := range []*Player{idle, connected, inGame} {
+		p.LastSeen = time.Now().Add(-time.Minute)
+	}
+
+	removed := r.CleanupStale(30 * time.Second)
+
+	if len(removed) != 1 || removed[0] != idle.Proto.Id {
+		t.Fatalf("expected only the idle player to be removed, got %v", removed)
+	}
+	if _, ok := r.GetByID(connected.Proto.Id); !ok {
+		t.Error("expected connected player to be kept")
+	}
+	if _, ok := r.GetByID(inGame.Proto.Id); !ok {
+		t.Error("expected in-game player to be kept")
+	}
+	if r.CurrentGame(inGame.Proto.Id) != "game-1" {
+		t.Errorf("expected the in-game player in game-1, got %q", r.CurrentGame(inGame.Proto.Id))
+	}
+}
@@ -0,0 +1,279 @@
+// Package puzzle runs the daily puzzle: a hidden fleet, the same for every
+// player on a given day, to sink in as few shots as possible. The fleet and
+// the powers handed out are derived from the date and a server secret, so
+// that they cannot be worked out from the source code ahead of time.
+package puzzle
+
+import (
+	"cmp"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	mathrand "math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+// DateLayout formats the puzzle dates, which follow UTC days.
+const DateLayout = "2006-01-02"
+
+// LeaderboardSize caps the scores returned for a day.
+const LeaderboardSize = 100
+
+// keepDays is how long past puzzles and their leaderboards are kept.
+const keepDays = 7
+
+var (
+	ErrNoPuzzle      = errors.New("no puzzle started")
+	ErrPuzzleExpired = errors.New("puzzle no longer available")
+)
+
+// offensivePowers are the powers a puzzle may hand out.
+var offensivePowers = []piratesv1.PowerType{
+	piratesv1.PowerType_POWER_TYPE_INSTAKILL,
+	piratesv1.PowerType_POWER_TYPE_TRIPLE,
+	piratesv1.PowerType_POWER_TYPE_SONAR,
+	piratesv1.PowerType_POWER_TYPE_KRAKEN,
+}
+
+// Attempt is a player's try at a day's puzzle. Each player gets one per
+// day: starting the puzzle again resumes it.
+type Attempt struct {
+	PlayerID    string
+	DisplayName string
+	Date        string
+	Game        *game.Game
+	StartedAt   time.Time
+	// FinishedAt is set once the fleet is sunk.
+	FinishedAt time.Time
+}
+
+func (a *Attempt) solved() bool {
+	return !a.FinishedAt.IsZero()
+}
+
+func (a *Attempt) shots() int {
+	ps, _ := a.Game.GetPlayerState(a.PlayerID)
+	return ps.Stats.ShotsFired
+}
+
+type day struct {
+	fleet    []*piratesv1.Ship
+	powers   map[piratesv1.PowerType]int
+	attempts map[string]*Attempt
+}
+
+// Manager holds the puzzles of the last few days and the players' attempts
+// at them.
+type Manager struct {
+	mu     sync.Mutex
+	secret []byte
+	days   map[string]*day
+	// current is the attempt each player last started, which their shots
+	// go to.
+	current map[string]*Attempt
+}
+
+// NewManager creates a manager deriving the puzzles from secret. An empty
+// secret is replaced with a random one, which changes the puzzles on every
+// restart.
+func NewManager(secret string) *Manager {
+	key := []byte(secret)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		rand.Read(key)
+	}
+	return &Manager{
+		secret:  key,
+		days:    make(map[string]*day),
+		current: make(map[string]*Attempt),
+	}
+}
+
+// Date returns the puzzle date of t.
+func Date(t time.Time) string {
+	return t.UTC().Format(DateLayout)
+}
+
+// Start starts playerID's attempt at the puzzle of now's date, or resumes
+// it.
+func (m *Manager) Start(playerID, displayName string, now time.Time) (*piratesv1.DailyPuzzle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	date := Date(now)
+	m.pruneLocked(now)
+	d := m.dayLocked(date)
+	a, exists := d.attempts[playerID]
+	if !exists {
+		g, err := game.NewPuzzleGame(uuid.New().String(), playerID, d.fleet, d.powers)
+		if err != nil {
+			return nil, err
+		}
+		a = &Attempt{
+			PlayerID:    playerID,
+			DisplayName: displayName,
+			Date:        date,
+			Game:        g,
+			StartedAt:   now,
+		}
+		d.attempts[playerID] = a
+	}
+	m.current[playerID] = a
+	return m.puzzleLocked(a), nil
+}
+
+// Attack fires at (x, y) in playerID's current attempt.
+func (m *Manager) Attack(playerID string, x, y int, now time.Time) (*piratesv1.AttackResult, *piratesv1.DailyPuzzle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, err := m.currentLocked(playerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := a.Game.Attack(playerID, x, y)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.checkSolvedLocked(a, now)
+	return result, m.puzzleLocked(a), nil
+}
+
+// UsePower uses one of the puzzle's powers in playerID's current attempt.
+func (m *Manager) UsePower(playerID string, power piratesv1.PowerType, x, y int, o piratesv1.Orientation, now time.Time) (*piratesv1.PowerResult, *piratesv1.DailyPuzzle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, err := m.currentLocked(playerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := a.Game.UsePower(playerID, power, x, y, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.checkSolvedLocked(a, now)
+	return result, m.puzzleLocked(a), nil
+}
+
+// Leaderboard ranks the solved attempts at date's puzzle by shots, then by
+// time taken.
+func (m *Manager) Leaderboard(date string) *piratesv1.PuzzleLeaderboard {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	board := &piratesv1.PuzzleLeaderboard{Date: date}
+	for i, a := range m.rankedLocked(date) {
+		if i == LeaderboardSize {
+			break
+		}
+		board.Scores = append(board.Scores, &piratesv1.PuzzleScore{
+			Rank:            int32(i + 1),
+			PlayerId:        a.PlayerID,
+			DisplayName:     a.DisplayName,
+			Shots:           int32(a.shots()),
+			DurationSeconds: int32(a.FinishedAt.Sub(a.StartedAt).Seconds()),
+		})
+	}
+	return board
+}
+
+// Forget drops playerID's current attempt, which stays on the leaderboard
+// and can be resumed the same day.
+func (m *Manager) Forget(playerID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.current, playerID)
+}
+
+func (m *Manager) currentLocked(playerID string) (*Attempt, error) {
+	a, exists := m.current[playerID]
+	if !exists {
+		return nil, ErrNoPuzzle
+	}
+	if _, exists := m.days[a.Date]; !exists {
+		delete(m.current, playerID)
+		return nil, ErrPuzzleExpired
+	}
+	return a, nil
+}
+
+func (m *Manager) checkSolvedLocked(a *Attempt, now time.Time) {
+	if !a.solved() && a.Game.CheckVictory() {
+		a.FinishedAt = now
+	}
+}
+
+// dayLocked returns date's puzzle, generating it on first use.
+func (m *Manager) dayLocked(date string) *day {
+	if d, exists := m.days[date]; exists {
+		return d
+	}
+
+	sum := sha256.Sum256(append(slices.Clone(m.secret), date...))
+	rng := mathrand.New(mathrand.NewPCG(binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:16])))
+	d := &day{
+		fleet:    game.RandomFleet(rng),
+		powers:   make(map[piratesv1.PowerType]int),
+		attempts: make(map[string]*Attempt),
+	}
+	for range rng.IntN(3) {
+		d.powers[offensivePowers[rng.IntN(len(offensivePowers))]]++
+	}
+	m.days[date] = d
+	return d
+}
+
+// pruneLocked drops the puzzles older than keepDays.
+func (m *Manager) pruneLocked(now time.Time) {
+	oldest := Date(now.AddDate(0, 0, -keepDays))
+	for date := range m.days {
+		if date < oldest {
+			delete(m.days, date)
+		}
+	}
+}
+
+func (m *Manager) rankedLocked(date string) []*Attempt {
+	d, exists := m.days[date]
+	if !exists {
+		return nil
+	}
+	var solved []*Attempt
+	for _, a := range d.attempts {
+		if a.solved() {
+			solved = append(solved, a)
+		}
+	}
+	slices.SortFunc(solved, func(a, b *Attempt) int {
+		if a.shots() != b.shots() {
+			return a.shots() - b.shots()
+		}
+		return cmp.Compare(a.FinishedAt.Sub(a.StartedAt), b.FinishedAt.Sub(b.StartedAt))
+	})
+	return solved
+}
+
+// puzzleLocked describes a as its player sees it. The board only shows what
+// was learned by firing at it: the fleet itself never leaves the server.
+func (m *Manager) puzzleLocked(a *Attempt) *piratesv1.DailyPuzzle {
+	view, _ := a.Game.View(a.PlayerID)
+	puzzle := &piratesv1.DailyPuzzle{
+		Date:            a.Date,
+		AvailablePowers: a.Game.GetPlayerPowers(a.PlayerID),
+		Board:           view.OpponentBoard,
+		Shots:           int32(a.shots()),
+		Solved:          a.solved(),
+	}
+	if a.solved() {
+		puzzle.Rank = int32(slices.Index(m.rankedLocked(a.Date), a) + 1)
+	}
+	return puzzle
+}
@@ -0,0 +1,137 @@
+package puzzle
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+var today = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// fleetCells returns every cell of date's hidden fleet.
+func fleetCells(m *Manager, date string) []game.Coordinate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var cells []game.Coordinate
+	for _, ship := range m.dayLocked(date).fleet {
+		for i := 0; i < int(ship.Size); i++ {
+			c := game.Coordinate{X: int(ship.Start.X), Y: int(ship.Start.Y)}
+			if ship.Horizontal {
+				c.X += i
+			} else {
+				c.Y += i
+			}
+			cells = append(cells, c)
+		}
+	}
+	return cells
+}
+
+// solve sinks playerID's puzzle, firing misses first when given a cell
+// outside the fleet.
+func solve(t *testing.T, m *Manager, playerID string, misses []game.Coordinate, now time.Time) *piratesv1.DailyPuzzle {
+	t.Helper()
+	var daily *piratesv1.DailyPuzzle
+	var err error
+	for _, c := range append(misses, fleetCells(m, Date(now))...) {
+		if _, daily, err = m.Attack(playerID, c.X, c.Y, now); err != nil {
+			t.Fatalf("Attack(%d, %d) failed: %v", c.X, c.Y, err)
+		}
+	}
+	return daily
+}
+
+func TestManager_Puzzles(t *testing.T) {
+	t.Run("same date same puzzle", func(t *testing.T) {
+		a, b := NewManager("secret"), NewManager("secret")
+		if !reflect.DeepEqual(fleetCells(a, "2025-06-01"), fleetCells(b, "2025-06-01")) {
+			t.Error("expected the same fleet for the same secret and date")
+		}
+		if reflect.DeepEqual(fleetCells(a, "2025-06-01"), fleetCells(a, "2025-06-02")) {
+			t.Error("expected a new fleet the next day")
+		}
+		if reflect.DeepEqual(fleetCells(a, "2025-06-01"), fleetCells(NewManager("other"), "2025-06-01")) {
+			t.Error("expected the secret to change the fleet")
+		}
+	})
+
+	t.Run("the fleet stays hidden", func(t *testing.T) {
+		m := NewManager("secret")
+		daily, err := m.Start("p1", "Alice", today)
+		if err != nil {
+			t.Fatalf("Start failed: %v", err)
+		}
+		if daily.Date != "2025-06-01" || len(daily.Board.Ships) != 0 || len(daily.Board.Cells) != 0 {
+			t.Errorf("unexpected puzzle: %v", daily)
+		}
+	})
+
+	t.Run("starting again resumes", func(t *testing.T) {
+		m := NewManager("secret")
+		m.Start("p1", "Alice", today)
+		m.Attack("p1", 0, 0, today)
+		daily, _ := m.Start("p1", "Alice", today.Add(time.Hour))
+		if daily.Shots != 1 {
+			t.Errorf("expected the attempt to resume with 1 shot, got %d", daily.Shots)
+		}
+	})
+
+	t.Run("shots need a started puzzle", func(t *testing.T) {
+		m := NewManager("secret")
+		if _, _, err := m.Attack("p1", 0, 0, today); err != ErrNoPuzzle {
+			t.Errorf("expected ErrNoPuzzle, got %v", err)
+		}
+	})
+}
+
+func TestManager_Leaderboard(t *testing.T) {
+	m := NewManager("secret")
+	fleet := make(map[game.Coordinate]bool)
+	for _, c := range fleetCells(m, Date(today)) {
+		fleet[c] = true
+	}
+	var misses []game.Coordinate
+	for x := 0; len(misses) < 2; x++ {
+		for y := 0; y < game.GridSize && len(misses) < 2; y++ {
+			if c := (game.Coordinate{X: x, Y: y}); !fleet[c] {
+				misses = append(misses, c)
+			}
+		}
+	}
+
+	for _, id := range []string{"p1", "p2", "p3", "p4"} {
+		m.Start(id, "Player "+id, today)
+	}
+	solve(t, m, "p1", misses, today.Add(time.Minute))
+	solve(t, m, "p2", nil, today.Add(2*time.Minute))
+	solve(t, m, "p3", nil, today.Add(time.Minute))
+	daily := solve(t, m, "p4", misses[:1], today.Add(time.Minute))
+
+	if !daily.Solved || daily.Rank != 3 || daily.Shots != 18 {
+		t.Errorf("unexpected puzzle: %v", daily)
+	}
+
+	board := m.Leaderboard(Date(today))
+	var ranked []string
+	for _, score := range board.Scores {
+		ranked = append(ranked, score.PlayerId)
+	}
+	if want := []string{"p3", "p2", "p4", "p1"}; !reflect.DeepEqual(ranked, want) {
+		t.Errorf("expected %v, got %v", want, ranked)
+	}
+	if board.Scores[0].DisplayName != "Player p3" || board.Scores[0].Shots != 17 || board.Scores[0].DurationSeconds != 60 {
+		t.Errorf("unexpected score: %v", board.Scores[0])
+	}
+
+	if _, _, err := m.Attack("p1", misses[0].X, misses[0].Y, today); err == nil {
+		t.Error("expected shots at a solved puzzle to fail")
+	}
+
+	m.Start("p1", "Player p1", today.AddDate(0, 0, keepDays+1))
+	if board := m.Leaderboard(Date(today)); len(board.Scores) != 0 {
+		t.Errorf("expected old leaderboards to be dropped, got %v", board.Scores)
+	}
+}
@@ -0,0 +1,348 @@
+// Package stats keeps the players' records. Every finished game updates the
+// statistics and the rating of its players, which the leaderboards rank.
+package stats
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+const (
+	// InitialRating is the rating of a player before their first game.
+	InitialRating = 1000
+	// kFactor caps the rating a player wins or loses in a game.
+	kFactor = 32
+
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+
+	week = 7 * 24 * time.Hour
+)
+
+var (
+	ErrPlayerNotFound   = errors.New("player not found")
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidScope     = errors.New("invalid leaderboard scope")
+)
+
+// Game is a finished game to record.
+type Game struct {
+	ID       string
+	RuleSet  piratesv1.RuleSet
+	EndedAt  time.Time
+	Outcomes []game.Outcome
+	// Names holds the players' display names.
+	Names map[string]string
+	// Unranked games count in the players' statistics, but leave their
+	// rating and the leaderboards alone.
+	Unranked bool
+}
+
+// played is one game of a player.
+type played struct {
+	gameID    string
+	ranked    bool
+	ruleSet   piratesv1.RuleSet
+	endedAt   time.Time
+	won       bool
+	forfeited bool
+	stats     game.PlayerStats
+	rating    int
+	change    int
+}
+
+type record struct {
+	playerID    string
+	displayName string
+	rating      int
+	games       []played
+}
+
+// Recorder holds every player's record.
+type Recorder struct {
+	mu       sync.RWMutex
+	players  map[string]*record
+	recorded map[string]bool
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		players:  make(map[string]*record),
+		recorded: make(map[string]bool),
+	}
+}
+
+// Record updates the records of g's players, once per game, and reports
+// whether it did, with the rating change of each player. Games that ended
+// without a winner are left out.
+func (r *Recorder) Record(g Game) (changes map[string]int, ok bool) {
+	if !slices.ContainsFunc(g.Outcomes, func(o game.Outcome) bool { return o.Won }) {
+		return nil, false
+	}
+	if g.RuleSet == piratesv1.RuleSet_RULE_SET_UNSPECIFIED {
+		g.RuleSet = piratesv1.RuleSet_RULE_SET_CLASSIC
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.recorded[g.ID] {
+		return nil, false
+	}
+	r.recorded[g.ID] = true
+
+	changes = make(map[string]int)
+	if !g.Unranked {
+		changes = r.ratingChangesLocked(g.Outcomes)
+	}
+	for _, o := range g.Outcomes {
+		rec := r.recordLocked(o.PlayerID)
+		if name := g.Names[o.PlayerID]; name != "" {
+			rec.displayName = name
+		}
+		rec.rating += changes[o.PlayerID]
+		left := o.Reason == piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT ||
+			o.Reason == piratesv1.GameOverReason_GAME_OVER_REASON_DISCONNECT
+		rec.games = append(rec.games, played{
+			gameID:    g.ID,
+			ranked:    !g.Unranked,
+			ruleSet:   g.RuleSet,
+			endedAt:   g.EndedAt,
+			won:       o.Won,
+			forfeited: !o.Won && left,
+			stats:     o.Stats,
+			rating:    rec.rating,
+			change:    changes[o.PlayerID],
+		})
+	}
+	return changes, true
+}
+
+// ratingChangesLocked rates every player against each opponent on the
+// other side of the result: a winner against every loser of another team,
+// and the other way around. Losers of a free-for-all are not rated against
+// each other.
+func (r *Recorder) ratingChangesLocked(outcomes []game.Outcome) map[string]int {
+	changes := make(map[string]int, len(outcomes))
+	for _, a := range outcomes {
+		var sum float64
+		var n int
+		for _, b := range outcomes {
+			if a.Team == b.Team || a.Won == b.Won {
+				continue
+			}
+			score := 0.0
+			if a.Won {
+				score = 1
+			}
+			sum += score - expectedScore(r.ratingLocked(a.PlayerID), r.ratingLocked(b.PlayerID))
+			n++
+		}
+		if n > 0 {
+			changes[a.PlayerID] = int(math.Round(kFactor * sum / float64(n)))
+		}
+	}
+	return changes
+}
+
+// expectedScore is the Elo expectation of a player rated a against one
+// rated b.
+func expectedScore(a, b int) float64 {
+	return 1 / (1 + math.Pow(10, float64(b-a)/400))
+}
+
+// Rating returns playerID's rating, InitialRating before their first
+// ranked game.
+func (r *Recorder) Rating(playerID string) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.ratingLocked(playerID)
+}
+
+func (r *Recorder) ratingLocked(playerID string) int {
+	if rec, exists := r.players[playerID]; exists {
+		return rec.rating
+	}
+	return InitialRating
+}
+
+func (r *Recorder) recordLocked(playerID string) *record {
+	rec, exists := r.players[playerID]
+	if !exists {
+		rec = &record{playerID: playerID, rating: InitialRating}
+		r.players[playerID] = rec
+	}
+	return rec
+}
+
+// Profile sums up playerID's games. It returns ErrPlayerNotFound for a
+// player who finished none.
+func (r *Recorder) Profile(playerID string) (*piratesv1.PlayerProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rec, exists := r.players[playerID]
+	if !exists {
+		return nil, ErrPlayerNotFound
+	}
+
+	profile := &piratesv1.PlayerProfile{
+		PlayerId:    rec.playerID,
+		DisplayName: rec.displayName,
+		GamesPlayed: int32(len(rec.games)),
+		Rating:      int32(rec.rating),
+	}
+	var shots, hits, shotsToWin int
+	powers := make(map[piratesv1.PowerType]int)
+	for _, p := range rec.games {
+		if p.won {
+			profile.Wins++
+			shotsToWin += p.stats.ShotsFired
+		} else {
+			profile.Losses++
+		}
+		if p.forfeited {
+			profile.Forfeits++
+		}
+		shots += p.stats.ShotsFired
+		hits += p.stats.Hits
+		for _, power := range p.stats.PowersUsed {
+			powers[power]++
+		}
+		if !p.ranked {
+			continue
+		}
+		profile.RatingHistory = append(profile.RatingHistory, &piratesv1.RatingChange{
+			GameId:   p.gameID,
+			Rating:   int32(p.rating),
+			Change:   int32(p.change),
+			AtUnixMs: p.endedAt.UnixMilli(),
+		})
+	}
+	if profile.Wins > 0 {
+		profile.AverageShotsToWin = float32(shotsToWin) / float32(profile.Wins)
+	}
+	if shots > 0 {
+		profile.Accuracy = float32(hits) / float32(shots)
+	}
+	for power, uses := range powers {
+		favorite := powers[profile.FavoritePower]
+		if uses > favorite || uses == favorite && power < profile.FavoritePower {
+			profile.FavoritePower = power
+		}
+	}
+	for i := len(rec.games) - 1; i >= 0; i-- {
+		won := rec.games[i].won
+		if i < len(rec.games)-1 && won != rec.games[len(rec.games)-1].won {
+			break
+		}
+		if won {
+			profile.CurrentStreak++
+		} else {
+			profile.CurrentStreak--
+		}
+	}
+	return profile, nil
+}
+
+// Leaderboard ranks the players who played within scope, pageSize at a
+// time. The global leaderboard ranks them by rating; the others by the
+// rating gained within their scope: the week up to now, or ruleSet's games.
+// Only ranked games count.
+func (r *Recorder) Leaderboard(scope piratesv1.LeaderboardScope, ruleSet piratesv1.RuleSet, now time.Time, pageSize int, pageToken string) (*piratesv1.Leaderboard, error) {
+	if scope == piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_UNSPECIFIED {
+		scope = piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL
+	}
+	var inScope func(p played) bool
+	switch scope {
+	case piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL:
+		inScope = func(played) bool { return true }
+	case piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_WEEKLY:
+		inScope = func(p played) bool { return now.Sub(p.endedAt) < week }
+	case piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_RULE_SET:
+		if ruleSet == piratesv1.RuleSet_RULE_SET_UNSPECIFIED {
+			ruleSet = piratesv1.RuleSet_RULE_SET_CLASSIC
+		}
+		inScope = func(p played) bool { return p.ruleSet == ruleSet }
+	default:
+		return nil, ErrInvalidScope
+	}
+
+	offset := 0
+	if pageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(pageToken); err != nil || offset < 0 {
+			return nil, ErrInvalidPageToken
+		}
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	r.mu.RLock()
+	var entries []*piratesv1.LeaderboardEntry
+	for _, rec := range r.players {
+		entry := &piratesv1.LeaderboardEntry{
+			PlayerId:    rec.playerID,
+			DisplayName: rec.displayName,
+			Rating:      int32(rec.rating),
+		}
+		for _, p := range rec.games {
+			if !p.ranked || !inScope(p) {
+				continue
+			}
+			entry.Points += int32(p.change)
+			if p.won {
+				entry.Wins++
+			} else {
+				entry.Losses++
+			}
+		}
+		if entry.Wins+entry.Losses > 0 {
+			entries = append(entries, entry)
+		}
+	}
+	r.mu.RUnlock()
+
+	global := scope == piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL
+	slices.SortFunc(entries, func(a, b *piratesv1.LeaderboardEntry) int {
+		switch {
+		case !global && a.Points != b.Points:
+			return int(b.Points - a.Points)
+		case a.Rating != b.Rating:
+			return int(b.Rating - a.Rating)
+		case a.Wins != b.Wins:
+			return int(b.Wins - a.Wins)
+		}
+		return strings.Compare(a.PlayerId, b.PlayerId)
+	})
+	for i, entry := range entries {
+		entry.Rank = int32(i + 1)
+	}
+
+	board := &piratesv1.Leaderboard{
+		Scope: scope,
+		Total: int32(len(entries)),
+	}
+	if scope == piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_RULE_SET {
+		board.RuleSet = ruleSet
+	}
+	if offset < len(entries) {
+		end := min(offset+pageSize, len(entries))
+		board.Entries = entries[offset:end]
+		if end < len(entries) {
+			board.NextPageToken = strconv.Itoa(end)
+		}
+	}
+	return board, nil
+}
@@ -0,0 +1,211 @@
+package stats
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+var now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// duel is a one-on-one game won by winnerID.
+func duel(id, winnerID, loserID string, ruleSet piratesv1.RuleSet, endedAt time.Time) Game {
+	return Game{
+		ID:      id,
+		RuleSet: ruleSet,
+		EndedAt: endedAt,
+		Outcomes: []game.Outcome{
+			{PlayerID: winnerID, Team: 0, Won: true, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK},
+			{PlayerID: loserID, Team: 1, Reason: piratesv1.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK},
+		},
+		Names: map[string]string{winnerID: "Captain " + winnerID, loserID: "Captain " + loserID},
+	}
+}
+
+func TestRecorder_Profile(t *testing.T) {
+	r := NewRecorder()
+	if _, err := r.Profile("p1"); err != ErrPlayerNotFound {
+		t.Errorf("expected ErrPlayerNotFound, got %v", err)
+	}
+
+	first := duel("game-1", "p1", "p2", piratesv1.RuleSet_RULE_SET_CLASSIC, now)
+	first.Outcomes[0].Stats = game.PlayerStats{
+		ShotsFired: 40,
+		Hits:       17,
+		PowersUsed: []piratesv1.PowerType{piratesv1.PowerType_POWER_TYPE_SONAR, piratesv1.PowerType_POWER_TYPE_KRAKEN},
+	}
+	r.Record(first)
+	if _, ok := r.Record(first); ok {
+		t.Error("expected a game to be recorded once")
+	}
+
+	second := duel("game-2", "p1", "p2", piratesv1.RuleSet_RULE_SET_CLASSIC, now)
+	second.Outcomes[0].Stats = game.PlayerStats{
+		ShotsFired: 60,
+		Hits:       17,
+		PowersUsed: []piratesv1.PowerType{piratesv1.PowerType_POWER_TYPE_KRAKEN},
+	}
+	second.Outcomes[1].Reason = piratesv1.GameOverReason_GAME_OVER_REASON_FORFEIT
+	r.Record(second)
+
+	third := duel("game-3", "p2", "p1", piratesv1.RuleSet_RULE_SET_CLASSIC, now)
+	r.Record(third)
+
+	// A game without a winner is left out.
+	r.Record(Game{ID: "game-4", Outcomes: []game.Outcome{{PlayerID: "p1"}, {PlayerID: "p2", Team: 1}}})
+
+	profile, err := r.Profile("p1")
+	if err != nil {
+		t.Fatalf("Profile failed: %v", err)
+	}
+	if profile.DisplayName != "Captain p1" || profile.GamesPlayed != 3 || profile.Wins != 2 || profile.Losses != 1 {
+		t.Errorf("unexpected record: %v", profile)
+	}
+	if profile.AverageShotsToWin != 50 || profile.Accuracy != float32(34)/100 {
+		t.Errorf("unexpected shots: %v", profile)
+	}
+	if profile.FavoritePower != piratesv1.PowerType_POWER_TYPE_KRAKEN {
+		t.Errorf("expected Kraken, got %v", profile.FavoritePower)
+	}
+	if profile.CurrentStreak != -1 {
+		t.Errorf("expected a losing streak of 1, got %d", profile.CurrentStreak)
+	}
+	if len(profile.RatingHistory) != 3 || profile.RatingHistory[0].Change != 16 || profile.Rating != profile.RatingHistory[2].Rating {
+		t.Errorf("unexpected rating history: %v", profile.RatingHistory)
+	}
+
+	profile, _ = r.Profile("p2")
+	if profile.Forfeits != 1 || profile.CurrentStreak != 1 {
+		t.Errorf("unexpected record: %v", profile)
+	}
+}
+
+func TestRecorder_Ratings(t *testing.T) {
+	t.Run("ratings balance out", func(t *testing.T) {
+		r := NewRecorder()
+		changes, _ := r.Record(duel("game-1", "p1", "p2", piratesv1.RuleSet_RULE_SET_CLASSIC, now))
+		if changes["p1"] != 16 || changes["p2"] != -16 {
+			t.Errorf("expected a 16 point swing, got %v", changes)
+		}
+		r.Record(duel("game-2", "p1", "p2", piratesv1.RuleSet_RULE_SET_CLASSIC, now))
+
+		p1, _ := r.Profile("p1")
+		p2, _ := r.Profile("p2")
+		if p1.Rating+p2.Rating != 2*InitialRating || p1.RatingHistory[1].Change >= 16 {
+			t.Errorf("unexpected ratings: %d and %d", p1.Rating, p2.Rating)
+		}
+		if r.Rating("p1") != int(p1.Rating) || r.Rating("p3") != InitialRating {
+			t.Errorf("unexpected ratings: %d and %d", r.Rating("p1"), r.Rating("p3"))
+		}
+	})
+
+	t.Run("free-for-all losers are rated against the winner only", func(t *testing.T) {
+		r := NewRecorder()
+		r.Record(Game{ID: "game-1", Outcomes: []game.Outcome{
+			{PlayerID: "p1", Team: 0, Won: true},
+			{PlayerID: "p2", Team: 1},
+			{PlayerID: "p3", Team: 2},
+		}})
+
+		p1, _ := r.Profile("p1")
+		p3, _ := r.Profile("p3")
+		if p1.Rating != InitialRating+16 || p3.Rating != InitialRating-16 {
+			t.Errorf("unexpected ratings: %d and %d", p1.Rating, p3.Rating)
+		}
+	})
+
+	t.Run("teammates share the result", func(t *testing.T) {
+		r := NewRecorder()
+		r.Record(Game{ID: "game-1", Outcomes: []game.Outcome{
+			{PlayerID: "p1", Team: 0, Won: true},
+			{PlayerID: "p2", Team: 1},
+			{PlayerID: "p3", Team: 0, Won: true},
+			{PlayerID: "p4", Team: 1},
+		}})
+
+		for id, want := range map[string]int32{"p1": 1016, "p2": 984, "p3": 1016, "p4": 984} {
+			if profile, _ := r.Profile(id); profile.Rating != want {
+				t.Errorf("expected %s rated %d, got %d", id, want, profile.Rating)
+			}
+		}
+	})
+
+	t.Run("unranked games leave ratings alone", func(t *testing.T) {
+		r := NewRecorder()
+		unranked := duel("game-1", "p1", "p2", piratesv1.RuleSet_RULE_SET_CLASSIC, now)
+		unranked.Unranked = true
+		r.Record(unranked)
+
+		p1, _ := r.Profile("p1")
+		if p1.Wins != 1 || p1.Rating != InitialRating || len(p1.RatingHistory) != 0 {
+			t.Errorf("unexpected profile: %v", p1)
+		}
+		if board, _ := r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL, 0, now, 0, ""); board.Total != 0 {
+			t.Errorf("expected an empty leaderboard, got %v", board.Entries)
+		}
+	})
+}
+
+func TestRecorder_Leaderboard(t *testing.T) {
+	r := NewRecorder()
+	r.Record(duel("game-1", "p1", "p2", piratesv1.RuleSet_RULE_SET_CLASSIC, now.Add(-10*24*time.Hour)))
+	r.Record(duel("game-2", "p1", "p3", piratesv1.RuleSet_RULE_SET_CLASSIC, now.Add(-9*24*time.Hour)))
+	r.Record(duel("game-3", "p2", "p3", piratesv1.RuleSet_RULE_SET_SALVO, now.Add(-time.Hour)))
+
+	ranking := func(board *piratesv1.Leaderboard) string {
+		var ids []string
+		for _, entry := range board.Entries {
+			ids = append(ids, fmt.Sprintf("%d:%s", entry.Rank, entry.PlayerId))
+		}
+		return fmt.Sprint(ids)
+	}
+
+	t.Run("global", func(t *testing.T) {
+		board, err := r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_UNSPECIFIED, 0, now, 0, "")
+		if err != nil {
+			t.Fatalf("Leaderboard failed: %v", err)
+		}
+		if got := ranking(board); got != "[1:p1 2:p2 3:p3]" || board.Total != 3 {
+			t.Errorf("unexpected ranking %s", got)
+		}
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		board, _ := r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_WEEKLY, 0, now, 0, "")
+		if got := ranking(board); got != "[1:p2 2:p3]" {
+			t.Errorf("unexpected ranking %s", got)
+		}
+		if board.Entries[0].Wins != 1 || board.Entries[0].Points <= 0 {
+			t.Errorf("unexpected entry: %v", board.Entries[0])
+		}
+	})
+
+	t.Run("by rule set", func(t *testing.T) {
+		board, _ := r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_RULE_SET, piratesv1.RuleSet_RULE_SET_UNSPECIFIED, now, 0, "")
+		// p3 lost to p1 once rated higher, which cost less.
+		if got := ranking(board); got != "[1:p1 2:p3 3:p2]" || board.RuleSet != piratesv1.RuleSet_RULE_SET_CLASSIC {
+			t.Errorf("unexpected ranking %s", got)
+		}
+		board, _ = r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_RULE_SET, piratesv1.RuleSet_RULE_SET_SALVO, now, 0, "")
+		if got := ranking(board); got != "[1:p2 2:p3]" {
+			t.Errorf("unexpected ranking %s", got)
+		}
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		board, _ := r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL, 0, now, 2, "")
+		if got := ranking(board); got != "[1:p1 2:p2]" || board.NextPageToken == "" {
+			t.Fatalf("unexpected first page %s", got)
+		}
+		board, _ = r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL, 0, now, 2, board.NextPageToken)
+		if got := ranking(board); got != "[3:p3]" || board.NextPageToken != "" {
+			t.Errorf("unexpected last page %s", got)
+		}
+		if _, err := r.Leaderboard(piratesv1.LeaderboardScope_LEADERBOARD_SCOPE_GLOBAL, 0, now, 2, "nope"); err != ErrInvalidPageToken {
+			t.Errorf("expected ErrInvalidPageToken, got %v", err)
+		}
+	})
+}
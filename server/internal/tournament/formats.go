@@ -0,0 +1,224 @@
+package tournament
+
+import (
+	"math/bits"
+	"slices"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+type standing struct {
+	playerID   string
+	seed       int
+	wins       int
+	losses     int
+	eliminated bool
+}
+
+// standings ranks the players by wins, then losses, then seed. Byes count
+// as wins.
+func (t *Tournament) standings() []standing {
+	standings := make([]standing, len(t.PlayerIDs))
+	index := make(map[string]int, len(t.PlayerIDs))
+	for i, id := range t.PlayerIDs {
+		standings[i] = standing{playerID: id, seed: i}
+		index[id] = i
+	}
+	for _, match := range t.Matches {
+		if !match.Finished {
+			continue
+		}
+		for _, id := range []string{match.Player1ID, match.Player2ID} {
+			if id == "" {
+				continue
+			}
+			if id == match.WinnerID {
+				standings[index[id]].wins++
+			} else {
+				standings[index[id]].losses++
+			}
+		}
+	}
+	if lives := t.lives(); lives > 0 {
+		for i := range standings {
+			standings[i].eliminated = standings[i].losses >= lives
+		}
+	}
+
+	slices.SortFunc(standings, func(a, b standing) int {
+		switch {
+		case a.wins != b.wins:
+			return b.wins - a.wins
+		case a.losses != b.losses:
+			return a.losses - b.losses
+		}
+		return a.seed - b.seed
+	})
+	return standings
+}
+
+// lives returns how many losses put a player out of the tournament, or 0
+// in formats where everyone plays every round.
+func (t *Tournament) lives() int {
+	switch t.Format {
+	case piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION:
+		return 1
+	case piratesv1.TournamentFormat_TOURNAMENT_FORMAT_DOUBLE_ELIMINATION:
+		return 2
+	}
+	return 0
+}
+
+// nextPairs pairs the next round. A pair whose second player is empty is
+// a bye. It returns nil once the tournament is over.
+func (t *Tournament) nextPairs() [][2]string {
+	switch t.Format {
+	case piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION:
+		players := t.playersWithLosses(0)
+		if len(players) < 2 {
+			return nil
+		}
+		return pairBySeed(players)
+	case piratesv1.TournamentFormat_TOURNAMENT_FORMAT_DOUBLE_ELIMINATION:
+		// Players who never lost and players who lost once play in separate
+		// brackets, until one of each is left for the final.
+		winners, losers := t.playersWithLosses(0), t.playersWithLosses(1)
+		switch {
+		case len(winners)+len(losers) < 2:
+			return nil
+		case len(winners) == 1 && len(losers) == 1:
+			return [][2]string{{winners[0], losers[0]}}
+		}
+		return append(pairBySeed(winners), pairBySeed(losers)...)
+	case piratesv1.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN:
+		return t.roundRobinPairs()
+	case piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SWISS:
+		return t.swissPairs()
+	}
+	return nil
+}
+
+// playersWithLosses returns the players with exactly losses losses, in seed
+// order.
+func (t *Tournament) playersWithLosses(losses int) []string {
+	var players []string
+	for _, s := range t.standings() {
+		if s.losses == losses {
+			players = append(players, s.playerID)
+		}
+	}
+	slices.SortFunc(players, func(a, b string) int {
+		return slices.Index(t.PlayerIDs, a) - slices.Index(t.PlayerIDs, b)
+	})
+	return players
+}
+
+// pairBySeed pairs the best seed with the worst, the second best with the
+// second worst, and so on. With an odd count, the best seed gets a bye.
+func pairBySeed(players []string) [][2]string {
+	var pairs [][2]string
+	if len(players)%2 == 1 {
+		pairs = append(pairs, [2]string{players[0], ""})
+		players = players[1:]
+	}
+	for i := 0; i < len(players)/2; i++ {
+		pairs = append(pairs, [2]string{players[i], players[len(players)-1-i]})
+	}
+	return pairs
+}
+
+// roundRobinPairs pairs the next round with the circle method: the first
+// seed stays in place while the others rotate, so that everyone meets once.
+func (t *Tournament) roundRobinPairs() [][2]string {
+	players := slices.Clone(t.PlayerIDs)
+	if len(players)%2 == 1 {
+		players = append(players, "")
+	}
+	n := len(players)
+	if t.Round >= n-1 {
+		return nil
+	}
+
+	others := players[1:]
+	order := []string{players[0]}
+	for i := range others {
+		order = append(order, others[(i+t.Round)%len(others)])
+	}
+
+	var pairs [][2]string
+	for i := 0; i < n/2; i++ {
+		a, b := order[i], order[n-1-i]
+		if a == "" {
+			a, b = b, a
+		}
+		pairs = append(pairs, [2]string{a, b})
+	}
+	return pairs
+}
+
+// swissPairs pairs players with similar records for as many rounds as an
+// elimination bracket would take. Players who already met are kept apart
+// when possible, and the lowest ranked player who had no bye yet gets one
+// with an odd count.
+func (t *Tournament) swissPairs() [][2]string {
+	if t.Round >= bits.Len(uint(len(t.PlayerIDs)-1)) {
+		return nil
+	}
+
+	met := make(map[[2]string]bool)
+	hadBye := make(map[string]bool)
+	for _, match := range t.Matches {
+		if match.Player2ID == "" {
+			hadBye[match.Player1ID] = true
+			continue
+		}
+		met[[2]string{match.Player1ID, match.Player2ID}] = true
+		met[[2]string{match.Player2ID, match.Player1ID}] = true
+	}
+
+	var players []string
+	for _, s := range t.standings() {
+		players = append(players, s.playerID)
+	}
+
+	var pairs [][2]string
+	if len(players)%2 == 1 {
+		bye := len(players) - 1
+		for i := len(players) - 1; i >= 0; i-- {
+			if !hadBye[players[i]] {
+				bye = i
+				break
+			}
+		}
+		pairs = append(pairs, [2]string{players[bye], ""})
+		players = slices.Delete(players, bye, bye+1)
+	}
+
+	if rest, ok := pairUnmet(players, met); ok {
+		return append(pairs, rest...)
+	}
+	// Rematches cannot be avoided: pair neighbors in the standings.
+	for i := 0; i < len(players); i += 2 {
+		pairs = append(pairs, [2]string{players[i], players[i+1]})
+	}
+	return pairs
+}
+
+// pairUnmet pairs each player, in order, with the best ranked player left
+// they have not met, backtracking when that leaves someone without an
+// opponent.
+func pairUnmet(players []string, met map[[2]string]bool) ([][2]string, bool) {
+	if len(players) == 0 {
+		return nil, true
+	}
+	for i := 1; i < len(players); i++ {
+		if met[[2]string{players[0], players[i]}] {
+			continue
+		}
+		rest := slices.Delete(slices.Clone(players), i, i+1)[1:]
+		if pairs, ok := pairUnmet(rest, met); ok {
+			return append([][2]string{{players[0], players[i]}}, pairs...), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,454 @@
+// Package tournament runs tournaments: players register, and the rounds are
+// paired one after the other as the previous one ends, following the
+// tournament's format. Games are played through the usual matchmaking; the
+// manager only hands out the matches due and records their results.
+package tournament
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+// MaxPlayers caps registrations so that round robins stay playable.
+const MaxPlayers = 64
+
+var (
+	ErrTournamentNotFound = errors.New("tournament not found")
+	ErrInvalidFormat      = errors.New("invalid tournament format")
+	ErrNotOrganizer       = errors.New("only the organizer can start the tournament")
+	ErrRegistrationClosed = errors.New("registration is closed")
+	ErrAlreadyRegistered  = errors.New("already registered")
+	ErrNotRegistered      = errors.New("not registered")
+	ErrTournamentFull     = errors.New("tournament is full")
+	ErrNotEnoughPlayers   = errors.New("a tournament needs at least two players")
+)
+
+type Tournament struct {
+	ID          string
+	Name        string
+	OrganizerID string
+	Format      piratesv1.TournamentFormat
+	Rules       game.Rules
+	Status      piratesv1.TournamentStatus
+	// PlayerIDs lists the players in registration order, which seeds them.
+	PlayerIDs []string
+	// Round is the round being played, from 1.
+	Round     int
+	Matches   []*Match
+	WinnerID  string
+	CreatedAt time.Time
+}
+
+// Match is a match of a round. A match without a second player is a bye,
+// won by the first.
+type Match struct {
+	ID        string
+	Round     int
+	Player1ID string
+	Player2ID string
+	GameID    string
+	Finished  bool
+	// WinnerID is empty when both players lost the match.
+	WinnerID string
+	// NoShow is set when the match was settled because its game did not
+	// start before the deadline.
+	NoShow   bool
+	Deadline time.Time
+}
+
+// Pairing is a match waiting for its game to be created.
+type Pairing struct {
+	TournamentID string
+	MatchID      string
+	Player1ID    string
+	Player2ID    string
+	Rules        game.Rules
+}
+
+// Manager holds the tournaments. Every change to a tournament is handed to
+// OnUpdate with the players concerned: its organizer and its players.
+type Manager struct {
+	mu            sync.RWMutex
+	tournaments   map[string]*Tournament
+	noShowTimeout time.Duration
+
+	OnUpdate func(playerIDs []string, bracket *piratesv1.TournamentBracket)
+}
+
+// NewManager creates a manager whose matches must start within
+// noShowTimeout of their round.
+func NewManager(noShowTimeout time.Duration) *Manager {
+	return &Manager{
+		tournaments:   make(map[string]*Tournament),
+		noShowTimeout: noShowTimeout,
+	}
+}
+
+// Create opens a tournament for registration. Its matches are single
+// one-on-one games, whatever the rules say about teams and series.
+func (m *Manager) Create(organizerID, name string, format piratesv1.TournamentFormat, rules game.Rules) (*piratesv1.Tournament, error) {
+	if _, ok := piratesv1.TournamentFormat_name[int32(format)]; !ok || format == piratesv1.TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED {
+		return nil, ErrInvalidFormat
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "Tournament"
+	}
+	rules.Teams = false
+	rules.SeriesLength = 0
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := &Tournament{
+		ID:          uuid.New().String(),
+		Name:        name,
+		OrganizerID: organizerID,
+		Format:      format,
+		Rules:       rules,
+		Status:      piratesv1.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION,
+		CreatedAt:   time.Now(),
+	}
+	m.tournaments[t.ID] = t
+	return t.toProto(), nil
+}
+
+// List returns every tournament, oldest first.
+func (m *Manager) List() []*piratesv1.Tournament {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	tournaments := make([]*Tournament, 0, len(m.tournaments))
+	for _, t := range m.tournaments {
+		tournaments = append(tournaments, t)
+	}
+	slices.SortFunc(tournaments, func(a, b *Tournament) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	list := make([]*piratesv1.Tournament, len(tournaments))
+	for i, t := range tournaments {
+		list[i] = t.toProto()
+	}
+	return list
+}
+
+func (m *Manager) Register(tournamentID, playerID string) (*piratesv1.Tournament, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tournaments[tournamentID]
+	if !ok {
+		return nil, ErrTournamentNotFound
+	}
+	if t.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION {
+		return nil, ErrRegistrationClosed
+	}
+	if slices.Contains(t.PlayerIDs, playerID) {
+		return nil, ErrAlreadyRegistered
+	}
+	if len(t.PlayerIDs) >= MaxPlayers {
+		return nil, ErrTournamentFull
+	}
+
+	t.PlayerIDs = append(t.PlayerIDs, playerID)
+	m.notifyLocked(t)
+	return t.toProto(), nil
+}
+
+// Withdraw cancels a registration before the tournament starts.
+func (m *Manager) Withdraw(tournamentID, playerID string) (*piratesv1.Tournament, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tournaments[tournamentID]
+	if !ok {
+		return nil, ErrTournamentNotFound
+	}
+	if t.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION {
+		return nil, ErrRegistrationClosed
+	}
+	i := slices.Index(t.PlayerIDs, playerID)
+	if i < 0 {
+		return nil, ErrNotRegistered
+	}
+
+	t.PlayerIDs = slices.Delete(t.PlayerIDs, i, i+1)
+	m.notifyLocked(t)
+	return t.toProto(), nil
+}
+
+// Start closes registration and pairs the first round.
+func (m *Manager) Start(tournamentID, organizerID string) (*piratesv1.TournamentBracket, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tournaments[tournamentID]
+	if !ok {
+		return nil, ErrTournamentNotFound
+	}
+	if t.OrganizerID != organizerID {
+		return nil, ErrNotOrganizer
+	}
+	if t.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION {
+		return nil, ErrRegistrationClosed
+	}
+	if len(t.PlayerIDs) < 2 {
+		return nil, ErrNotEnoughPlayers
+	}
+
+	t.Status = piratesv1.TournamentStatus_TOURNAMENT_STATUS_RUNNING
+	m.advanceLocked(t, time.Now())
+	m.notifyLocked(t)
+	return t.bracket(), nil
+}
+
+// Bracket returns the tournament's matches and standings as they stand.
+func (m *Manager) Bracket(tournamentID string) (*piratesv1.TournamentBracket, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	t, ok := m.tournaments[tournamentID]
+	if !ok {
+		return nil, ErrTournamentNotFound
+	}
+	return t.bracket(), nil
+}
+
+// Schedule settles the matches whose deadline passed before their game
+// started, and returns those still waiting for their game. A player present
+// at the deadline beats one who is not; otherwise both lose.
+func (m *Manager) Schedule(now time.Time, present func(playerID string) bool) []Pairing {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var pairings []Pairing
+	for _, t := range m.tournaments {
+		if t.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_RUNNING {
+			continue
+		}
+		for _, match := range t.roundMatches() {
+			if match.Finished || match.GameID != "" || !now.After(match.Deadline) {
+				continue
+			}
+			winnerID := ""
+			switch p1, p2 := present(match.Player1ID), present(match.Player2ID); {
+			case p1 && !p2:
+				winnerID = match.Player1ID
+			case p2 && !p1:
+				winnerID = match.Player2ID
+			}
+			match.NoShow = true
+			m.settleLocked(t, match, winnerID, now)
+		}
+
+		for _, match := range t.roundMatches() {
+			if !match.Finished && match.GameID == "" {
+				pairings = append(pairings, Pairing{
+					TournamentID: t.ID,
+					MatchID:      match.ID,
+					Player1ID:    match.Player1ID,
+					Player2ID:    match.Player2ID,
+					Rules:        t.Rules,
+				})
+			}
+		}
+	}
+	return pairings
+}
+
+// TournamentOf returns the ID of the tournament whose match matchID is
+// waiting for its game, or "" if there is none.
+func (m *Manager) TournamentOf(matchID string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if t, _ := m.waitingMatchLocked(matchID); t != nil {
+		return t.ID
+	}
+	return ""
+}
+
+// GameCreated ties a new game to the tournament match matchID, if it is
+// waiting for its game, and reports whether it was.
+func (m *Manager) GameCreated(gameID, matchID string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, match := m.waitingMatchLocked(matchID)
+	if t == nil {
+		return false
+	}
+	match.GameID = gameID
+	m.notifyLocked(t)
+	return true
+}
+
+// RecordResult settles the tournament match played in gameID, if any. The
+// next round is paired once every match of the current one is settled.
+func (m *Manager) RecordResult(gameID, winnerID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, t := range m.tournaments {
+		if t.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_RUNNING {
+			continue
+		}
+		for _, match := range t.roundMatches() {
+			if match.GameID != gameID || match.Finished {
+				continue
+			}
+			if winnerID != match.Player1ID && winnerID != match.Player2ID {
+				winnerID = ""
+			}
+			m.settleLocked(t, match, winnerID, time.Now())
+			return
+		}
+	}
+}
+
+func (m *Manager) waitingMatchLocked(matchID string) (*Tournament, *Match) {
+	if matchID == "" {
+		return nil, nil
+	}
+	for _, t := range m.tournaments {
+		if t.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_RUNNING {
+			continue
+		}
+		for _, match := range t.roundMatches() {
+			if match.ID == matchID && !match.Finished && match.GameID == "" {
+				return t, match
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (m *Manager) settleLocked(t *Tournament, match *Match, winnerID string, now time.Time) {
+	match.Finished = true
+	match.WinnerID = winnerID
+	for _, match := range t.roundMatches() {
+		if !match.Finished {
+			m.notifyLocked(t)
+			return
+		}
+	}
+	m.advanceLocked(t, now)
+	m.notifyLocked(t)
+}
+
+// advanceLocked pairs the next round, or ends the tournament when the
+// format has no round left to play. Byes are settled right away.
+func (m *Manager) advanceLocked(t *Tournament, now time.Time) {
+	for {
+		pairs := t.nextPairs()
+		if len(pairs) == 0 {
+			t.finish()
+			return
+		}
+
+		t.Round++
+		finished := true
+		for _, pair := range pairs {
+			match := &Match{
+				ID:        uuid.New().String(),
+				Round:     t.Round,
+				Player1ID: pair[0],
+				Player2ID: pair[1],
+				Deadline:  now.Add(m.noShowTimeout),
+			}
+			if match.Player2ID == "" {
+				match.Finished = true
+				match.WinnerID = match.Player1ID
+			}
+			finished = finished && match.Finished
+			t.Matches = append(t.Matches, match)
+		}
+		if !finished {
+			return
+		}
+	}
+}
+
+func (m *Manager) notifyLocked(t *Tournament) {
+	if m.OnUpdate == nil {
+		return
+	}
+	playerIDs := slices.Clone(t.PlayerIDs)
+	if !slices.Contains(playerIDs, t.OrganizerID) {
+		playerIDs = append(playerIDs, t.OrganizerID)
+	}
+	go m.OnUpdate(playerIDs, t.bracket())
+}
+
+// roundMatches returns the matches of the round being played.
+func (t *Tournament) roundMatches() []*Match {
+	var matches []*Match
+	for _, match := range t.Matches {
+		if match.Round == t.Round {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// finish ends the tournament. In elimination formats, the last player
+// standing wins; otherwise the top of the standings does.
+func (t *Tournament) finish() {
+	t.Status = piratesv1.TournamentStatus_TOURNAMENT_STATUS_FINISHED
+	for _, s := range t.standings() {
+		// Nobody is left when both players lost the final.
+		if !s.eliminated {
+			t.WinnerID = s.playerID
+			return
+		}
+	}
+}
+
+func (t *Tournament) toProto() *piratesv1.Tournament {
+	return &piratesv1.Tournament{
+		Id:           t.ID,
+		Name:         t.Name,
+		OrganizerId:  t.OrganizerID,
+		Format:       t.Format,
+		Rules:        t.Rules.ToProto(),
+		Status:       t.Status,
+		PlayerIds:    slices.Clone(t.PlayerIDs),
+		CurrentRound: int32(t.Round),
+		WinnerId:     t.WinnerID,
+	}
+}
+
+func (t *Tournament) bracket() *piratesv1.TournamentBracket {
+	bracket := &piratesv1.TournamentBracket{Tournament: t.toProto()}
+	for _, match := range t.Matches {
+		bracket.Matches = append(bracket.Matches, &piratesv1.TournamentMatch{
+			Id:             match.ID,
+			Round:          int32(match.Round),
+			Player1Id:      match.Player1ID,
+			Player2Id:      match.Player2ID,
+			GameId:         match.GameID,
+			Finished:       match.Finished,
+			WinnerId:       match.WinnerID,
+			NoShow:         match.NoShow,
+			DeadlineUnixMs: match.Deadline.UnixMilli(),
+		})
+	}
+	for i, s := range t.standings() {
+		bracket.Standings = append(bracket.Standings, &piratesv1.TournamentStanding{
+			PlayerId:   s.playerID,
+			Rank:       int32(i + 1),
+			Wins:       int32(s.wins),
+			Losses:     int32(s.losses),
+			Eliminated: s.eliminated,
+		})
+	}
+	return bracket
+}
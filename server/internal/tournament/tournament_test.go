@@ -0,0 +1,240 @@
+package tournament
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	piratesv1 "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+)
+
+// newStartedTournament registers n players, named p1 to pn, and starts the
+// tournament.
+func newStartedTournament(t *testing.T, format piratesv1.TournamentFormat, n int) (*Manager, string) {
+	t.Helper()
+	m := NewManager(time.Minute)
+	created, err := m.Create("organizer", "Cup", format, game.DefaultRules())
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	for i := 1; i <= n; i++ {
+		if _, err := m.Register(created.Id, fmt.Sprintf("p%d", i)); err != nil {
+			t.Fatalf("Register failed: %v", err)
+		}
+	}
+	if _, err := m.Start(created.Id, "organizer"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	return m, created.Id
+}
+
+// playRound plays every match of the current round, the better seed of
+// each pair winning, and returns the round's pairings.
+func playRound(t *testing.T, m *Manager) []Pairing {
+	t.Helper()
+	pairings := m.Schedule(time.Now(), func(string) bool { return true })
+	for _, p := range pairings {
+		gameID := "game-" + p.MatchID
+		if !m.GameCreated(gameID, p.MatchID) {
+			t.Fatalf("expected a game for %s against %s", p.Player1ID, p.Player2ID)
+		}
+		winner := p.Player1ID
+		if seed(p.Player2ID) < seed(p.Player1ID) {
+			winner = p.Player2ID
+		}
+		m.RecordResult(gameID, winner)
+	}
+	return pairings
+}
+
+func seed(playerID string) int {
+	n, _ := strconv.Atoi(strings.TrimPrefix(playerID, "p"))
+	return n
+}
+
+func TestManager_Registration(t *testing.T) {
+	m := NewManager(time.Minute)
+	if _, err := m.Create("organizer", "Cup", piratesv1.TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED, game.DefaultRules()); err != ErrInvalidFormat {
+		t.Errorf("expected ErrInvalidFormat, got %v", err)
+	}
+
+	rules := game.DefaultRules()
+	rules.Teams = true
+	created, _ := m.Create("organizer", " ", piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SWISS, rules)
+	if created.Name != "Tournament" || created.Rules.Teams {
+		t.Errorf("unexpected tournament: %v", created)
+	}
+
+	m.Register(created.Id, "p1")
+	if _, err := m.Register(created.Id, "p1"); err != ErrAlreadyRegistered {
+		t.Errorf("expected ErrAlreadyRegistered, got %v", err)
+	}
+	if _, err := m.Start(created.Id, "organizer"); err != ErrNotEnoughPlayers {
+		t.Errorf("expected ErrNotEnoughPlayers, got %v", err)
+	}
+	m.Register(created.Id, "p2")
+	if _, err := m.Start(created.Id, "p1"); err != ErrNotOrganizer {
+		t.Errorf("expected ErrNotOrganizer, got %v", err)
+	}
+	if _, err := m.Start(created.Id, "organizer"); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if _, err := m.Register(created.Id, "p3"); err != ErrRegistrationClosed {
+		t.Errorf("expected ErrRegistrationClosed, got %v", err)
+	}
+	if _, err := m.Withdraw(created.Id, "p1"); err != ErrRegistrationClosed {
+		t.Errorf("expected ErrRegistrationClosed, got %v", err)
+	}
+}
+
+func TestManager_Formats(t *testing.T) {
+	t.Run("single elimination", func(t *testing.T) {
+		m, id := newStartedTournament(t, piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION, 5)
+		var rounds [][]Pairing
+		for {
+			pairings := playRound(t, m)
+			if len(pairings) == 0 {
+				break
+			}
+			rounds = append(rounds, pairings)
+		}
+
+		// p1 gets a bye in the first round, when 5 players are paired.
+		if len(rounds) != 3 || len(rounds[0]) != 2 {
+			t.Errorf("unexpected rounds: %v", rounds)
+		}
+		bracket, _ := m.Bracket(id)
+		if bracket.Tournament.Status != piratesv1.TournamentStatus_TOURNAMENT_STATUS_FINISHED || bracket.Tournament.WinnerId != "p1" {
+			t.Errorf("expected p1 to win, got %v", bracket.Tournament)
+		}
+		if s := bracket.Standings[1]; !s.Eliminated || s.Losses != 1 {
+			t.Errorf("expected the runner-up to be out, got %v", s)
+		}
+	})
+
+	t.Run("double elimination", func(t *testing.T) {
+		m, id := newStartedTournament(t, piratesv1.TournamentFormat_TOURNAMENT_FORMAT_DOUBLE_ELIMINATION, 4)
+		for len(playRound(t, m)) > 0 {
+		}
+
+		bracket, _ := m.Bracket(id)
+		if bracket.Tournament.WinnerId != "p1" {
+			t.Errorf("expected p1 to win, got %q", bracket.Tournament.WinnerId)
+		}
+		for _, s := range bracket.Standings[1:] {
+			if s.Losses != 2 || !s.Eliminated {
+				t.Errorf("expected %s out after two losses, got %v", s.PlayerId, s)
+			}
+		}
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		m, id := newStartedTournament(t, piratesv1.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN, 5)
+		met := make(map[[2]string]int)
+		for {
+			pairings := playRound(t, m)
+			if len(pairings) == 0 {
+				break
+			}
+			for _, p := range pairings {
+				met[[2]string{min(p.Player1ID, p.Player2ID), max(p.Player1ID, p.Player2ID)}]++
+			}
+		}
+
+		if len(met) != 10 {
+			t.Errorf("expected every pair of players to meet, got %d pairs", len(met))
+		}
+		for pair, n := range met {
+			if n != 1 {
+				t.Errorf("expected %v to meet once, got %d", pair, n)
+			}
+		}
+		bracket, _ := m.Bracket(id)
+		if bracket.Tournament.CurrentRound != 5 || bracket.Tournament.WinnerId != "p1" {
+			t.Errorf("unexpected tournament: %v", bracket.Tournament)
+		}
+	})
+
+	t.Run("swiss", func(t *testing.T) {
+		m, id := newStartedTournament(t, piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SWISS, 6)
+		met := make(map[[2]string]bool)
+		rounds := 0
+		for {
+			pairings := playRound(t, m)
+			if len(pairings) == 0 {
+				break
+			}
+			rounds++
+			for _, p := range pairings {
+				pair := [2]string{min(p.Player1ID, p.Player2ID), max(p.Player1ID, p.Player2ID)}
+				if met[pair] {
+					t.Errorf("expected %v not to meet twice", pair)
+				}
+				met[pair] = true
+			}
+		}
+
+		bracket, _ := m.Bracket(id)
+		if rounds != 3 || bracket.Tournament.WinnerId != "p1" {
+			t.Errorf("expected p1 to win after 3 rounds, got %d rounds and %v", rounds, bracket.Tournament)
+		}
+	})
+}
+
+func TestManager_Schedule(t *testing.T) {
+	t.Run("no-shows lose", func(t *testing.T) {
+		m, id := newStartedTournament(t, piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION, 4)
+		later := time.Now().Add(2 * time.Minute)
+		m.Schedule(later, func(playerID string) bool { return playerID != "p4" && playerID != "p3" })
+
+		bracket, _ := m.Bracket(id)
+		first := bracket.Matches[:2]
+		for _, match := range first {
+			if !match.Finished || !match.NoShow {
+				t.Fatalf("expected the match to be settled as a no-show, got %v", match)
+			}
+		}
+		if first[0].WinnerId != "p1" || first[1].WinnerId != "p2" {
+			t.Errorf("expected the present players to win, got %v", first)
+		}
+		if bracket.Tournament.CurrentRound != 2 {
+			t.Errorf("expected the next round to be paired, got round %d", bracket.Tournament.CurrentRound)
+		}
+	})
+
+	t.Run("matches in play are not handed out", func(t *testing.T) {
+		m, tournamentID := newStartedTournament(t, piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION, 4)
+		first := m.Schedule(time.Now(), func(string) bool { return true })[0]
+		if m.TournamentOf(first.MatchID) != tournamentID {
+			t.Fatalf("expected match %s to belong to the tournament", first.MatchID)
+		}
+		if m.GameCreated("game-1", "nope") {
+			t.Error("expected a game of no tournament match not to count")
+		}
+		m.GameCreated("game-1", first.MatchID)
+		if m.TournamentOf(first.MatchID) != "" || m.GameCreated("game-2", first.MatchID) {
+			t.Error("expected a match in play not to wait for its game anymore")
+		}
+
+		pairings := m.Schedule(time.Now(), func(string) bool { return true })
+		if len(pairings) != 1 || !slices.Contains([]string{pairings[0].Player1ID, pairings[0].Player2ID}, "p2") {
+			t.Errorf("expected only p2 and p3's match, got %v", pairings)
+		}
+	})
+
+	t.Run("updates reach the organizer and players", func(t *testing.T) {
+		m := NewManager(time.Minute)
+		updates := make(chan []string, 1)
+		m.OnUpdate = func(playerIDs []string, bracket *piratesv1.TournamentBracket) { updates <- playerIDs }
+		created, _ := m.Create("organizer", "Cup", piratesv1.TournamentFormat_TOURNAMENT_FORMAT_SWISS, game.DefaultRules())
+		m.Register(created.Id, "p1")
+
+		if got := <-updates; !slices.Equal(got, []string{"p1", "organizer"}) {
+			t.Errorf("unexpected recipients: %v", got)
+		}
+	})
+}
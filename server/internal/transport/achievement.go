@@ -0,0 +1,48 @@
+package transport
+
+import (
+	"github.com/trezz/bataille-de-pirates/server/internal/achievement"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// observePower checks playerID's achievements against a power they used.
+func (s *PiratesServer) observePower(playerID string, result *pb.PowerResult) {
+	var sunk []string
+	for _, ship := range result.SunkShips {
+		sunk = append(sunk, ship.Name)
+	}
+	s.achievements.Observe(achievement.Event{
+		PlayerID:  playerID,
+		Trigger:   achievement.OnPower,
+		Power:     result.PowerUsed,
+		SunkShips: sunk,
+	})
+}
+
+// observeWins checks the achievements of the winners of the finished game
+// g.
+func (s *PiratesServer) observeWins(g *game.Game) {
+	for _, outcome := range g.Outcomes() {
+		if !outcome.Won {
+			continue
+		}
+		s.achievements.Observe(achievement.Event{
+			PlayerID:  outcome.PlayerID,
+			Trigger:   achievement.OnWin,
+			ShipsLost: outcome.ShipsLost,
+			Reason:    outcome.Reason,
+		})
+	}
+}
+
+func (s *PiratesServer) handleAchievementUnlocked(playerID string, unlocked *pb.Achievement) {
+	p, ok := s.registry.GetByID(playerID)
+	if !ok {
+		return
+	}
+	s.sendEvent(p, &pb.GameEvent{
+		Event: &pb.GameEvent_AchievementUnlocked{AchievementUnlocked: unlocked},
+	})
+}
@@ -0,0 +1,106 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/chat"
+	"github.com/trezz/bataille-de-pirates/server/internal/player"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func (s *PiratesServer) SendChatMessage(
+	ctx context.Context,
+	req *connect.Request[pb.SendChatMessageRequest],
+) (*connect.Response[pb.SendChatMessageResponse], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.ChatMessage{
+		SenderId:     p.Proto.Id,
+		SenderName:   p.Proto.DisplayName,
+		Scope:        req.Msg.Scope,
+		SentAtUnixMs: time.Now().UnixMilli(),
+	}
+	if req.Msg.Emote != pb.QuickEmote_QUICK_EMOTE_UNSPECIFIED {
+		if err := chat.ValidateEmote(req.Msg.Emote); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		msg.Emote = req.Msg.Emote
+	} else {
+		text, err := chat.Sanitize(req.Msg.Text)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		msg.Text = text
+	}
+
+	var recipients []*player.Player
+	switch req.Msg.Scope {
+	case pb.ChatScope_CHAT_SCOPE_GAME:
+		g := s.activeGame(p)
+		if g == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
+		}
+		if !s.chatLimiter.Allow(p.Proto.Id) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, chat.ErrRateLimited)
+		}
+		for _, id := range g.PlayerIDs {
+			if recipient, ok := s.registry.GetByID(id); ok {
+				recipients = append(recipients, recipient)
+			}
+		}
+	case pb.ChatScope_CHAT_SCOPE_LOBBY:
+		if snapshot, _ := s.registry.Snapshot(p.Proto.Id); snapshot == nil || snapshot.Status != pb.PlayerStatus_PLAYER_STATUS_ONLINE {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in the lobby"))
+		}
+		if !s.chatLimiter.Allow(p.Proto.Id) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, chat.ErrRateLimited)
+		}
+		recipients = s.registry.GetAvailablePlayers()
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid chat scope"))
+	}
+
+	for _, recipient := range recipients {
+		if recipient.Proto.Id == p.Proto.Id || s.chatMutes.IsMuted(recipient.Proto.Id, p.Proto.Id) ||
+			s.friends.IsBlocked(recipient.Proto.Id, p.Proto.Id) {
+			continue
+		}
+		s.sendEvent(recipient, &pb.GameEvent{
+			Event: &pb.GameEvent_ChatMessage{
+				ChatMessage: msg,
+			},
+		})
+	}
+
+	return connect.NewResponse(&pb.SendChatMessageResponse{
+		Message: msg,
+	}), nil
+}
+
+func (s *PiratesServer) MutePlayer(
+	ctx context.Context,
+	req *connect.Request[pb.MutePlayerRequest],
+) (*connect.Response[pb.MutePlayerResponse], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.PlayerId == p.Proto.Id {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot mute yourself"))
+	}
+	if _, ok := s.registry.GetByID(req.Msg.PlayerId); !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+	}
+
+	s.chatMutes.SetMuted(p.Proto.Id, req.Msg.PlayerId, req.Msg.Muted)
+
+	return connect.NewResponse(&pb.MutePlayerResponse{}), nil
+}
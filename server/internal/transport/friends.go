@@ -0,0 +1,264 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/friends"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+	"github.com/trezz/bataille-de-pirates/server/internal/player"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func (s *PiratesServer) SendFriendRequest(
+	ctx context.Context,
+	req *connect.Request[pb.SendFriendRequestRequest],
+) (*connect.Response[pb.FriendList], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var other *player.Player
+	switch {
+	case req.Msg.PlayerId != "":
+		var ok bool
+		if other, ok = s.registry.GetByID(req.Msg.PlayerId); !ok {
+			return nil, connect.NewError(connect.CodeNotFound, player.ErrPlayerNotFound)
+		}
+	case req.Msg.DisplayName != "":
+		if other, err = s.registry.FindByName(req.Msg.DisplayName); err != nil {
+			return nil, nameError(err)
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("player_id or display_name is required"))
+	}
+
+	accepted, err := s.friends.SendRequest(p.Proto.Id, other.Proto.Id)
+	if err != nil {
+		return nil, friendsError(err)
+	}
+	if accepted {
+		s.sendFriendList(other.Proto.Id)
+	} else if from, ok := s.registry.Snapshot(p.Proto.Id); ok {
+		s.sendEvent(other, &pb.GameEvent{
+			Event: &pb.GameEvent_FriendRequest{
+				FriendRequest: &pb.FriendRequest{From: from},
+			},
+		})
+	}
+
+	return connect.NewResponse(s.friendList(p.Proto.Id)), nil
+}
+
+func (s *PiratesServer) RespondToFriendRequest(
+	ctx context.Context,
+	req *connect.Request[pb.RespondToFriendRequestRequest],
+) (*connect.Response[pb.FriendList], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.friends.RespondToRequest(p.Proto.Id, req.Msg.PlayerId, req.Msg.Accepted); err != nil {
+		return nil, friendsError(err)
+	}
+	s.sendFriendList(req.Msg.PlayerId)
+
+	return connect.NewResponse(s.friendList(p.Proto.Id)), nil
+}
+
+func (s *PiratesServer) RemoveFriend(
+	ctx context.Context,
+	req *connect.Request[pb.RemoveFriendRequest],
+) (*connect.Response[pb.FriendList], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.friends.Remove(p.Proto.Id, req.Msg.PlayerId); err != nil {
+		return nil, friendsError(err)
+	}
+	s.sendFriendList(req.Msg.PlayerId)
+
+	return connect.NewResponse(s.friendList(p.Proto.Id)), nil
+}
+
+func (s *PiratesServer) BlockPlayer(
+	ctx context.Context,
+	req *connect.Request[pb.BlockPlayerRequest],
+) (*connect.Response[pb.FriendList], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.registry.GetByID(req.Msg.PlayerId); !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+	}
+	if err := s.friends.Block(p.Proto.Id, req.Msg.PlayerId); err != nil {
+		return nil, friendsError(err)
+	}
+	// The blocked player sees a friend removed, not that they were blocked.
+	s.sendFriendList(req.Msg.PlayerId)
+
+	return connect.NewResponse(s.friendList(p.Proto.Id)), nil
+}
+
+func (s *PiratesServer) UnblockPlayer(
+	ctx context.Context,
+	req *connect.Request[pb.UnblockPlayerRequest],
+) (*connect.Response[pb.FriendList], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	s.friends.Unblock(p.Proto.Id, req.Msg.PlayerId)
+
+	return connect.NewResponse(s.friendList(p.Proto.Id)), nil
+}
+
+func (s *PiratesServer) GetFriends(
+	ctx context.Context,
+	req *connect.Request[pb.GetFriendsRequest],
+) (*connect.Response[pb.FriendList], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(s.friendList(p.Proto.Id)), nil
+}
+
+// ChallengeFriend challenges the friend going by the given name. A friend
+// in a game is challenged once it is over.
+func (s *PiratesServer) ChallengeFriend(
+	ctx context.Context,
+	req *connect.Request[pb.ChallengeFriendRequest],
+) (*connect.Response[pb.ChallengeFriendResponse], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var friend *pb.Player
+	for _, id := range s.friends.FriendIDs(p.Proto.Id) {
+		snapshot, ok := s.registry.Snapshot(id)
+		if !ok || !strings.EqualFold(snapshot.DisplayName, req.Msg.DisplayName) {
+			continue
+		}
+		if friend != nil {
+			return nil, nameError(player.ErrAmbiguousName)
+		}
+		friend = snapshot
+	}
+	if friend == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("friend not found"))
+	}
+
+	rules := game.RulesFromProto(req.Msg.Rules)
+	rules.Teams = false
+	if friend.Status == pb.PlayerStatus_PLAYER_STATUS_IN_GAME {
+		if err := s.friends.QueueChallenge(p.Proto.Id, friend.Id, rules); err != nil {
+			return nil, friendsError(err)
+		}
+		return connect.NewResponse(&pb.ChallengeFriendResponse{Queued: true}), nil
+	}
+
+	match, err := s.matchmaker.ProposeMatch(p.Proto.Id, friend.Id, rules)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(&pb.ChallengeFriendResponse{
+		MatchId: match.ID,
+	}), nil
+}
+
+// nameError maps a failed lookup by display name. Players sharing a name
+// must be told apart by their ID.
+func nameError(err error) error {
+	if errors.Is(err, player.ErrAmbiguousName) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeNotFound, err)
+}
+
+func friendsError(err error) error {
+	switch {
+	case errors.Is(err, friends.ErrSelf):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, friends.ErrRequestNotFound), errors.Is(err, friends.ErrNotFriends):
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, err)
+}
+
+// deliverChallenges proposes playerID, whose game just ended, the match of
+// the first friend who queued a challenge and is still free to play.
+func (s *PiratesServer) deliverChallenges(playerID string) {
+	for {
+		challenge, ok := s.friends.NextChallenge(playerID)
+		if !ok {
+			return
+		}
+		challenger, ok := s.registry.Snapshot(challenge.ChallengerID)
+		if !ok || challenger.Status != pb.PlayerStatus_PLAYER_STATUS_ONLINE {
+			continue
+		}
+		if _, err := s.matchmaker.ProposeMatch(challenge.ChallengerID, playerID, challenge.Rules); err == nil {
+			return
+		}
+	}
+}
+
+// pushFriendPresence sends the friends of the given players their friend
+// list, so they see their new status.
+func (s *PiratesServer) pushFriendPresence(playerIDs []string) {
+	told := make(map[string]bool)
+	for _, id := range playerIDs {
+		for _, friendID := range s.friends.FriendIDs(id) {
+			if !told[friendID] {
+				told[friendID] = true
+				s.sendFriendList(friendID)
+			}
+		}
+	}
+}
+
+func (s *PiratesServer) sendFriendList(playerID string) {
+	p, ok := s.registry.GetByID(playerID)
+	if !ok {
+		return
+	}
+	s.sendEvent(p, &pb.GameEvent{
+		Event: &pb.GameEvent_FriendList{FriendList: s.friendList(playerID)},
+	})
+}
+
+func (s *PiratesServer) friendList(playerID string) *pb.FriendList {
+	list := s.friends.List(playerID)
+	return &pb.FriendList{
+		Friends:          s.snapshots(list.FriendIDs),
+		IncomingRequests: s.snapshots(list.IncomingIDs),
+		OutgoingRequests: s.snapshots(list.OutgoingIDs),
+		BlockedPlayerIds: list.BlockedIDs,
+	}
+}
+
+// snapshots returns the current info of the players of ids still
+// registered.
+func (s *PiratesServer) snapshots(ids []string) []*pb.Player {
+	var players []*pb.Player
+	for _, id := range ids {
+		if snapshot, ok := s.registry.Snapshot(id); ok {
+			players = append(players, snapshot)
+		}
+	}
+	return players
+}
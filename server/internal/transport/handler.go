@@ -3,13 +3,23 @@ package transport
 import (
 	"context"
 	"errors"
+	"net/http"
+	"slices"
 	"sync"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/trezz/bataille-de-pirates/server/internal/achievement"
+	"github.com/trezz/bataille-de-pirates/server/internal/chat"
+	"github.com/trezz/bataille-de-pirates/server/internal/friends"
 	"github.com/trezz/bataille-de-pirates/server/internal/game"
+	"github.com/trezz/bataille-de-pirates/server/internal/lobby"
 	"github.com/trezz/bataille-de-pirates/server/internal/matchmaker"
 	"github.com/trezz/bataille-de-pirates/server/internal/player"
+	"github.com/trezz/bataille-de-pirates/server/internal/puzzle"
+	"github.com/trezz/bataille-de-pirates/server/internal/stats"
+	"github.com/trezz/bataille-de-pirates/server/internal/tournament"
 
 	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
 	"github.com/trezz/bataille-de-pirates/server/gen/pirates/v1/piratesv1connect"
@@ -18,24 +28,80 @@ import (
 var _ piratesv1connect.PiratesServiceHandler = (*PiratesServer)(nil)
 
 type PiratesServer struct {
-	registry    *player.Registry
-	matchmaker  *matchmaker.Matchmaker
-	games       map[string]*game.Game
-	gamesMu     sync.RWMutex
+	registry   *player.Registry
+	matchmaker *matchmaker.Matchmaker
+	games      map[string]*game.Game
+	gamesMu    sync.RWMutex
+
+	// disconnectGrace is how long a player may stay disconnected from an
+	// active game before it is forfeited in favor of their opponent.
+	disconnectGrace  time.Duration
+	disconnectTimers map[string]*time.Timer
+	disconnectMu     sync.Mutex
+
+	// placementTimeout is how long players have to place their ships once
+	// a game starts.
+	placementTimeout time.Duration
+
+	lobby         *lobby.Notifier
+	queueStatus   map[string]*pb.QueueStatusUpdate
+	queueStatusMu sync.Mutex
+
+	chatLimiter *chat.RateLimiter
+	chatMutes   *chat.Mutes
+
+	privateLobbies *lobby.PrivateLobbies
+
+	tournaments  *tournament.Manager
+	puzzles      *puzzle.Manager
+	stats        *stats.Recorder
+	achievements *achievement.Tracker
+	friends      *friends.Manager
 }
 
-func NewPiratesServer() *PiratesServer {
+// Option configures a PiratesServer when it is created.
+type Option func(*PiratesServer)
+
+func NewPiratesServer(opts ...Option) *PiratesServer {
 	s := &PiratesServer{
-		registry: player.NewRegistry(),
-		games:    make(map[string]*game.Game),
-	}
+		registry:         player.NewRegistry(),
+		games:            make(map[string]*game.Game),
+		disconnectGrace:  30 * time.Second,
+		disconnectTimers: make(map[string]*time.Timer),
+		placementTimeout: 2 * time.Minute,
+		lobby:            lobby.NewNotifier(500 * time.Millisecond),
+		queueStatus:      make(map[string]*pb.QueueStatusUpdate),
+		chatLimiter:      chat.NewRateLimiter(5, 10*time.Second),
+		chatMutes:        chat.NewMutes(),
+		privateLobbies:   lobby.NewPrivateLobbies(),
+		tournaments:      tournament.NewManager(2 * time.Minute),
+		puzzles:          puzzle.NewManager(""),
+		stats:            stats.NewRecorder(),
+		achievements:     achievement.NewTracker(),
+		friends:          friends.NewManager(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.lobby.OnPresence = s.pushPresence
+	s.lobby.OnQueue = s.pushQueueStatus
 
 	s.matchmaker = matchmaker.NewMatchmaker(30 * time.Second)
 	s.matchmaker.OnMatchProposed = s.handleMatchProposed
 	s.matchmaker.OnMatchResult = s.handleMatchResult
 	s.matchmaker.OnGameCreated = s.handleGameCreated
+	s.matchmaker.OnQueueChanged = s.lobby.QueueChanged
+	s.matchmaker.OnPartyInvite = s.handlePartyInvite
+	s.matchmaker.OnPartyChanged = s.handlePartyChanged
+	s.matchmaker.OnPartyDisbanded = s.handlePartyDisbanded
+	s.matchmaker.Blocked = s.friends.IsBlocked
+	s.matchmaker.Rating = s.stats.Rating
+	s.tournaments.OnUpdate = s.handleTournamentUpdate
+	s.achievements.OnUnlock = s.handleAchievementUnlocked
 
 	go s.runCleanup()
+	go s.runTournaments()
 
 	return s
 }
@@ -45,19 +111,30 @@ func (s *PiratesServer) runCleanup() {
 	defer ticker.Stop()
 
 	for range ticker.C {
-		s.registry.CleanupStale(30 * time.Second)
+		for _, id := range s.registry.CleanupStale(30 * time.Second) {
+			s.matchmaker.LeaveQueue(id)
+			s.matchmaker.LeaveParty(id)
+			s.lobby.PlayerChanged(id)
+			s.chatLimiter.Forget(id)
+			s.chatMutes.Forget(id)
+			s.privateLobbies.Close(id)
+			s.puzzles.Forget(id)
+			s.friends.DropChallenges(id)
+		}
 	}
 }
 
-func (s *PiratesServer) getPlayerFromContext(ctx context.Context) (*player.Player, error) {
-	token := ctx.Value("session_token")
-	if token == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing session token"))
+// authenticate resolves the calling player from the session token carried in
+// the request message, falling back to the Authorization header.
+func (s *PiratesServer) authenticate(header http.Header, token string) (*player.Player, error) {
+	if token == "" {
+		token = header.Get("Authorization")
 	}
-	p, ok := s.registry.GetByToken(token.(string))
+	p, ok := s.registry.GetByToken(token)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
 	}
+	s.registry.UpdateLastSeen(p.Proto.Id)
 	return p, nil
 }
 
@@ -65,13 +142,18 @@ func (s *PiratesServer) Connect(
 	ctx context.Context,
 	req *connect.Request[pb.ConnectRequest],
 ) (*connect.Response[pb.ConnectResponse], error) {
-	p, err := s.registry.Register(req.Msg.DisplayName)
+	p, err := s.registry.Resume(req.Msg.ResumeToken, req.Msg.DisplayName)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.lobby.PlayerChanged(p.Proto.Id)
 
+	snapshot, ok := s.registry.Snapshot(p.Proto.Id)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("player removed while connecting"))
+	}
 	return connect.NewResponse(&pb.ConnectResponse{
-		Player:       p.Proto,
+		Player:       snapshot,
 		SessionToken: p.SessionToken,
 	}), nil
 }
@@ -80,51 +162,88 @@ func (s *PiratesServer) JoinQueue(
 	ctx context.Context,
 	req *connect.Request[pb.JoinQueueRequest],
 ) (*connect.Response[pb.QueueStatusUpdate], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
-	position, total := s.matchmaker.JoinQueue(p.Proto.Id)
-	s.registry.SetStatus(p.Proto.Id, pb.PlayerStatus_PLAYER_STATUS_IN_QUEUE)
+	queue := req.Msg.Queue
+	if queue == "" {
+		queue = matchmaker.DefaultQueue
+		if req.Msg.Teams {
+			queue = matchmaker.QueueTeams
+		}
+	}
 
-	return connect.NewResponse(&pb.QueueStatusUpdate{
-		InQueue:        true,
-		QueuePosition:  int32(position),
-		PlayersInQueue: int32(total),
-	}), nil
+	if party := s.matchmaker.GetParty(p.Proto.Id); party != nil {
+		if err := s.joinPartyQueue(p, party, queue); err != nil {
+			return nil, queueError(err)
+		}
+	} else {
+		if _, _, err := s.matchmaker.JoinQueue(p.Proto.Id, queue); err != nil {
+			return nil, queueError(err)
+		}
+		s.privateLobbies.Close(p.Proto.Id)
+		s.setStatus(p.Proto.Id, pb.PlayerStatus_PLAYER_STATUS_IN_QUEUE)
+	}
+
+	return connect.NewResponse(s.currentQueueStatus(p.Proto.Id)), nil
+}
+
+func queueError(err error) error {
+	if errors.Is(err, matchmaker.ErrQueueNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, err)
 }
 
 func (s *PiratesServer) LeaveQueue(
 	ctx context.Context,
 	req *connect.Request[pb.LeaveQueueRequest],
 ) (*connect.Response[pb.LeaveQueueResponse], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	s.matchmaker.LeaveQueue(p.Proto.Id)
-	s.registry.SetStatus(p.Proto.Id, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
+	s.setStatus(p.Proto.Id, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
 
 	return connect.NewResponse(&pb.LeaveQueueResponse{}), nil
 }
 
+// GetQueueStatus returns the caller's queue status along with every queue,
+// so they can pick one before joining.
+func (s *PiratesServer) GetQueueStatus(
+	ctx context.Context,
+	req *connect.Request[pb.GetQueueStatusRequest],
+) (*connect.Response[pb.QueueStatusUpdate], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(s.currentQueueStatus(p.Proto.Id)), nil
+}
+
 func (s *PiratesServer) ListPlayers(
 	ctx context.Context,
 	req *connect.Request[pb.ListPlayersRequest],
 ) (*connect.Response[pb.PlayerListUpdate], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	s.registry.UpdateLastSeen(p.Proto.Id)
 
-	available := s.registry.GetAvailablePlayers()
-	protoPlayers := make([]*pb.Player, len(available))
-	for i, p := range available {
-		protoPlayers[i] = p.Proto
+	var protoPlayers []*pb.Player
+	for _, p := range s.registry.GetAvailablePlayers() {
+		// Snapshots are taken one by one, so a player may have left the
+		// lobby in between.
+		if snapshot, ok := s.registry.Snapshot(p.Proto.Id); ok && snapshot.Status == pb.PlayerStatus_PLAYER_STATUS_ONLINE {
+			protoPlayers = append(protoPlayers, snapshot)
+		}
 	}
 
 	return connect.NewResponse(&pb.PlayerListUpdate{
@@ -136,12 +255,24 @@ func (s *PiratesServer) ChallengePlayer(
 	ctx context.Context,
 	req *connect.Request[pb.ChallengePlayerRequest],
 ) (*connect.Response[pb.ChallengePlayerResponse], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
-	match, err := s.matchmaker.Challenge(p.Proto.Id, req.Msg.TargetPlayerId)
+	rules := game.RulesFromProto(req.Msg.Rules)
+	rules.Teams = false
+	targetIDs := append([]string{req.Msg.TargetPlayerId}, req.Msg.AdditionalPlayerIds...)
+	if req.Msg.AllyPlayerId != "" {
+		if len(req.Msg.AdditionalPlayerIds) != 1 {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				errors.New("a team match needs an ally and two opponents"))
+		}
+		// Seats alternate between teams: the ally plays third.
+		targetIDs = []string{req.Msg.TargetPlayerId, req.Msg.AllyPlayerId, req.Msg.AdditionalPlayerIds[0]}
+		rules.Teams = true
+	}
+	match, err := s.matchmaker.ProposeGroupMatch(p.Proto.Id, targetIDs, rules)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -155,9 +286,9 @@ func (s *PiratesServer) RespondToMatch(
 	ctx context.Context,
 	req *connect.Request[pb.RespondToMatchRequest],
 ) (*connect.Response[pb.MatchResult], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	match, err := s.matchmaker.RespondToMatch(req.Msg.MatchId, p.Proto.Id, req.Msg.Accepted)
@@ -181,20 +312,20 @@ func (s *PiratesServer) PlaceShips(
 	ctx context.Context,
 	req *connect.Request[pb.PlaceShipsRequest],
 ) (*connect.Response[pb.PlacementResult], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	s.gamesMu.RLock()
-	g, exists := s.games[p.CurrentGameID]
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
 	s.gamesMu.RUnlock()
 
 	if !exists {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
 	}
 
-	err := g.PlaceShips(p.Proto.Id, req.Msg.Ships)
+	err = g.PlaceShips(p.Proto.Id, req.Msg.Ships)
 	if err != nil {
 		return connect.NewResponse(&pb.PlacementResult{
 			Valid:        false,
@@ -202,14 +333,17 @@ func (s *PiratesServer) PlaceShips(
 		}), nil
 	}
 
-	waitingForOpponent := !g.BothPlayersReady()
+	waitingForOpponent := !g.AllPlayersReady()
 
 	if !waitingForOpponent {
 		g.StartGame()
 		s.notifyTurnStarted(g)
 	} else {
-		opponentID := g.GetOpponentID(p.Proto.Id)
-		if opponent, ok := s.registry.GetByID(opponentID); ok {
+		for _, opponentID := range g.OtherPlayerIDs(p.Proto.Id) {
+			opponent, ok := s.registry.GetByID(opponentID)
+			if !ok {
+				continue
+			}
 			s.sendEvent(opponent, &pb.GameEvent{
 				Event: &pb.GameEvent_PlacementUpdate{
 					PlacementUpdate: &pb.PlacementResult{
@@ -231,33 +365,57 @@ func (s *PiratesServer) Attack(
 	ctx context.Context,
 	req *connect.Request[pb.AttackRequest],
 ) (*connect.Response[pb.AttackResult], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	s.gamesMu.RLock()
-	g, exists := s.games[p.CurrentGameID]
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
 	s.gamesMu.RUnlock()
 
 	if !exists {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
 	}
 
-	result, err := g.Attack(p.Proto.Id, int(req.Msg.Target.X), int(req.Msg.Target.Y))
+	targetID := s.targetOf(g, p.Proto.Id, req.Msg.TargetPlayerId)
+	result, err := g.AttackOn(p.Proto.Id, targetID, int(req.Msg.Target.X), int(req.Msg.Target.Y))
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	s.notifyOpponentOfAttack(g, p.Proto.Id, result)
+	s.notifyOpponentOfAttack(g, p.Proto.Id, targetID, result)
+	s.finishAction(g)
 
-	if gameOver := g.CheckVictory(); gameOver != nil {
-		s.handleGameOver(g, gameOver)
-	} else {
-		g.NextTurn()
-		s.notifyTurnStarted(g)
+	return connect.NewResponse(result), nil
+}
+
+func (s *PiratesServer) AttackSalvo(
+	ctx context.Context,
+	req *connect.Request[pb.AttackSalvoRequest],
+) (*connect.Response[pb.SalvoResult], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	s.gamesMu.RLock()
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
+	s.gamesMu.RUnlock()
+
+	if !exists {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
 	}
 
+	targetID := s.targetOf(g, p.Proto.Id, req.Msg.TargetPlayerId)
+	result, err := g.AttackSalvoOn(p.Proto.Id, targetID, req.Msg.Targets)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	s.notifyOpponentOfSalvo(g, p.Proto.Id, targetID, result)
+	s.finishAction(g)
+
 	return connect.NewResponse(result), nil
 }
 
@@ -265,55 +423,85 @@ func (s *PiratesServer) UsePower(
 	ctx context.Context,
 	req *connect.Request[pb.UsePowerRequest],
 ) (*connect.Response[pb.PowerResult], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	s.gamesMu.RLock()
-	g, exists := s.games[p.CurrentGameID]
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
 	s.gamesMu.RUnlock()
 
 	if !exists {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
 	}
 
-	result, err := g.UsePower(p.Proto.Id, req.Msg.Power, int(req.Msg.Target.X), int(req.Msg.Target.Y), req.Msg.Horizontal)
+	targetID := ""
+	if !game.Powers[req.Msg.Power].Defensive() {
+		targetID = s.targetOf(g, p.Proto.Id, req.Msg.TargetPlayerId)
+	}
+	orientation := game.OrientationOf(req.Msg.Orientation, req.Msg.Horizontal)
+	result, err := g.UsePowerOn(p.Proto.Id, targetID, req.Msg.Power, int(req.Msg.Target.X), int(req.Msg.Target.Y), orientation)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	s.notifyOpponentOfPower(g, p.Proto.Id, result)
+	s.notifyOpponentOfPower(g, p.Proto.Id, targetID, result)
+	s.observePower(p.Proto.Id, result)
+	s.finishAction(g)
 
-	if gameOver := g.CheckVictory(); gameOver != nil {
-		s.handleGameOver(g, gameOver)
-	} else {
-		g.NextTurn()
-		s.notifyTurnStarted(g)
+	return connect.NewResponse(result), nil
+}
+
+func (s *PiratesServer) PreviewPower(
+	ctx context.Context,
+	req *connect.Request[pb.PreviewPowerRequest],
+) (*connect.Response[pb.PowerPreview], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
-	return connect.NewResponse(result), nil
+	s.gamesMu.RLock()
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
+	s.gamesMu.RUnlock()
+
+	if !exists {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
+	}
+
+	orientation := game.OrientationOf(req.Msg.Orientation, req.Msg.Horizontal)
+	preview, err := g.PreviewPowerOn(p.Proto.Id, req.Msg.TargetPlayerId, req.Msg.Power, int(req.Msg.Target.GetX()), int(req.Msg.Target.GetY()), orientation)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(preview), nil
 }
 
 func (s *PiratesServer) Forfeit(
 	ctx context.Context,
 	req *connect.Request[pb.ForfeitRequest],
 ) (*connect.Response[pb.ForfeitResponse], error) {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
 	}
 
 	s.gamesMu.RLock()
-	g, exists := s.games[p.CurrentGameID]
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
 	s.gamesMu.RUnlock()
 
 	if !exists {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in a game"))
 	}
 
-	gameOver := g.Forfeit(p.Proto.Id)
-	s.handleGameOver(g, gameOver)
+	if err := g.Forfeit(p.Proto.Id); errors.Is(err, game.ErrPlayerEliminated) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	s.afterLeave(g, p.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_FORFEIT)
 
 	return connect.NewResponse(&pb.ForfeitResponse{}), nil
 }
@@ -323,12 +511,13 @@ func (s *PiratesServer) SubscribeEvents(
 	req *connect.Request[pb.SubscribeEventsRequest],
 	stream *connect.ServerStream[pb.GameEvent],
 ) error {
-	p, ok := s.registry.GetByToken(req.Msg.SessionToken)
-	if !ok {
-		return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid session token"))
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return err
 	}
 
-	defer s.cleanupPlayer(p)
+	s.handleReconnect(p)
+	defer s.handleDisconnect(p)
 
 	for {
 		select {
@@ -347,7 +536,124 @@ func (s *PiratesServer) SubscribeEvents(
 
 func (s *PiratesServer) cleanupPlayer(p *player.Player) {
 	s.matchmaker.LeaveQueue(p.Proto.Id)
+	s.matchmaker.LeaveParty(p.Proto.Id)
 	s.registry.Remove(p.Proto.Id)
+	s.lobby.PlayerChanged(p.Proto.Id)
+	s.chatLimiter.Forget(p.Proto.Id)
+	s.chatMutes.Forget(p.Proto.Id)
+	s.privateLobbies.Close(p.Proto.Id)
+	s.puzzles.Forget(p.Proto.Id)
+	s.friends.DropChallenges(p.Proto.Id)
+}
+
+// activeGame returns the unfinished game the player is part of, if any.
+func (s *PiratesServer) activeGame(p *player.Player) *game.Game {
+	s.gamesMu.RLock()
+	g, exists := s.games[s.registry.CurrentGame(p.Proto.Id)]
+	s.gamesMu.RUnlock()
+
+	if !exists || g.GetStatus() == game.StatusFinished {
+		return nil
+	}
+	return g
+}
+
+// handleDisconnect runs when a player's event stream ends. Players outside a
+// game are removed right away; players in a game get a grace period to
+// reconnect before the game is forfeited on their behalf.
+func (s *PiratesServer) handleDisconnect(p *player.Player) {
+	s.registry.SetConnected(p.Proto.Id, false)
+
+	g := s.activeGame(p)
+	if g == nil {
+		s.cleanupPlayer(p)
+		return
+	}
+
+	s.disconnectMu.Lock()
+	if timer, ok := s.disconnectTimers[p.Proto.Id]; ok {
+		timer.Stop()
+	}
+	s.disconnectTimers[p.Proto.Id] = time.AfterFunc(s.disconnectGrace, func() {
+		s.forfeitDisconnected(p, g)
+	})
+	s.disconnectMu.Unlock()
+
+	for _, opponentID := range g.OtherPlayerIDs(p.Proto.Id) {
+		if opponent, ok := s.registry.GetByID(opponentID); ok {
+			s.sendEvent(opponent, &pb.GameEvent{
+				Event: &pb.GameEvent_OpponentDisconnected{
+					OpponentDisconnected: &pb.OpponentDisconnected{
+						GracePeriodSeconds: int32(s.disconnectGrace / time.Second),
+					},
+				},
+			})
+		}
+	}
+}
+
+// handleReconnect runs when a player opens an event stream. If they were in
+// their disconnect grace period, the pending forfeit is cancelled and the
+// opponent is told they are back.
+func (s *PiratesServer) handleReconnect(p *player.Player) {
+	s.registry.SetConnected(p.Proto.Id, true)
+
+	s.disconnectMu.Lock()
+	timer, pending := s.disconnectTimers[p.Proto.Id]
+	if pending {
+		timer.Stop()
+		delete(s.disconnectTimers, p.Proto.Id)
+	}
+	s.disconnectMu.Unlock()
+
+	if !pending {
+		return
+	}
+
+	g := s.activeGame(p)
+	if g == nil {
+		return
+	}
+
+	for _, opponentID := range g.OtherPlayerIDs(p.Proto.Id) {
+		if opponent, ok := s.registry.GetByID(opponentID); ok {
+			s.sendEvent(opponent, &pb.GameEvent{
+				Event: &pb.GameEvent_OpponentReconnected{
+					OpponentReconnected: &pb.OpponentReconnected{},
+				},
+			})
+		}
+	}
+
+	if g.GetStatus() != game.StatusWaitingForShips {
+		s.sendEvent(p, &pb.GameEvent{
+			Event: &pb.GameEvent_TurnStarted{
+				TurnStarted: g.TurnStartedFor(p.Proto.Id),
+			},
+		})
+	}
+}
+
+// forfeitDisconnected takes p out of g once their grace period has run out
+// without a reconnection. In a two-player game their opponent wins.
+func (s *PiratesServer) forfeitDisconnected(p *player.Player, g *game.Game) {
+	s.disconnectMu.Lock()
+	if _, pending := s.disconnectTimers[p.Proto.Id]; !pending {
+		s.disconnectMu.Unlock()
+		return
+	}
+	delete(s.disconnectTimers, p.Proto.Id)
+	s.disconnectMu.Unlock()
+
+	if s.registry.IsConnected(p.Proto.Id) {
+		return
+	}
+
+	if g.GetStatus() != game.StatusFinished && !g.IsEliminated(p.Proto.Id) {
+		g.Leave(p.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_DISCONNECT)
+		s.afterLeave(g, p.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_DISCONNECT)
+	}
+	s.cleanupPlayer(p)
 }
 
 func (s *PiratesServer) handleMatchProposed(playerID string, match *matchmaker.Match) {
@@ -356,17 +662,23 @@ func (s *PiratesServer) handleMatchProposed(playerID string, match *matchmaker.M
 		return
 	}
 
-	var opponent *player.Player
-	var youInitiated bool
-	if match.Player1ID == playerID {
-		opponent, _ = s.registry.GetByID(match.Player2ID)
-		youInitiated = match.InitiatedBy == playerID
-	} else {
-		opponent, _ = s.registry.GetByID(match.Player1ID)
-		youInitiated = match.InitiatedBy == playerID
+	team := match.Rules.Team(slices.Index(match.PlayerIDs, playerID))
+	var opponents, allies []*pb.Player
+	for seat, id := range match.PlayerIDs {
+		if id == playerID {
+			continue
+		}
+		other, ok := s.registry.Snapshot(id)
+		if !ok {
+			return
+		}
+		if match.Rules.Team(seat) == team {
+			allies = append(allies, other)
+		} else {
+			opponents = append(opponents, other)
+		}
 	}
-
-	if opponent == nil {
+	if len(opponents) == 0 {
 		return
 	}
 
@@ -374,9 +686,13 @@ func (s *PiratesServer) handleMatchProposed(playerID string, match *matchmaker.M
 		Event: &pb.GameEvent_MatchProposal{
 			MatchProposal: &pb.MatchProposal{
 				MatchId:        match.ID,
-				Opponent:       opponent.Proto,
-				YouInitiated:   youInitiated,
-				TimeoutSeconds: 30,
+				Opponent:       opponents[0],
+				YouInitiated:   match.InitiatedBy == playerID,
+				TimeoutSeconds: int32(time.Until(match.ExpiresAt).Seconds()),
+				Rules:          match.Rules.ToProto(),
+				Opponents:      opponents,
+				Allies:         allies,
+				TournamentId:   s.tournaments.TournamentOf(match.TournamentMatchID),
 			},
 		},
 	})
@@ -406,136 +722,236 @@ func (s *PiratesServer) handleMatchResult(playerID string, match *matchmaker.Mat
 	})
 }
 
-func (s *PiratesServer) handleGameCreated(player1ID, player2ID, gameID string) {
-	g := game.NewGame(gameID, player1ID, player2ID)
+func (s *PiratesServer) handleGameCreated(match *matchmaker.Match, gameID string) {
+	s.tournaments.GameCreated(gameID, match.TournamentMatchID)
+	s.createGame(match.PlayerIDs, gameID, match.Rules)
+}
+
+// createGame starts the game gameID between playerIDs.
+func (s *PiratesServer) createGame(playerIDs []string, gameID string, rules game.Rules) {
+	g := game.NewGameForPlayers(gameID, playerIDs, rules)
+	if rules.SeriesLength > 1 {
+		g.Series = game.NewSeries(playerIDs, rules.SeriesLength)
+	}
+	s.startGame(g)
+}
+
+// startGame seats the players of the new game g and tells them it started.
+func (s *PiratesServer) startGame(g *game.Game) {
+	// A private lobby is used up once its game starts.
+	for _, playerID := range g.PlayerIDs {
+		s.privateLobbies.Close(playerID)
+	}
 
 	s.gamesMu.Lock()
-	s.games[gameID] = g
+	s.games[g.ID] = g
 	s.gamesMu.Unlock()
 
-	p1, ok1 := s.registry.GetByID(player1ID)
-	p2, ok2 := s.registry.GetByID(player2ID)
+	for _, playerID := range g.PlayerIDs {
+		p, ok := s.registry.GetByID(playerID)
+		if !ok {
+			continue
+		}
+		opponents := s.seatedPlayers(g.OpponentIDs(playerID))
+		allies := s.seatedPlayers(g.AllyIDs(playerID))
 
-	if ok1 {
-		p1.CurrentGameID = gameID
-		s.registry.SetStatus(player1ID, pb.PlayerStatus_PLAYER_STATUS_IN_GAME)
-		s.sendEvent(p1, &pb.GameEvent{
+		s.registry.SetCurrentGame(playerID, g.ID)
+		s.setStatus(playerID, pb.PlayerStatus_PLAYER_STATUS_IN_GAME)
+		s.sendEvent(p, &pb.GameEvent{
 			Event: &pb.GameEvent_GameStarted{
 				GameStarted: &pb.GameStarted{
-					GameId:        gameID,
-					Opponent:      p2.Proto,
-					YourTurnFirst: g.CurrentTurn == player1ID,
+					GameId:        g.ID,
+					Opponent:      opponents[0],
+					YourTurnFirst: g.CurrentTurn == playerID,
+					Rules:         g.Rules.ToProto(),
+					Terrain:       g.TerrainFor(playerID),
+					Opponents:     opponents,
+					Allies:        allies,
+					Series:        g.Series.ToProto(true),
 				},
 			},
 		})
 	}
+	time.AfterFunc(s.placementTimeout, func() { s.timeOutPlacement(g) })
+}
 
-	if ok2 {
-		p2.CurrentGameID = gameID
-		s.registry.SetStatus(player2ID, pb.PlayerStatus_PLAYER_STATUS_IN_GAME)
-		s.sendEvent(p2, &pb.GameEvent{
-			Event: &pb.GameEvent_GameStarted{
-				GameStarted: &pb.GameStarted{
-					GameId:        gameID,
-					Opponent:      p1.Proto,
-					YourTurnFirst: g.CurrentTurn == player2ID,
-				},
-			},
-		})
+// timeOutPlacement takes the players of g who have not placed their ships
+// in time out of the game. Games already under way find nothing to do.
+func (s *PiratesServer) timeOutPlacement(g *game.Game) {
+	late := g.TimeOutPlacement()
+	if len(late) == 0 {
+		return
+	}
+	if g.GetStatus() == game.StatusFinished {
+		s.handleGameOver(g)
+		return
 	}
+	for _, playerID := range late {
+		s.notifyEliminated(g, playerID, pb.GameOverReason_GAME_OVER_REASON_PLACEMENT_TIMEOUT)
+	}
+	s.notifyTurnStarted(g)
 }
 
-func (s *PiratesServer) notifyTurnStarted(g *game.Game) {
-	p1, ok1 := s.registry.GetByID(g.Player1ID)
-	p2, ok2 := s.registry.GetByID(g.Player2ID)
+// seatedPlayers returns a snapshot of the players of ids. Those who left in
+// the meantime are still seated.
+func (s *PiratesServer) seatedPlayers(ids []string) []*pb.Player {
+	var players []*pb.Player
+	for _, id := range ids {
+		if p, ok := s.registry.Snapshot(id); ok {
+			players = append(players, p)
+		} else {
+			players = append(players, &pb.Player{Id: id})
+		}
+	}
+	return players
+}
 
-	if ok1 {
-		powers := g.GetPlayerPowers(g.Player1ID)
-		s.sendEvent(p1, &pb.GameEvent{
-			Event: &pb.GameEvent_TurnStarted{
-				TurnStarted: &pb.TurnStarted{
-					YourTurn:        g.CurrentTurn == g.Player1ID,
-					AvailablePowers: powers,
+// targetOf resolves the opponent attackerID fires at: targetID, or the next
+// opponent still in the game when it is empty.
+func (s *PiratesServer) targetOf(g *game.Game, attackerID, targetID string) string {
+	if targetID != "" {
+		return targetID
+	}
+	return g.GetOpponentID(attackerID)
+}
+
+// finishAction ends the game once a player's action leaves a single player
+// standing. Otherwise it announces the players it eliminated and moves on
+// to the next turn.
+func (s *PiratesServer) finishAction(g *game.Game) {
+	eliminated := g.EliminateSunkFleets()
+	if g.CheckVictory() {
+		s.handleGameOver(g)
+		return
+	}
+	for _, playerID := range eliminated {
+		s.notifyEliminated(g, playerID, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+	}
+	g.FinishTurn()
+	s.notifyTurnStarted(g)
+}
+
+// afterLeave follows playerID leaving g: the game is over, or it goes on
+// without them, possibly with the turn passed on.
+func (s *PiratesServer) afterLeave(g *game.Game, playerID string, reason pb.GameOverReason) {
+	if g.GetStatus() == game.StatusFinished {
+		s.handleGameOver(g)
+		return
+	}
+	s.notifyEliminated(g, playerID, reason)
+	if g.GetStatus() != game.StatusWaitingForShips {
+		s.notifyTurnStarted(g)
+	}
+}
+
+func (s *PiratesServer) notifyEliminated(g *game.Game, eliminatedID string, reason pb.GameOverReason) {
+	for _, playerID := range g.PlayerIDs {
+		p, ok := s.registry.GetByID(playerID)
+		if !ok {
+			continue
+		}
+		s.sendEvent(p, &pb.GameEvent{
+			Event: &pb.GameEvent_PlayerEliminated{
+				PlayerEliminated: &pb.PlayerEliminated{
+					PlayerId: eliminatedID,
+					Reason:   reason,
 				},
 			},
 		})
 	}
+}
 
-	if ok2 {
-		powers := g.GetPlayerPowers(g.Player2ID)
-		s.sendEvent(p2, &pb.GameEvent{
+func (s *PiratesServer) notifyTurnStarted(g *game.Game) {
+	for _, playerID := range g.PlayerIDs {
+		p, ok := s.registry.GetByID(playerID)
+		if !ok {
+			continue
+		}
+		s.sendEvent(p, &pb.GameEvent{
 			Event: &pb.GameEvent_TurnStarted{
-				TurnStarted: &pb.TurnStarted{
-					YourTurn:        g.CurrentTurn == g.Player2ID,
-					AvailablePowers: powers,
-				},
+				TurnStarted: g.TurnStartedFor(playerID),
 			},
 		})
 	}
+	if deadline := g.GetTurnDeadline(); !deadline.IsZero() {
+		time.AfterFunc(time.Until(deadline), func() { s.timeOutTurn(g) })
+	}
 }
 
-func (s *PiratesServer) notifyOpponentOfAttack(g *game.Game, attackerID string, result *pb.AttackResult) {
-	opponentID := g.GetOpponentID(attackerID)
-	opponent, ok := s.registry.GetByID(opponentID)
+// timeOutTurn takes the current player of g out of the game if their turn
+// ran out. Timers of turns played in time find nothing to do.
+func (s *PiratesServer) timeOutTurn(g *game.Game) {
+	playerID, ok := g.TimeOutTurn(time.Now())
 	if !ok {
 		return
 	}
+	s.afterLeave(g, playerID, pb.GameOverReason_GAME_OVER_REASON_TURN_TIMEOUT)
+}
 
-	s.sendEvent(opponent, &pb.GameEvent{
-		Event: &pb.GameEvent_OpponentAction{
-			OpponentAction: &pb.OpponentAction{
-				Action: &pb.OpponentAction_Attack{
-					Attack: result,
-				},
-			},
-		},
+func (s *PiratesServer) notifyOpponentOfAttack(g *game.Game, attackerID, targetID string, result *pb.AttackResult) {
+	s.notifyAction(g, attackerID, func(playerID string) (*pb.OpponentAction, error) {
+		return g.AttackFor(playerID, attackerID, targetID, result)
 	})
 }
 
-func (s *PiratesServer) notifyOpponentOfPower(g *game.Game, attackerID string, result *pb.PowerResult) {
-	opponentID := g.GetOpponentID(attackerID)
-	opponent, ok := s.registry.GetByID(opponentID)
-	if !ok {
-		return
-	}
-
-	s.sendEvent(opponent, &pb.GameEvent{
-		Event: &pb.GameEvent_OpponentAction{
-			OpponentAction: &pb.OpponentAction{
-				Action: &pb.OpponentAction_Power{
-					Power: result,
-				},
-			},
-		},
+func (s *PiratesServer) notifyOpponentOfSalvo(g *game.Game, attackerID, targetID string, result *pb.SalvoResult) {
+	s.notifyAction(g, attackerID, func(playerID string) (*pb.OpponentAction, error) {
+		return g.SalvoFor(playerID, attackerID, targetID, result)
 	})
 }
 
-func (s *PiratesServer) handleGameOver(g *game.Game, gameOver *pb.GameOver) {
-	p1, ok1 := s.registry.GetByID(g.Player1ID)
-	p2, ok2 := s.registry.GetByID(g.Player2ID)
+func (s *PiratesServer) notifyOpponentOfPower(g *game.Game, attackerID, targetID string, result *pb.PowerResult) {
+	s.notifyAction(g, attackerID, func(playerID string) (*pb.OpponentAction, error) {
+		return g.PowerFor(playerID, attackerID, targetID, result)
+	})
+}
 
-	if ok1 {
-		p1.CurrentGameID = ""
-		s.registry.SetStatus(g.Player1ID, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
-		s.sendEvent(p1, &pb.GameEvent{
-			Event: &pb.GameEvent_GameOver{
-				GameOver: &pb.GameOver{
-					YouWon: g.Winner == g.Player1ID,
-					Reason: gameOver.Reason,
-				},
-			},
+// notifyAction tells every other player of g, spectators included, about
+// attackerID's action, as actionFor tells it to each of them.
+func (s *PiratesServer) notifyAction(g *game.Game, attackerID string, actionFor func(playerID string) (*pb.OpponentAction, error)) {
+	for _, playerID := range g.OtherPlayerIDs(attackerID) {
+		p, ok := s.registry.GetByID(playerID)
+		if !ok {
+			continue
+		}
+		told, err := actionFor(playerID)
+		if err != nil {
+			continue
+		}
+		s.sendEvent(p, &pb.GameEvent{
+			Event: &pb.GameEvent_OpponentAction{OpponentAction: told},
 		})
 	}
+}
 
-	if ok2 {
-		p2.CurrentGameID = ""
-		s.registry.SetStatus(g.Player2ID, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
-		s.sendEvent(p2, &pb.GameEvent{
+func (s *PiratesServer) handleGameOver(g *game.Game) {
+	s.tournaments.RecordResult(g.ID, g.GetWinner())
+	ratingChanges, recorded := s.recordStats(g)
+	if recorded {
+		s.observeWins(g)
+	}
+	seriesOver := g.Series == nil || g.Series.Record(g)
+	if !seriesOver {
+		// A player who is gone cannot take their seat in the next game, so
+		// they give up the series before anyone learns how it stands.
+		if absent := s.absentPlayers(g); len(absent) > 0 {
+			g.Series.GiveUp(g, absent)
+			seriesOver = true
+		}
+	}
+
+	for _, playerID := range g.PlayerIDs {
+		p, ok := s.registry.GetByID(playerID)
+		if !ok {
+			continue
+		}
+		s.registry.SetCurrentGame(playerID, "")
+		s.setStatus(playerID, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
+		gameOver := g.GameOverFor(playerID)
+		gameOver.Summary.RatingChange = int32(ratingChanges[playerID])
+		s.sendEvent(p, &pb.GameEvent{
 			Event: &pb.GameEvent_GameOver{
-				GameOver: &pb.GameOver{
-					YouWon: g.Winner == g.Player2ID,
-					Reason: gameOver.Reason,
-				},
+				GameOver: gameOver,
 			},
 		})
 	}
@@ -543,11 +959,34 @@ func (s *PiratesServer) handleGameOver(g *game.Game, gameOver *pb.GameOver) {
 	s.gamesMu.Lock()
 	delete(s.games, g.ID)
 	s.gamesMu.Unlock()
+
+	if !seriesOver {
+		s.startNextInSeries(g)
+		return
+	}
+	for _, playerID := range g.PlayerIDs {
+		s.deliverChallenges(playerID)
+	}
 }
 
-func (s *PiratesServer) sendEvent(p *player.Player, event *pb.GameEvent) {
-	select {
-	case p.EventChannel <- event:
-	default:
+// absentPlayers returns the players of g who are no longer connected.
+func (s *PiratesServer) absentPlayers(g *game.Game) []string {
+	var absent []string
+	for _, playerID := range g.PlayerIDs {
+		if !s.registry.IsConnected(playerID) {
+			absent = append(absent, playerID)
+		}
 	}
+	return absent
+}
+
+// startNextInSeries starts the game following g in its series.
+func (s *PiratesServer) startNextInSeries(g *game.Game) {
+	next := game.NewGameForPlayers(uuid.New().String(), g.Series.NextSeats(), g.Rules)
+	next.Series = g.Series
+	s.startGame(next)
+}
+
+func (s *PiratesServer) sendEvent(p *player.Player, event *pb.GameEvent) {
+	s.registry.SendEvent(p.Proto.Id, event)
 }
@@ -2,9 +2,19 @@ package transport
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+	"github.com/trezz/bataille-de-pirates/server/internal/matchmaker"
+	"github.com/trezz/bataille-de-pirates/server/internal/player"
+	"github.com/trezz/bataille-de-pirates/server/internal/stats"
+	"google.golang.org/protobuf/proto"
+
 	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
 )
 
@@ -44,6 +54,31 @@ func TestPiratesServer_Connect(t *testing.T) {
 			t.Error("expected generated display name")
 		}
 	})
+
+	t.Run("resuming keeps the player and their friends", func(t *testing.T) {
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+		s.friends.SendRequest(p1.Proto.Id, p2.Proto.Id)
+		s.friends.RespondToRequest(p2.Proto.Id, p1.Proto.Id, true)
+		s.cleanupPlayer(p1)
+
+		req := connect.NewRequest(&pb.ConnectRequest{DisplayName: "Player1", ResumeToken: p1.SessionToken})
+		resp, err := s.Connect(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.Player.Id != p1.Proto.Id || resp.Msg.SessionToken == p1.SessionToken {
+			t.Errorf("expected player 1 back with a new session, got %v", resp.Msg)
+		}
+		if friends := s.friendList(p2.Proto.Id).Friends; len(friends) != 1 || friends[0].Id != p1.Proto.Id {
+			t.Errorf("expected player 1 still a friend, got %v", friends)
+		}
+
+		req = connect.NewRequest(&pb.ConnectRequest{ResumeToken: p1.SessionToken})
+		if resp, _ := s.Connect(context.Background(), req); resp.Msg.Player.Id == p1.Proto.Id {
+			t.Error("expected a session token to be resumed once")
+		}
+	})
 }
 
 func TestPiratesServer_JoinQueue(t *testing.T) {
@@ -103,6 +138,81 @@ func TestPiratesServer_LeaveQueue(t *testing.T) {
 	})
 }
 
+func TestPiratesServer_Queues(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	t.Run("every queue is listed", func(t *testing.T) {
+		req := connect.NewRequest(&pb.GetQueueStatusRequest{})
+		req.Header().Set("Authorization", p1.SessionToken)
+		resp, err := s.GetQueueStatus(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.InQueue || len(resp.Msg.Queues) != len(matchmaker.DefaultQueues()) {
+			t.Errorf("unexpected queue status: %v", resp.Msg)
+		}
+	})
+
+	t.Run("unknown queue", func(t *testing.T) {
+		req := connect.NewRequest(&pb.JoinQueueRequest{Queue: "nope"})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.JoinQueue(context.Background(), req); connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("a queue's matches use its rules", func(t *testing.T) {
+		req := connect.NewRequest(&pb.JoinQueueRequest{Queue: matchmaker.QueueSalvo})
+		req.Header().Set("Authorization", p1.SessionToken)
+		resp, err := s.JoinQueue(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.Queue != matchmaker.QueueSalvo || resp.Msg.QueuePosition != 1 || resp.Msg.PlayersInQueue != 1 {
+			t.Errorf("unexpected queue status: %v", resp.Msg)
+		}
+		for _, queue := range resp.Msg.Queues {
+			if queue.Name == matchmaker.QueueSalvo && queue.Players != 1 {
+				t.Errorf("expected 1 player in the salvo queue, got %d", queue.Players)
+			}
+		}
+
+		req = connect.NewRequest(&pb.JoinQueueRequest{Queue: matchmaker.QueueSalvo})
+		req.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.JoinQueue(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetMatchProposal() != nil
+		})
+		if rules := event.GetMatchProposal().Rules; rules.RuleSet != pb.RuleSet_RULE_SET_SALVO {
+			t.Errorf("expected the salvo rules, got %v", rules)
+		}
+	})
+
+	t.Run("a queue's matches have its accept timeout", func(t *testing.T) {
+		p3 := connectPlayer(t, s, "Player3")
+		p4 := connectPlayer(t, s, "Player4")
+		for _, p := range []*player.Player{p3, p4} {
+			req := connect.NewRequest(&pb.JoinQueueRequest{Queue: matchmaker.QueueQuick})
+			req.Header().Set("Authorization", p.SessionToken)
+			if _, err := s.JoinQueue(context.Background(), req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		event := waitForEvent(t, p3.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetMatchProposal() != nil
+		})
+		if timeout := event.GetMatchProposal().TimeoutSeconds; timeout <= 0 || timeout > 10 {
+			t.Errorf("expected at most 10s to accept, got %d", timeout)
+		}
+	})
+}
+
 func TestPiratesServer_ListPlayers(t *testing.T) {
 	s := NewPiratesServer()
 
@@ -220,3 +330,1316 @@ func TestPiratesServer_RespondToMatch(t *testing.T) {
 		}
 	})
 }
+
+// waitForEvent drains events from ch until one satisfies match, failing the
+// test if none arrives before the timeout.
+func waitForEvent(t *testing.T, ch <-chan *pb.GameEvent, match func(*pb.GameEvent) bool) *pb.GameEvent {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				t.Fatal("event channel closed")
+			}
+			if match(event) {
+				return event
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for event")
+		}
+	}
+}
+
+func connectPlayer(t *testing.T, s *PiratesServer, name string) *player.Player {
+	t.Helper()
+	resp, err := s.Connect(context.Background(), connect.NewRequest(&pb.ConnectRequest{DisplayName: name}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p, _ := s.registry.GetByID(resp.Msg.Player.Id)
+	s.registry.SetConnected(p.Proto.Id, true)
+	return p
+}
+
+func TestPiratesServer_DisconnectGracePeriod(t *testing.T) {
+	t.Run("forfeits after grace period", func(t *testing.T) {
+		s := NewPiratesServer()
+		s.disconnectGrace = 20 * time.Millisecond
+
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+		s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+
+		s.handleDisconnect(p1)
+
+		waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetOpponentDisconnected() != nil
+		})
+
+		event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetGameOver() != nil
+		})
+		gameOver := event.GetGameOver()
+		if !gameOver.YouWon {
+			t.Error("expected remaining player to win")
+		}
+		if gameOver.Reason != pb.GameOverReason_GAME_OVER_REASON_DISCONNECT {
+			t.Errorf("expected DISCONNECT, got %v", gameOver.Reason)
+		}
+		if _, ok := s.registry.GetByID(p1.Proto.Id); ok {
+			t.Error("expected disconnected player to be removed")
+		}
+	})
+
+	t.Run("reconnect cancels forfeit", func(t *testing.T) {
+		s := NewPiratesServer()
+		s.disconnectGrace = 20 * time.Millisecond
+
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+		s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+
+		s.handleDisconnect(p1)
+		s.handleReconnect(p1)
+
+		waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetOpponentReconnected() != nil
+		})
+
+		time.Sleep(50 * time.Millisecond)
+
+		s.gamesMu.RLock()
+		g, exists := s.games["game-1"]
+		s.gamesMu.RUnlock()
+		if !exists || g.GetStatus() == game.StatusFinished {
+			t.Error("expected game to still be active")
+		}
+	})
+
+	t.Run("idle player is removed immediately", func(t *testing.T) {
+		s := NewPiratesServer()
+		p := connectPlayer(t, s, "Player1")
+
+		s.handleDisconnect(p)
+
+		if _, ok := s.registry.GetByID(p.Proto.Id); ok {
+			t.Error("expected idle player to be removed")
+		}
+	})
+}
+
+func TestPiratesServer_LobbyPush(t *testing.T) {
+	t.Run("presence changes are pushed to idle players", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+
+		event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			list := e.GetPlayerList()
+			if list == nil {
+				return false
+			}
+			for _, changed := range list.ChangedPlayers {
+				if changed.Id == p2.Proto.Id {
+					return true
+				}
+			}
+			return false
+		})
+		if len(event.GetPlayerList().DepartedPlayerIds) != 0 {
+			t.Error("expected no departed players")
+		}
+
+		s.cleanupPlayer(p2)
+
+		waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			list := e.GetPlayerList()
+			return list != nil && len(list.DepartedPlayerIds) == 1 && list.DepartedPlayerIds[0] == p2.Proto.Id
+		})
+	})
+
+	t.Run("queue position is pushed to queued players", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+
+		req := connect.NewRequest(&pb.JoinQueueRequest{})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.JoinQueue(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetQueueStatus() != nil
+		})
+		status := event.GetQueueStatus()
+		if !status.InQueue || status.QueuePosition != 1 || status.PlayersInQueue != 1 {
+			t.Errorf("unexpected queue status: %v", status)
+		}
+	})
+}
+
+func TestPiratesServer_SendChatMessage(t *testing.T) {
+	sendChat := func(s *PiratesServer, p *player.Player, msg *pb.SendChatMessageRequest) error {
+		req := connect.NewRequest(msg)
+		req.Header().Set("Authorization", p.SessionToken)
+		_, err := s.SendChatMessage(context.Background(), req)
+		return err
+	}
+
+	t.Run("game chat reaches opponent and game log", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+		s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+
+		err := sendChat(s, p1, &pb.SendChatMessageRequest{
+			Scope: pb.ChatScope_CHAT_SCOPE_GAME,
+			Text:  "Ahoy!",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetChatMessage() != nil
+		})
+		if event.GetChatMessage().Text != "Ahoy!" || event.GetChatMessage().SenderId != p1.Proto.Id {
+			t.Errorf("unexpected chat message: %v", event.GetChatMessage())
+		}
+	})
+
+	t.Run("emote", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+		s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+
+		err := sendChat(s, p1, &pb.SendChatMessageRequest{
+			Scope: pb.ChatScope_CHAT_SCOPE_GAME,
+			Emote: pb.QuickEmote_QUICK_EMOTE_NICE_SHOT,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetChatMessage() != nil
+		})
+		if event.GetChatMessage().Emote != pb.QuickEmote_QUICK_EMOTE_NICE_SHOT {
+			t.Errorf("expected NICE_SHOT emote, got %v", event.GetChatMessage().Emote)
+		}
+	})
+
+	t.Run("not in a game", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+
+		err := sendChat(s, p1, &pb.SendChatMessageRequest{
+			Scope: pb.ChatScope_CHAT_SCOPE_GAME,
+			Text:  "Ahoy!",
+		})
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+
+		var err error
+		for i := 0; i < 6 && err == nil; i++ {
+			err = sendChat(s, p1, &pb.SendChatMessageRequest{
+				Scope: pb.ChatScope_CHAT_SCOPE_LOBBY,
+				Text:  "Ahoy!",
+			})
+		}
+		if connect.CodeOf(err) != connect.CodeResourceExhausted {
+			t.Errorf("expected ResourceExhausted, got %v", err)
+		}
+	})
+
+	t.Run("muted sender", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+
+		muteReq := connect.NewRequest(&pb.MutePlayerRequest{PlayerId: p1.Proto.Id, Muted: true})
+		muteReq.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.MutePlayer(context.Background(), muteReq); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		err := sendChat(s, p1, &pb.SendChatMessageRequest{
+			Scope: pb.ChatScope_CHAT_SCOPE_LOBBY,
+			Text:  "Ahoy!",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		timeout := time.After(50 * time.Millisecond)
+		for {
+			select {
+			case e := <-p2.EventChannel:
+				if e.GetChatMessage() != nil {
+					t.Fatal("expected muted sender's message to be dropped")
+				}
+			case <-timeout:
+				return
+			}
+		}
+	})
+
+	t.Run("blocked players do not hear each other", func(t *testing.T) {
+		s := NewPiratesServer()
+		p1 := connectPlayer(t, s, "Player1")
+		p2 := connectPlayer(t, s, "Player2")
+
+		block := connect.NewRequest(&pb.BlockPlayerRequest{PlayerId: p1.Proto.Id})
+		block.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.BlockPlayer(context.Background(), block); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, sender := range []*player.Player{p1, p2} {
+			err := sendChat(s, sender, &pb.SendChatMessageRequest{
+				Scope: pb.ChatScope_CHAT_SCOPE_LOBBY,
+				Text:  "Ahoy!",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		timeout := time.After(50 * time.Millisecond)
+		for {
+			select {
+			case e := <-p1.EventChannel:
+				if e.GetChatMessage() != nil {
+					t.Fatal("expected the blocker's message to be dropped")
+				}
+			case e := <-p2.EventChannel:
+				if e.GetChatMessage() != nil {
+					t.Fatal("expected the blocked player's message to be dropped")
+				}
+			case <-timeout:
+				return
+			}
+		}
+	})
+}
+
+func TestPiratesServer_PrivateLobby(t *testing.T) {
+	s := NewPiratesServer()
+	host := connectPlayer(t, s, "Host")
+	guest := connectPlayer(t, s, "Guest")
+
+	createReq := connect.NewRequest(&pb.CreatePrivateLobbyRequest{
+		Rules: &pb.GameRules{PowersDisabled: true, Teams: true},
+	})
+	createReq.Header().Set("Authorization", host.SessionToken)
+	created, err := s.CreatePrivateLobby(context.Background(), createReq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Msg.Rules.Teams {
+		t.Error("expected a private lobby to be played one-on-one")
+	}
+	code := created.Msg.Code
+
+	t.Run("host is hidden from the public list", func(t *testing.T) {
+		for _, p := range s.registry.GetAvailablePlayers() {
+			if p.Proto.Id == host.Proto.Id {
+				t.Error("expected host to be hidden")
+			}
+		}
+	})
+
+	t.Run("host's status is not broadcast", func(t *testing.T) {
+		waitForEvent(t, guest.EventChannel, func(e *pb.GameEvent) bool {
+			for _, changed := range e.GetPlayerList().GetChangedPlayers() {
+				if changed.Status == pb.PlayerStatus_PLAYER_STATUS_IN_PRIVATE_LOBBY {
+					t.Errorf("expected the host hidden, got %v", changed)
+				}
+			}
+			return slices.Contains(e.GetPlayerList().GetDepartedPlayerIds(), host.Proto.Id)
+		})
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		req := connect.NewRequest(&pb.JoinPrivateLobbyRequest{Code: "NOPE42"})
+		req.Header().Set("Authorization", guest.SessionToken)
+		_, err := s.JoinPrivateLobby(context.Background(), req)
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("join proposes a match with the host's rules", func(t *testing.T) {
+		req := connect.NewRequest(&pb.JoinPrivateLobbyRequest{Code: code})
+		req.Header().Set("Authorization", guest.SessionToken)
+		resp, err := s.JoinPrivateLobby(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		event := waitForEvent(t, guest.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetMatchProposal() != nil
+		})
+		proposal := event.GetMatchProposal()
+		if proposal.MatchId != resp.Msg.MatchId {
+			t.Errorf("expected match %q, got %q", resp.Msg.MatchId, proposal.MatchId)
+		}
+		if proposal.Opponent.Id != host.Proto.Id {
+			t.Error("expected the host as opponent")
+		}
+		if !proposal.Rules.PowersDisabled {
+			t.Error("expected the host's rules in the proposal")
+		}
+	})
+
+	t.Run("lobby closes when the game starts", func(t *testing.T) {
+		s.createGame([]string{host.Proto.Id, guest.Proto.Id}, "game-1", game.DefaultRules())
+		if _, ok := s.privateLobbies.HostedBy(host.Proto.Id); ok {
+			t.Error("expected lobby to be closed")
+		}
+	})
+}
+
+func TestPiratesServer_AttackSalvo(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	rules := game.DefaultRules()
+	rules.RuleSet = pb.RuleSet_RULE_SET_SALVO
+	rules.SalvoShots = 2
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", rules)
+
+	s.gamesMu.RLock()
+	g := s.games["game-1"]
+	s.gamesMu.RUnlock()
+
+	ships := []*pb.Ship{
+		{Id: "ship-1", Name: "Galion", Size: 5, Start: &pb.Coordinate{X: 0, Y: 0}, Horizontal: true},
+		{Id: "ship-2", Name: "Frégate", Size: 4, Start: &pb.Coordinate{X: 0, Y: 1}, Horizontal: true},
+		{Id: "ship-3", Name: "Brick", Size: 3, Start: &pb.Coordinate{X: 0, Y: 2}, Horizontal: true},
+		{Id: "ship-4", Name: "Corvette", Size: 3, Start: &pb.Coordinate{X: 0, Y: 3}, Horizontal: true},
+		{Id: "ship-5", Name: "Chaloupe", Size: 2, Start: &pb.Coordinate{X: 0, Y: 4}, Horizontal: true},
+	}
+	g.PlaceShips(p1.Proto.Id, ships)
+	g.PlaceShips(p2.Proto.Id, ships)
+	g.StartGame()
+
+	req := connect.NewRequest(&pb.AttackSalvoRequest{
+		Targets: []*pb.Coordinate{{X: 0, Y: 0}, {X: 9, Y: 9}},
+	})
+	req.Header().Set("Authorization", p1.SessionToken)
+	resp, err := s.AttackSalvo(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Msg.Shots) != 2 {
+		t.Fatalf("expected 2 shots, got %d", len(resp.Msg.Shots))
+	}
+
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetOpponentAction().GetSalvo() != nil
+	})
+	if updates := event.GetOpponentAction().YourGridUpdates; len(updates) != 2 ||
+		updates[0].State != pb.CellState_CELL_STATE_HIT || updates[1].State != pb.CellState_CELL_STATE_MISS {
+		t.Errorf("unexpected grid updates: %v", updates)
+	}
+
+	event = waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetTurnStarted() != nil
+	})
+	if turn := event.GetTurnStarted(); !turn.YourTurn || turn.SalvoShots != 2 {
+		t.Errorf("expected player 2's salvo turn with 2 shots, got %v", turn)
+	}
+	if cells := event.GetTurnStarted().GetView().GetYourBoard().GetCells(); len(cells) != 2 {
+		t.Errorf("expected the view to show the 2 shots on player 2's board, got %v", cells)
+	}
+}
+
+func TestPiratesServer_PreviewPower(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+
+	s.gamesMu.RLock()
+	g := s.games["game-1"]
+	s.gamesMu.RUnlock()
+
+	ships := []*pb.Ship{
+		{Id: "ship-1", Name: "Galion", Size: 5, Start: &pb.Coordinate{X: 0, Y: 0}, Horizontal: true},
+		{Id: "ship-2", Name: "Frégate", Size: 4, Start: &pb.Coordinate{X: 0, Y: 1}, Horizontal: true},
+		{Id: "ship-3", Name: "Brick", Size: 3, Start: &pb.Coordinate{X: 0, Y: 2}, Horizontal: true},
+		{Id: "ship-4", Name: "Corvette", Size: 3, Start: &pb.Coordinate{X: 0, Y: 3}, Horizontal: true},
+		{Id: "ship-5", Name: "Chaloupe", Size: 2, Start: &pb.Coordinate{X: 0, Y: 4}, Horizontal: true},
+	}
+	g.PlaceShips(p1.Proto.Id, ships)
+	g.PlaceShips(p2.Proto.Id, ships)
+	g.StartGame()
+
+	ps, _ := g.GetPlayerState(p1.Proto.Id)
+	ps.Powers[pb.PowerType_POWER_TYPE_TRIPLE] = 1
+
+	t.Run("legacy vertical flag", func(t *testing.T) {
+		req := connect.NewRequest(&pb.PreviewPowerRequest{
+			Power:  pb.PowerType_POWER_TYPE_TRIPLE,
+			Target: &pb.Coordinate{X: 5, Y: 5},
+		})
+		req.Header().Set("Authorization", p1.SessionToken)
+		resp, err := s.PreviewPower(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cells := resp.Msg.Cells; len(cells) != 3 || cells[0].X != 5 || cells[0].Y != 4 {
+			t.Errorf("expected a vertical line, got %v", cells)
+		}
+	})
+
+	t.Run("diagonal orientation", func(t *testing.T) {
+		req := connect.NewRequest(&pb.PreviewPowerRequest{
+			Power:       pb.PowerType_POWER_TYPE_TRIPLE,
+			Target:      &pb.Coordinate{X: 5, Y: 5},
+			Orientation: pb.Orientation_ORIENTATION_NORTH_EAST,
+		})
+		req.Header().Set("Authorization", p1.SessionToken)
+		resp, err := s.PreviewPower(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cells := resp.Msg.Cells; len(cells) != 3 || cells[0].X != 4 || cells[0].Y != 6 {
+			t.Errorf("expected a diagonal line, got %v", cells)
+		}
+	})
+
+	t.Run("missing power", func(t *testing.T) {
+		req := connect.NewRequest(&pb.PreviewPowerRequest{
+			Power:  pb.PowerType_POWER_TYPE_SONAR,
+			Target: &pb.Coordinate{X: 5, Y: 5},
+		})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.PreviewPower(context.Background(), req); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestPiratesServer_Terrain(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	rules := game.DefaultRules()
+	rules.Terrain = true
+	rules.TerrainSeed = 42
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", rules)
+
+	event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameStarted() != nil
+	})
+	terrain := event.GetGameStarted().Terrain
+	if len(terrain.GetIslands()) != game.IslandCount || len(terrain.GetYourMines()) != game.MinesPerBoard {
+		t.Fatalf("unexpected terrain in GameStarted: %v", terrain)
+	}
+
+	s.gamesMu.RLock()
+	g := s.games["game-1"]
+	s.gamesMu.RUnlock()
+
+	if err := g.PlaceShips(p1.Proto.Id, fleetAvoidingTerrain(t, g.Player1State)); err != nil {
+		t.Fatalf("PlaceShips failed: %v", err)
+	}
+	if err := g.PlaceShips(p2.Proto.Id, fleetAvoidingTerrain(t, g.Player2State)); err != nil {
+		t.Fatalf("PlaceShips failed: %v", err)
+	}
+	g.StartGame()
+
+	// Player 1 fires at one of player 2's mines.
+	mine := g.Player2State.Grid.Mines()[0]
+	req := connect.NewRequest(&pb.AttackRequest{Target: &pb.Coordinate{X: int32(mine.X), Y: int32(mine.Y)}})
+	req.Header().Set("Authorization", p1.SessionToken)
+	resp, err := s.Attack(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Msg.Mine || resp.Msg.MineBlast.GetTarget() == nil {
+		t.Fatalf("expected the attacker to see where the mine hit them, got %v", resp.Msg)
+	}
+
+	event = waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetOpponentAction().GetAttack() != nil
+	})
+	attack := event.GetOpponentAction().GetAttack()
+	if !attack.Mine || attack.MineBlast.GetTarget() != nil {
+		t.Errorf("expected the mine owner to learn of the blast but not its cell, got %v", attack)
+	}
+	if updates := event.GetOpponentAction().YourGridUpdates; len(updates) != 1 || updates[0].State != pb.CellState_CELL_STATE_MINE {
+		t.Errorf("expected the mine cell as the grid update, got %v", updates)
+	}
+}
+
+// fleetAvoidingTerrain lays the required fleet out horizontally, one ship
+// per row, in the first rows with room for it.
+func fleetAvoidingTerrain(t *testing.T, ps *game.PlayerState) []*pb.Ship {
+	t.Helper()
+	var ships []*pb.Ship
+	y := 0
+	for i, def := range game.RequiredShips {
+		placed := false
+		for ; y < game.GridSize && !placed; y += 2 {
+			for x := 0; x+def.Size <= game.GridSize && !placed; x++ {
+				free := true
+				for dx := 0; dx < def.Size; dx++ {
+					if cell := ps.Grid[x+dx][y]; cell.Island || cell.Mine {
+						free = false
+					}
+				}
+				if free {
+					ships = append(ships, &pb.Ship{
+						Id: fmt.Sprintf("ship-%d", i+1), Name: def.Name, Size: int32(def.Size),
+						Start: &pb.Coordinate{X: int32(x), Y: int32(y)}, Horizontal: true,
+					})
+					placed = true
+				}
+			}
+		}
+		if !placed {
+			t.Fatalf("no room for %s", def.Name)
+		}
+	}
+	return ships
+}
+
+func TestPiratesServer_FreeForAll(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+	p3 := connectPlayer(t, s, "Player3")
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id, p3.Proto.Id}, "game-1", game.DefaultRules())
+
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameStarted() != nil
+	})
+	if opponents := event.GetGameStarted().Opponents; len(opponents) != 2 ||
+		opponents[0].Id != p3.Proto.Id || opponents[1].Id != p1.Proto.Id {
+		t.Fatalf("expected player 2 to face players 3 and 1, got %v", opponents)
+	}
+
+	s.gamesMu.RLock()
+	g := s.games["game-1"]
+	s.gamesMu.RUnlock()
+
+	ships := []*pb.Ship{
+		{Id: "ship-1", Name: "Galion", Size: 5, Start: &pb.Coordinate{X: 0, Y: 0}, Horizontal: true},
+		{Id: "ship-2", Name: "Frégate", Size: 4, Start: &pb.Coordinate{X: 0, Y: 1}, Horizontal: true},
+		{Id: "ship-3", Name: "Brick", Size: 3, Start: &pb.Coordinate{X: 0, Y: 2}, Horizontal: true},
+		{Id: "ship-4", Name: "Corvette", Size: 3, Start: &pb.Coordinate{X: 0, Y: 3}, Horizontal: true},
+		{Id: "ship-5", Name: "Chaloupe", Size: 2, Start: &pb.Coordinate{X: 0, Y: 4}, Horizontal: true},
+	}
+	for _, p := range []*player.Player{p1, p2, p3} {
+		g.PlaceShips(p.Proto.Id, ships)
+	}
+	g.StartGame()
+
+	req := connect.NewRequest(&pb.AttackRequest{
+		Target:         &pb.Coordinate{X: 0, Y: 0},
+		TargetPlayerId: p3.Proto.Id,
+	})
+	req.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.Attack(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event = waitForEvent(t, p3.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetOpponentAction() != nil
+	})
+	if action := event.GetOpponentAction(); action.AttackerId != p1.Proto.Id || len(action.YourGridUpdates) != 1 {
+		t.Errorf("expected player 3 to be told of the hit on their grid, got %v", action)
+	}
+	event = waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetOpponentAction() != nil
+	})
+	if action := event.GetOpponentAction(); action.TargetPlayerId != p3.Proto.Id || len(action.YourGridUpdates) != 0 {
+		t.Errorf("expected player 2 to watch the attack on player 3, got %v", action)
+	}
+	event = waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetTurnStarted() != nil
+	})
+	if !event.GetTurnStarted().YourTurn {
+		t.Fatal("expected player 2 to play next")
+	}
+
+	forfeit := connect.NewRequest(&pb.ForfeitRequest{})
+	forfeit.Header().Set("Authorization", p2.SessionToken)
+	if _, err := s.Forfeit(context.Background(), forfeit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event = waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetPlayerEliminated() != nil
+	})
+	if eliminated := event.GetPlayerEliminated(); eliminated.PlayerId != p2.Proto.Id ||
+		eliminated.Reason != pb.GameOverReason_GAME_OVER_REASON_FORFEIT {
+		t.Errorf("unexpected elimination: %v", eliminated)
+	}
+	waitForEvent(t, p3.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetTurnStarted() != nil && e.GetTurnStarted().YourTurn
+	})
+	if g.GetStatus() == game.StatusFinished {
+		t.Error("expected the game to go on with two players left")
+	}
+
+	waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetTurnStarted() != nil
+	})
+	if _, err := s.Forfeit(context.Background(), forfeit); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a player already out, got %v", err)
+	}
+	timeout := time.After(50 * time.Millisecond)
+	for {
+		select {
+		case e := <-p1.EventChannel:
+			if e.GetPlayerEliminated() != nil || e.GetTurnStarted() != nil {
+				t.Fatalf("expected nothing to be broadcast, got %v", e)
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
+func TestPiratesServer_Teams(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+	p3 := connectPlayer(t, s, "Player3")
+	p4 := connectPlayer(t, s, "Player4")
+
+	t.Run("an ally needs two opponents", func(t *testing.T) {
+		req := connect.NewRequest(&pb.ChallengePlayerRequest{
+			TargetPlayerId: p2.Proto.Id,
+			AllyPlayerId:   p3.Proto.Id,
+		})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.ChallengePlayer(context.Background(), req); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("teams are proposed and seated", func(t *testing.T) {
+		req := connect.NewRequest(&pb.ChallengePlayerRequest{
+			TargetPlayerId:      p2.Proto.Id,
+			AllyPlayerId:        p3.Proto.Id,
+			AdditionalPlayerIds: []string{p4.Proto.Id},
+		})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.ChallengePlayer(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		event := waitForEvent(t, p4.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetMatchProposal() != nil
+		})
+		proposal := event.GetMatchProposal()
+		if !proposal.Rules.Teams || len(proposal.Allies) != 1 || proposal.Allies[0].Id != p2.Proto.Id ||
+			len(proposal.Opponents) != 2 {
+			t.Fatalf("expected player 4 to team up with player 2, got %v", proposal)
+		}
+
+		rules := game.DefaultRules()
+		rules.Teams = true
+		s.createGame([]string{p1.Proto.Id, p2.Proto.Id, p3.Proto.Id, p4.Proto.Id}, "game-1", rules)
+		event = waitForEvent(t, p3.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetGameStarted() != nil
+		})
+		started := event.GetGameStarted()
+		if len(started.Allies) != 1 || started.Allies[0].Id != p1.Proto.Id || len(started.Opponents) != 2 {
+			t.Errorf("expected player 3 to team up with player 1, got %v", started)
+		}
+	})
+}
+
+func TestPiratesServer_Parties(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	invite := connect.NewRequest(&pb.InviteToPartyRequest{PlayerId: p2.Proto.Id})
+	invite.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.InviteToParty(context.Background(), invite); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetPartyInvite() != nil
+	})
+	partyInvite := event.GetPartyInvite()
+	if partyInvite.Inviter.Id != p1.Proto.Id {
+		t.Fatalf("expected an invitation from player 1, got %v", partyInvite)
+	}
+
+	respond := connect.NewRequest(&pb.RespondToPartyInviteRequest{PartyId: partyInvite.Party.Id, Accepted: true})
+	respond.Header().Set("Authorization", p2.SessionToken)
+	if _, err := s.RespondToPartyInvite(context.Background(), respond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	event = waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetPartyUpdate() != nil
+	})
+	if members := event.GetPartyUpdate().Members; len(members) != 2 || members[1].Id != p2.Proto.Id {
+		t.Fatalf("expected player 2 to join, got %v", members)
+	}
+
+	t.Run("only the leader queues the party", func(t *testing.T) {
+		req := connect.NewRequest(&pb.JoinQueueRequest{})
+		req.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.JoinQueue(context.Background(), req); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", err)
+		}
+
+		req = connect.NewRequest(&pb.JoinQueueRequest{Teams: true})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.JoinQueue(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if snapshot, _ := s.registry.Snapshot(p2.Proto.Id); snapshot.Status != pb.PlayerStatus_PLAYER_STATUS_IN_QUEUE {
+			t.Errorf("expected player 2 in the queue, got %v", snapshot.Status)
+		}
+	})
+
+	t.Run("the party plays 2v2 as a team", func(t *testing.T) {
+		for _, name := range []string{"Player3", "Player4"} {
+			p := connectPlayer(t, s, name)
+			req := connect.NewRequest(&pb.JoinQueueRequest{Teams: true})
+			req.Header().Set("Authorization", p.SessionToken)
+			if _, err := s.JoinQueue(context.Background(), req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetMatchProposal() != nil
+		})
+		proposal := event.GetMatchProposal()
+		if !proposal.Rules.Teams || len(proposal.Allies) != 1 || proposal.Allies[0].Id != p1.Proto.Id ||
+			len(proposal.Opponents) != 2 {
+			t.Errorf("expected player 2 to team up with player 1, got %v", proposal)
+		}
+	})
+
+	t.Run("leaving disbands a party of two", func(t *testing.T) {
+		req := connect.NewRequest(&pb.LeavePartyRequest{})
+		req.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.LeaveParty(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetPartyDisbanded() != nil
+		})
+		if s.matchmaker.IsInQueue(p1.Proto.Id) {
+			t.Error("expected the party to leave the queue")
+		}
+	})
+}
+
+func TestPiratesServer_Tournament(t *testing.T) {
+	s := NewPiratesServer()
+	organizer := connectPlayer(t, s, "Organizer")
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	create := connect.NewRequest(&pb.CreateTournamentRequest{
+		Name:   "Cup",
+		Format: pb.TournamentFormat_TOURNAMENT_FORMAT_SINGLE_ELIMINATION,
+	})
+	create.Header().Set("Authorization", organizer.SessionToken)
+	created, err := s.CreateTournament(context.Background(), create)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tournamentID := created.Msg.Id
+
+	for _, p := range []*player.Player{p1, p2} {
+		req := connect.NewRequest(&pb.RegisterForTournamentRequest{TournamentId: tournamentID})
+		req.Header().Set("Authorization", p.SessionToken)
+		if _, err := s.RegisterForTournament(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	start := connect.NewRequest(&pb.StartTournamentRequest{TournamentId: tournamentID})
+	start.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.StartTournament(context.Background(), start); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected PermissionDenied for a player, got %v", err)
+	}
+	start.Header().Set("Authorization", organizer.SessionToken)
+	if _, err := s.StartTournament(context.Background(), start); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.scheduleTournaments()
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetMatchProposal() != nil
+	})
+	if proposal := event.GetMatchProposal(); proposal.TournamentId != tournamentID {
+		t.Fatalf("expected a tournament match, got %v", proposal)
+	}
+
+	s.handleGameCreated(s.matchmaker.GetPendingMatch(p1.Proto.Id), "game-1")
+	forfeit := connect.NewRequest(&pb.ForfeitRequest{})
+	forfeit.Header().Set("Authorization", p2.SessionToken)
+	if _, err := s.Forfeit(context.Background(), forfeit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event = waitForEvent(t, organizer.EventChannel, func(e *pb.GameEvent) bool {
+		update := e.GetTournamentUpdate()
+		return update != nil && update.Tournament.Status == pb.TournamentStatus_TOURNAMENT_STATUS_FINISHED
+	})
+	if winner := event.GetTournamentUpdate().Tournament.WinnerId; winner != p1.Proto.Id {
+		t.Errorf("expected player 1 to win the tournament, got %q", winner)
+	}
+}
+
+func TestPiratesServer_DailyPuzzle(t *testing.T) {
+	s := NewPiratesServer(WithPuzzleSecret("secret"))
+	p1 := connectPlayer(t, s, "Player1")
+
+	attack := connect.NewRequest(&pb.PuzzleAttackRequest{Target: &pb.Coordinate{X: 0, Y: 0}})
+	attack.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.PuzzleAttack(context.Background(), attack); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("expected FailedPrecondition before the puzzle starts, got %v", err)
+	}
+
+	start := connect.NewRequest(&pb.StartDailyPuzzleRequest{})
+	start.Header().Set("Authorization", p1.SessionToken)
+	started, err := s.StartDailyPuzzle(context.Background(), start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(started.Msg.Board.Ships) != 0 || len(started.Msg.Board.Cells) != 0 {
+		t.Errorf("expected the fleet to stay hidden, got %v", started.Msg.Board)
+	}
+
+	shot, err := s.PuzzleAttack(context.Background(), attack)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shot.Msg.GetAttack() == nil || shot.Msg.Puzzle.Shots != 1 || len(shot.Msg.Puzzle.Board.Cells) != 1 {
+		t.Errorf("unexpected shot result: %v", shot.Msg)
+	}
+	if _, err := s.PuzzleAttack(context.Background(), attack); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected InvalidArgument for a cell already fired at, got %v", err)
+	}
+
+	s.gamesMu.RLock()
+	inGames := len(s.games)
+	s.gamesMu.RUnlock()
+	if inGames != 0 {
+		t.Error("puzzles should stay out of the multiplayer games")
+	}
+
+	leaderboard := connect.NewRequest(&pb.GetPuzzleLeaderboardRequest{Date: "yesterday"})
+	leaderboard.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.GetPuzzleLeaderboard(context.Background(), leaderboard); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected InvalidArgument for a malformed date, got %v", err)
+	}
+	leaderboard.Msg.Date = ""
+	board, err := s.GetPuzzleLeaderboard(context.Background(), leaderboard)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if board.Msg.Date != shot.Msg.Puzzle.Date || len(board.Msg.Scores) != 0 {
+		t.Errorf("unexpected leaderboard: %v", board.Msg)
+	}
+}
+
+func TestPiratesServer_Stats(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	profileOf := func(p *player.Player, playerID string) *pb.PlayerProfile {
+		t.Helper()
+		req := connect.NewRequest(&pb.GetPlayerProfileRequest{PlayerId: playerID})
+		req.Header().Set("Authorization", p.SessionToken)
+		resp, err := s.GetPlayerProfile(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resp.Msg
+	}
+
+	if profile := profileOf(p1, ""); profile.GamesPlayed != 0 || profile.Rating != 1000 || profile.DisplayName != "Player1" {
+		t.Errorf("expected a blank profile, got %v", profile)
+	}
+
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+	forfeit := connect.NewRequest(&pb.ForfeitRequest{})
+	forfeit.Header().Set("Authorization", p2.SessionToken)
+	if _, err := s.Forfeit(context.Background(), forfeit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameOver() != nil
+	})
+	if change := event.GetGameOver().Summary.RatingChange; change != 16 {
+		t.Errorf("expected player 1 to gain 16 points, got %d", change)
+	}
+
+	if profile := profileOf(p1, p2.Proto.Id); profile.Losses != 1 || profile.Forfeits != 1 || profile.CurrentStreak != -1 {
+		t.Errorf("unexpected profile: %v", profile)
+	}
+
+	req := connect.NewRequest(&pb.GetPlayerProfileRequest{PlayerId: "nobody"})
+	req.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.GetPlayerProfile(context.Background(), req); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	leaderboard := connect.NewRequest(&pb.GetLeaderboardRequest{Scope: pb.LeaderboardScope_LEADERBOARD_SCOPE_WEEKLY})
+	leaderboard.Header().Set("Authorization", p1.SessionToken)
+	board, err := s.GetLeaderboard(context.Background(), leaderboard)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(board.Msg.Entries) != 2 || board.Msg.Entries[0].PlayerId != p1.Proto.Id {
+		t.Errorf("unexpected leaderboard: %v", board.Msg)
+	}
+
+	leaderboard.Msg.PageToken = "nope"
+	if _, err := s.GetLeaderboard(context.Background(), leaderboard); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestPiratesServer_Achievements(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	// A win by forfeit unlocks nothing.
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+	forfeit := connect.NewRequest(&pb.ForfeitRequest{})
+	forfeit.Header().Set("Authorization", p2.SessionToken)
+	if _, err := s.Forfeit(context.Background(), forfeit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-2", game.DefaultRules())
+	s.gamesMu.RLock()
+	g := s.games["game-2"]
+	s.gamesMu.RUnlock()
+	g.Leave(p2.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+	s.afterLeave(g, p2.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+
+	event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetAchievementUnlocked() != nil
+	})
+	if unlocked := event.GetAchievementUnlocked(); unlocked.Id != "flawless-victory" {
+		t.Errorf("expected Flawless Victory, got %v", unlocked)
+	}
+
+	req := connect.NewRequest(&pb.GetPlayerProfileRequest{})
+	req.Header().Set("Authorization", p1.SessionToken)
+	profile, err := s.GetPlayerProfile(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var unlocked []string
+	for _, a := range profile.Msg.Achievements {
+		if a.Unlocked {
+			unlocked = append(unlocked, a.Id)
+		}
+		if a.Id == "sea-wolf" && a.Progress != 1 {
+			t.Errorf("expected only the second win to count, got %v", a)
+		}
+	}
+	if len(profile.Msg.Achievements) < 2 || !slices.Equal(unlocked, []string{"flawless-victory"}) {
+		t.Errorf("unexpected achievements: %v", profile.Msg.Achievements)
+	}
+}
+
+func TestPiratesServer_Friends(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+	p3 := connectPlayer(t, s, "Player3")
+
+	send := connect.NewRequest(&pb.SendFriendRequestRequest{DisplayName: "player2"})
+	send.Header().Set("Authorization", p1.SessionToken)
+	list, err := s.SendFriendRequest(context.Background(), send)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Msg.OutgoingRequests) != 1 || list.Msg.OutgoingRequests[0].Id != p2.Proto.Id {
+		t.Errorf("expected a request to Player2, got %v", list.Msg)
+	}
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetFriendRequest() != nil
+	})
+	if event.GetFriendRequest().From.Id != p1.Proto.Id {
+		t.Errorf("expected a request from Player1, got %v", event.GetFriendRequest())
+	}
+
+	respond := connect.NewRequest(&pb.RespondToFriendRequestRequest{PlayerId: p1.Proto.Id, Accepted: true})
+	respond.Header().Set("Authorization", p2.SessionToken)
+	if _, err := s.RespondToFriendRequest(context.Background(), respond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return len(e.GetFriendList().GetFriends()) == 1
+	})
+
+	t.Run("a name shared by several players is refused", func(t *testing.T) {
+		connectPlayer(t, s, "PLAYER3")
+		req := connect.NewRequest(&pb.SendFriendRequestRequest{DisplayName: "player3"})
+		req.Header().Set("Authorization", p1.SessionToken)
+		if _, err := s.SendFriendRequest(context.Background(), req); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("a friend in game is challenged once it is over", func(t *testing.T) {
+		s.createGame([]string{p2.Proto.Id, p3.Proto.Id}, "game-1", game.DefaultRules())
+		waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			friends := e.GetFriendList().GetFriends()
+			return len(friends) == 1 && friends[0].Status == pb.PlayerStatus_PLAYER_STATUS_IN_GAME
+		})
+
+		challenge := connect.NewRequest(&pb.ChallengeFriendRequest{DisplayName: "Player2"})
+		challenge.Header().Set("Authorization", p1.SessionToken)
+		resp, err := s.ChallengeFriend(context.Background(), challenge)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !resp.Msg.Queued || resp.Msg.MatchId != "" {
+			t.Fatalf("expected the challenge queued, got %v", resp.Msg)
+		}
+
+		forfeit := connect.NewRequest(&pb.ForfeitRequest{})
+		forfeit.Header().Set("Authorization", p3.SessionToken)
+		if _, err := s.Forfeit(context.Background(), forfeit); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetMatchProposal() != nil
+		})
+		if proposal := event.GetMatchProposal(); proposal.Opponent.Id != p1.Proto.Id {
+			t.Errorf("expected a challenge from Player1, got %v", proposal)
+		}
+
+		respond := connect.NewRequest(&pb.RespondToMatchRequest{MatchId: event.GetMatchProposal().MatchId})
+		respond.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.RespondToMatch(context.Background(), respond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("only friends are challenged by name", func(t *testing.T) {
+		challenge := connect.NewRequest(&pb.ChallengeFriendRequest{DisplayName: "Player3"})
+		challenge.Header().Set("Authorization", p1.SessionToken)
+		_, err := s.ChallengeFriend(context.Background(), challenge)
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("blocked players cannot challenge", func(t *testing.T) {
+		block := connect.NewRequest(&pb.BlockPlayerRequest{PlayerId: p2.Proto.Id})
+		block.Header().Set("Authorization", p1.SessionToken)
+		list, err := s.BlockPlayer(context.Background(), block)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(list.Msg.Friends) != 0 || !slices.Equal(list.Msg.BlockedPlayerIds, []string{p2.Proto.Id}) {
+			t.Errorf("unexpected friend list: %v", list.Msg)
+		}
+
+		challenge := connect.NewRequest(&pb.ChallengePlayerRequest{TargetPlayerId: p1.Proto.Id})
+		challenge.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.ChallengePlayer(context.Background(), challenge); !errors.Is(err, matchmaker.ErrPlayerUnavailable) {
+			t.Errorf("expected ErrPlayerUnavailable, got %v", err)
+		}
+
+		send := connect.NewRequest(&pb.SendFriendRequestRequest{PlayerId: p1.Proto.Id})
+		send.Header().Set("Authorization", p2.SessionToken)
+		if _, err := s.SendFriendRequest(context.Background(), send); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", err)
+		}
+	})
+}
+
+func TestPiratesServer_ChallengeOptions(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	options := &pb.GameRules{
+		RuleSet:      pb.RuleSet_RULE_SET_SALVO,
+		BonusTurn:    pb.BonusTurn_BONUS_TURN_ON_HIT,
+		TurnSeconds:  45,
+		SeriesLength: 3,
+		Unranked:     true,
+	}
+	challenge := connect.NewRequest(&pb.ChallengePlayerRequest{TargetPlayerId: p2.Proto.Id, Rules: options})
+	challenge.Header().Set("Authorization", p1.SessionToken)
+	if _, err := s.ChallengePlayer(context.Background(), challenge); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetMatchProposal() != nil
+	})
+	proposal := event.GetMatchProposal()
+	if !proto.Equal(proposal.Rules, options) {
+		t.Errorf("expected the options in the proposal, got %v", proposal.Rules)
+	}
+	for _, p := range []*player.Player{p1, p2} {
+		respond := connect.NewRequest(&pb.RespondToMatchRequest{MatchId: proposal.MatchId, Accepted: true})
+		respond.Header().Set("Authorization", p.SessionToken)
+		if _, err := s.RespondToMatch(context.Background(), respond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	event = waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameStarted() != nil
+	})
+	started := event.GetGameStarted()
+	if !proto.Equal(started.Rules, options) || started.Series.GetGameNumber() != 1 || started.Series.GetLength() != 3 {
+		t.Errorf("unexpected game: %v", started)
+	}
+	s.gamesMu.RLock()
+	g := s.games[started.GameId]
+	s.gamesMu.RUnlock()
+	if g.Rules != game.RulesFromProto(options) {
+		t.Errorf("expected the game played with the options, got %+v", g.Rules)
+	}
+
+	t.Run("the series goes on after a game", func(t *testing.T) {
+		g.Leave(p2.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+		s.afterLeave(g, p2.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+
+		event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetGameOver() != nil
+		})
+		if series := event.GetGameOver().Series; series.GetOver() || series.GetScores()[0].Wins != 1 {
+			t.Errorf("unexpected series: %v", series)
+		}
+		event = waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetGameStarted() != nil
+		})
+		if next := event.GetGameStarted(); next.Series.GetGameNumber() != 2 || next.YourTurnFirst {
+			t.Errorf("expected game 2 with Player2 first, got %v", next)
+		}
+	})
+
+	t.Run("a player gone between games gives up the series", func(t *testing.T) {
+		s.gamesMu.RLock()
+		g := s.games[s.registry.CurrentGame(p1.Proto.Id)]
+		s.gamesMu.RUnlock()
+		s.registry.SetConnected(p2.Proto.Id, false)
+		g.Leave(p1.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+		s.afterLeave(g, p1.Proto.Id, pb.GameOverReason_GAME_OVER_REASON_ALL_SHIPS_SUNK)
+
+		event := waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+			return e.GetGameOver() != nil
+		})
+		if series := event.GetGameOver().Series; !series.GetOver() || series.GetWinnerId() != p1.Proto.Id {
+			t.Errorf("expected Player1 to take the series, got %v", series)
+		}
+		if gameID := s.registry.CurrentGame(p1.Proto.Id); gameID != "" {
+			t.Errorf("expected no next game, got %s", gameID)
+		}
+	})
+
+	t.Run("unranked games leave ratings alone", func(t *testing.T) {
+		req := connect.NewRequest(&pb.GetPlayerProfileRequest{})
+		req.Header().Set("Authorization", p1.SessionToken)
+		profile, err := s.GetPlayerProfile(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if profile.Msg.Wins != 1 || profile.Msg.Rating != stats.InitialRating {
+			t.Errorf("unexpected profile: %v", profile.Msg)
+		}
+	})
+}
+
+func TestPiratesServer_PlacementTimeout(t *testing.T) {
+	s := NewPiratesServer()
+	s.placementTimeout = 20 * time.Millisecond
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", game.DefaultRules())
+
+	req := connect.NewRequest(&pb.PlaceShipsRequest{Ships: []*pb.Ship{
+		{Id: "ship-1", Name: "Galion", Size: 5, Start: &pb.Coordinate{X: 0, Y: 0}, Horizontal: true},
+		{Id: "ship-2", Name: "Frégate", Size: 4, Start: &pb.Coordinate{X: 0, Y: 2}, Horizontal: true},
+		{Id: "ship-3", Name: "Brick", Size: 3, Start: &pb.Coordinate{X: 0, Y: 4}, Horizontal: true},
+		{Id: "ship-4", Name: "Corvette", Size: 3, Start: &pb.Coordinate{X: 0, Y: 6}, Horizontal: true},
+		{Id: "ship-5", Name: "Chaloupe", Size: 2, Start: &pb.Coordinate{X: 0, Y: 8}, Horizontal: true},
+	}})
+	req.Header().Set("Authorization", p1.SessionToken)
+	if resp, err := s.PlaceShips(context.Background(), req); err != nil || !resp.Msg.Valid {
+		t.Fatalf("unexpected placement: %v, %v", resp, err)
+	}
+
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameOver() != nil
+	})
+	if over := event.GetGameOver(); over.YouWon || over.Reason != pb.GameOverReason_GAME_OVER_REASON_PLACEMENT_TIMEOUT {
+		t.Errorf("expected Player2 to lose on placement timeout, got %v", over)
+	}
+	event = waitForEvent(t, p1.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameOver() != nil
+	})
+	if over := event.GetGameOver(); !over.YouWon {
+		t.Errorf("expected Player1 to win, got %v", over)
+	}
+}
+
+func TestPiratesServer_TurnTimeout(t *testing.T) {
+	s := NewPiratesServer()
+	p1 := connectPlayer(t, s, "Player1")
+	p2 := connectPlayer(t, s, "Player2")
+
+	rules := game.DefaultRules()
+	rules.TurnTime = 20 * time.Millisecond
+	s.createGame([]string{p1.Proto.Id, p2.Proto.Id}, "game-1", rules)
+
+	s.gamesMu.RLock()
+	g := s.games["game-1"]
+	s.gamesMu.RUnlock()
+	ships := []*pb.Ship{
+		{Id: "ship-1", Name: "Galion", Size: 5, Start: &pb.Coordinate{X: 0, Y: 0}, Horizontal: true},
+		{Id: "ship-2", Name: "Frégate", Size: 4, Start: &pb.Coordinate{X: 0, Y: 1}, Horizontal: true},
+		{Id: "ship-3", Name: "Brick", Size: 3, Start: &pb.Coordinate{X: 0, Y: 2}, Horizontal: true},
+		{Id: "ship-4", Name: "Corvette", Size: 3, Start: &pb.Coordinate{X: 0, Y: 3}, Horizontal: true},
+		{Id: "ship-5", Name: "Chaloupe", Size: 2, Start: &pb.Coordinate{X: 0, Y: 4}, Horizontal: true},
+	}
+	g.PlaceShips(p1.Proto.Id, ships)
+	g.PlaceShips(p2.Proto.Id, ships)
+	g.StartGame()
+	s.notifyTurnStarted(g)
+
+	event := waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetTurnStarted() != nil
+	})
+	if turn := event.GetTurnStarted(); turn.DeadlineUnixMs == 0 {
+		t.Errorf("expected a turn deadline, got %v", turn)
+	}
+	event = waitForEvent(t, p2.EventChannel, func(e *pb.GameEvent) bool {
+		return e.GetGameOver() != nil
+	})
+	if over := event.GetGameOver(); !over.YouWon || over.Reason != pb.GameOverReason_GAME_OVER_REASON_TURN_TIMEOUT {
+		t.Errorf("expected Player2 to win on timeout, got %v", over)
+	}
+}
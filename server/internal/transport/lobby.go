@@ -0,0 +1,140 @@
+package transport
+
+import (
+	"time"
+
+	"github.com/trezz/bataille-de-pirates/server/internal/matchmaker"
+	"github.com/trezz/bataille-de-pirates/server/internal/player"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// setStatus updates a player's status and schedules a lobby push for it.
+func (s *PiratesServer) setStatus(playerID string, status pb.PlayerStatus) {
+	s.registry.SetStatus(playerID, status)
+	s.lobby.PlayerChanged(playerID)
+}
+
+// pushPresence sends idle players the incremental lobby changes for the given
+// players: those still registered are sent with their current status, the
+// others are reported as departed. Their friends are sent their friend list.
+func (s *PiratesServer) pushPresence(playerIDs []string) {
+	update := &pb.PlayerListUpdate{}
+	for _, id := range playerIDs {
+		snapshot, ok := s.registry.Snapshot(id)
+		switch {
+		case !ok:
+			update.DepartedPlayerIds = append(update.DepartedPlayerIds, id)
+		case snapshot.Status == pb.PlayerStatus_PLAYER_STATUS_IN_PRIVATE_LOBBY:
+			// Hosts are hidden from the public list, as in ListPlayers.
+			update.DepartedPlayerIds = append(update.DepartedPlayerIds, id)
+		default:
+			update.ChangedPlayers = append(update.ChangedPlayers, snapshot)
+		}
+	}
+
+	for _, p := range s.registry.GetAvailablePlayers() {
+		s.sendEvent(p, &pb.GameEvent{
+			Event: &pb.GameEvent_PlayerList{
+				PlayerList: update,
+			},
+		})
+	}
+	s.pushFriendPresence(playerIDs)
+}
+
+// pushQueueStatus sends each queued player their status when it changed
+// since the last push, which includes the population of every queue.
+// Players who dropped out of the queue without a match (e.g. queue timeout)
+// are told so and become available again.
+func (s *PiratesServer) pushQueueStatus() {
+	queues := queuesToProto(s.matchmaker.Queues())
+	statuses := queueStatuses(s.matchmaker.QueueEntries(), queues)
+
+	s.queueStatusMu.Lock()
+	defer s.queueStatusMu.Unlock()
+
+	for id, status := range statuses {
+		if last, ok := s.queueStatus[id]; ok && proto.Equal(last, status) {
+			continue
+		}
+		s.queueStatus[id] = status
+		if p, ok := s.registry.GetByID(id); ok {
+			s.sendQueueStatus(p, status)
+		}
+	}
+
+	for id := range s.queueStatus {
+		if _, stillQueued := statuses[id]; stillQueued {
+			continue
+		}
+		delete(s.queueStatus, id)
+
+		p, ok := s.registry.GetByID(id)
+		if !ok || s.matchmaker.GetPendingMatch(id) != nil {
+			continue
+		}
+		if snapshot, _ := s.registry.Snapshot(id); snapshot != nil && snapshot.Status == pb.PlayerStatus_PLAYER_STATUS_IN_QUEUE {
+			s.setStatus(id, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
+		}
+		s.sendQueueStatus(p, &pb.QueueStatusUpdate{
+			InQueue: false,
+			Queues:  queues,
+		})
+	}
+}
+
+// currentQueueStatus returns playerID's queue status as of now.
+func (s *PiratesServer) currentQueueStatus(playerID string) *pb.QueueStatusUpdate {
+	queues := queuesToProto(s.matchmaker.Queues())
+	if status, ok := queueStatuses(s.matchmaker.QueueEntries(), queues)[playerID]; ok {
+		return status
+	}
+	return &pb.QueueStatusUpdate{Queues: queues}
+}
+
+// queueStatuses returns the status of every queued player, by player ID.
+func queueStatuses(entries []matchmaker.QueueEntry, queues []*pb.QueueInfo) map[string]*pb.QueueStatusUpdate {
+	totals := make(map[string]int32)
+	for _, entry := range entries {
+		totals[entry.Queue]++
+	}
+
+	positions := make(map[string]int32)
+	statuses := make(map[string]*pb.QueueStatusUpdate, len(entries))
+	for _, entry := range entries {
+		positions[entry.Queue]++
+		statuses[entry.PlayerID] = &pb.QueueStatusUpdate{
+			InQueue:        true,
+			QueuePosition:  positions[entry.Queue],
+			PlayersInQueue: totals[entry.Queue],
+			Queue:          entry.Queue,
+			Queues:         queues,
+		}
+	}
+	return statuses
+}
+
+func queuesToProto(infos []matchmaker.QueueInfo) []*pb.QueueInfo {
+	queues := make([]*pb.QueueInfo, len(infos))
+	for i, info := range infos {
+		queues[i] = &pb.QueueInfo{
+			Name:                 info.Name,
+			Rules:                info.Rules.ToProto(),
+			RatingMatched:        info.Strategy == matchmaker.StrategyRating,
+			TimeoutSeconds:       int32(info.Timeout / time.Second),
+			Players:              int32(info.Players),
+			EstimatedWaitSeconds: int32(info.EstimatedWait.Round(time.Second) / time.Second),
+		}
+	}
+	return queues
+}
+
+func (s *PiratesServer) sendQueueStatus(p *player.Player, status *pb.QueueStatusUpdate) {
+	s.sendEvent(p, &pb.GameEvent{
+		Event: &pb.GameEvent_QueueStatus{
+			QueueStatus: status,
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/matchmaker"
+	"github.com/trezz/bataille-de-pirates/server/internal/player"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func (s *PiratesServer) InviteToParty(
+	ctx context.Context,
+	req *connect.Request[pb.InviteToPartyRequest],
+) (*connect.Response[pb.Party], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.registry.GetByID(req.Msg.PlayerId); !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+	}
+	party, err := s.matchmaker.InviteToParty(p.Proto.Id, req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(s.partyToProto(party)), nil
+}
+
+func (s *PiratesServer) RespondToPartyInvite(
+	ctx context.Context,
+	req *connect.Request[pb.RespondToPartyInviteRequest],
+) (*connect.Response[pb.Party], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.matchmaker.RespondToPartyInvite(req.Msg.PartyId, p.Proto.Id, req.Msg.Accepted)
+	if errors.Is(err, matchmaker.ErrPartyNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(s.partyToProto(party)), nil
+}
+
+func (s *PiratesServer) LeaveParty(
+	ctx context.Context,
+	req *connect.Request[pb.LeavePartyRequest],
+) (*connect.Response[pb.LeavePartyResponse], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.matchmaker.LeaveParty(p.Proto.Id); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewResponse(&pb.LeavePartyResponse{}), nil
+}
+
+// joinPartyQueue queues the party led by p in the named queue. Every member
+// is taken out of their private lobby.
+func (s *PiratesServer) joinPartyQueue(p *player.Player, party *matchmaker.Party, queue string) error {
+	if err := s.matchmaker.QueueParty(p.Proto.Id, queue); err != nil {
+		return err
+	}
+	for _, id := range party.MemberIDs {
+		s.privateLobbies.Close(id)
+		s.setStatus(id, pb.PlayerStatus_PLAYER_STATUS_IN_QUEUE)
+	}
+	return nil
+}
+
+func (s *PiratesServer) handlePartyInvite(playerID string, party *matchmaker.Party) {
+	p, ok := s.registry.GetByID(playerID)
+	if !ok {
+		return
+	}
+	inviter, ok := s.registry.Snapshot(party.LeaderID)
+	if !ok {
+		return
+	}
+
+	s.sendEvent(p, &pb.GameEvent{
+		Event: &pb.GameEvent_PartyInvite{
+			PartyInvite: &pb.PartyInvite{
+				Party:   s.partyToProto(party),
+				Inviter: inviter,
+			},
+		},
+	})
+}
+
+func (s *PiratesServer) handlePartyChanged(playerIDs []string, party *matchmaker.Party) {
+	update := s.partyToProto(party)
+	for _, id := range playerIDs {
+		if p, ok := s.registry.GetByID(id); ok {
+			s.sendEvent(p, &pb.GameEvent{
+				Event: &pb.GameEvent_PartyUpdate{PartyUpdate: update},
+			})
+		}
+	}
+}
+
+func (s *PiratesServer) handlePartyDisbanded(playerIDs []string, party *matchmaker.Party) {
+	for _, id := range playerIDs {
+		if p, ok := s.registry.GetByID(id); ok {
+			s.sendEvent(p, &pb.GameEvent{
+				Event: &pb.GameEvent_PartyDisbanded{
+					PartyDisbanded: &pb.PartyDisbanded{PartyId: party.ID},
+				},
+			})
+		}
+	}
+}
+
+func (s *PiratesServer) partyToProto(party *matchmaker.Party) *pb.Party {
+	return &pb.Party{
+		Id:       party.ID,
+		LeaderId: party.LeaderID,
+		Members:  s.seatedPlayers(party.MemberIDs),
+	}
+}
@@ -0,0 +1,96 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+	"github.com/trezz/bataille-de-pirates/server/internal/lobby"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func (s *PiratesServer) CreatePrivateLobby(
+	ctx context.Context,
+	req *connect.Request[pb.CreatePrivateLobbyRequest],
+) (*connect.Response[pb.PrivateLobby], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.activeGame(p) != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("already in a game"))
+	}
+	if s.matchmaker.GetPendingMatch(p.Proto.Id) != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("already has a pending match"))
+	}
+
+	// Private lobbies seat the host and one guest, so they are never team games.
+	rules := game.RulesFromProto(req.Msg.Rules)
+	rules.Teams = false
+	privateLobby, err := s.privateLobbies.Create(p.Proto.Id, rules)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Hosts are hidden from the public lobby until the lobby closes.
+	s.matchmaker.LeaveQueue(p.Proto.Id)
+	s.setStatus(p.Proto.Id, pb.PlayerStatus_PLAYER_STATUS_IN_PRIVATE_LOBBY)
+
+	return connect.NewResponse(&pb.PrivateLobby{
+		Code:  privateLobby.Code,
+		Rules: privateLobby.Rules.ToProto(),
+	}), nil
+}
+
+func (s *PiratesServer) JoinPrivateLobby(
+	ctx context.Context,
+	req *connect.Request[pb.JoinPrivateLobbyRequest],
+) (*connect.Response[pb.JoinPrivateLobbyResponse], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	privateLobby, err := s.privateLobbies.Get(req.Msg.Code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if privateLobby.HostID == p.Proto.Id {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot join your own lobby"))
+	}
+	if s.activeGame(p) != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("already in a game"))
+	}
+	if _, ok := s.registry.GetByID(privateLobby.HostID); !ok {
+		s.privateLobbies.Close(privateLobby.HostID)
+		return nil, connect.NewError(connect.CodeNotFound, lobby.ErrLobbyNotFound)
+	}
+
+	match, err := s.matchmaker.ProposeMatch(privateLobby.HostID, p.Proto.Id, privateLobby.Rules)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(&pb.JoinPrivateLobbyResponse{
+		MatchId: match.ID,
+	}), nil
+}
+
+func (s *PiratesServer) ClosePrivateLobby(
+	ctx context.Context,
+	req *connect.Request[pb.ClosePrivateLobbyRequest],
+) (*connect.Response[pb.ClosePrivateLobbyResponse], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.privateLobbies.Close(p.Proto.Id) && s.activeGame(p) == nil {
+		s.setStatus(p.Proto.Id, pb.PlayerStatus_PLAYER_STATUS_ONLINE)
+	}
+
+	return connect.NewResponse(&pb.ClosePrivateLobbyResponse{}), nil
+}
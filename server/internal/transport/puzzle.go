@@ -0,0 +1,103 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/puzzle"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+// WithPuzzleSecret derives the daily puzzles from secret. The puzzles of a
+// server created without one change on every restart.
+func WithPuzzleSecret(secret string) Option {
+	return func(s *PiratesServer) {
+		s.puzzles = puzzle.NewManager(secret)
+	}
+}
+
+func (s *PiratesServer) StartDailyPuzzle(
+	ctx context.Context,
+	req *connect.Request[pb.StartDailyPuzzleRequest],
+) (*connect.Response[pb.DailyPuzzle], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := s.puzzles.Start(p.Proto.Id, p.Proto.DisplayName, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(daily), nil
+}
+
+func (s *PiratesServer) PuzzleAttack(
+	ctx context.Context,
+	req *connect.Request[pb.PuzzleAttackRequest],
+) (*connect.Response[pb.PuzzleShotResult], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, daily, err := s.puzzles.Attack(p.Proto.Id, int(req.Msg.Target.GetX()), int(req.Msg.Target.GetY()), time.Now())
+	if err != nil {
+		return nil, puzzleError(err)
+	}
+
+	return connect.NewResponse(&pb.PuzzleShotResult{
+		Action: &pb.PuzzleShotResult_Attack{Attack: result},
+		Puzzle: daily,
+	}), nil
+}
+
+func (s *PiratesServer) PuzzleUsePower(
+	ctx context.Context,
+	req *connect.Request[pb.PuzzleUsePowerRequest],
+) (*connect.Response[pb.PuzzleShotResult], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, daily, err := s.puzzles.UsePower(p.Proto.Id, req.Msg.Power, int(req.Msg.Target.GetX()), int(req.Msg.Target.GetY()), req.Msg.Orientation, time.Now())
+	if err != nil {
+		return nil, puzzleError(err)
+	}
+
+	return connect.NewResponse(&pb.PuzzleShotResult{
+		Action: &pb.PuzzleShotResult_Power{Power: result},
+		Puzzle: daily,
+	}), nil
+}
+
+func (s *PiratesServer) GetPuzzleLeaderboard(
+	ctx context.Context,
+	req *connect.Request[pb.GetPuzzleLeaderboardRequest],
+) (*connect.Response[pb.PuzzleLeaderboard], error) {
+	if _, err := s.authenticate(req.Header(), req.Msg.SessionToken); err != nil {
+		return nil, err
+	}
+
+	date := req.Msg.Date
+	if date == "" {
+		date = puzzle.Date(time.Now())
+	} else if _, err := time.Parse(puzzle.DateLayout, date); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid date"))
+	}
+
+	return connect.NewResponse(s.puzzles.Leaderboard(date)), nil
+}
+
+func puzzleError(err error) error {
+	switch {
+	case errors.Is(err, puzzle.ErrNoPuzzle), errors.Is(err, puzzle.ErrPuzzleExpired):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInvalidArgument, err)
+}
@@ -0,0 +1,77 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+	"github.com/trezz/bataille-de-pirates/server/internal/stats"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func (s *PiratesServer) GetPlayerProfile(
+	ctx context.Context,
+	req *connect.Request[pb.GetPlayerProfileRequest],
+) (*connect.Response[pb.PlayerProfile], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	playerID := req.Msg.PlayerId
+	if playerID == "" {
+		playerID = p.Proto.Id
+	}
+	profile, err := s.stats.Profile(playerID)
+	if errors.Is(err, stats.ErrPlayerNotFound) {
+		// Connected players who finished no game yet have a blank record.
+		other, ok := s.registry.GetByID(playerID)
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		profile = &pb.PlayerProfile{
+			PlayerId:    playerID,
+			DisplayName: other.Proto.DisplayName,
+			Rating:      stats.InitialRating,
+		}
+	}
+	profile.Achievements = s.achievements.Achievements(playerID)
+
+	return connect.NewResponse(profile), nil
+}
+
+func (s *PiratesServer) GetLeaderboard(
+	ctx context.Context,
+	req *connect.Request[pb.GetLeaderboardRequest],
+) (*connect.Response[pb.Leaderboard], error) {
+	if _, err := s.authenticate(req.Header(), req.Msg.SessionToken); err != nil {
+		return nil, err
+	}
+
+	board, err := s.stats.Leaderboard(req.Msg.Scope, req.Msg.RuleSet, time.Now(), int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(board), nil
+}
+
+// recordStats adds the finished game g to its players' records, and
+// reports whether it was the first to, with each player's rating change.
+func (s *PiratesServer) recordStats(g *game.Game) (map[string]int, bool) {
+	names := make(map[string]string, len(g.PlayerIDs))
+	for _, p := range s.seatedPlayers(g.PlayerIDs) {
+		names[p.Id] = p.DisplayName
+	}
+	return s.stats.Record(stats.Game{
+		ID:       g.ID,
+		RuleSet:  g.Rules.RuleSet,
+		EndedAt:  g.EndedAt,
+		Outcomes: g.Outcomes(),
+		Names:    names,
+		Unranked: !g.Rules.Ranked,
+	})
+}
@@ -0,0 +1,161 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/trezz/bataille-de-pirates/server/internal/game"
+	"github.com/trezz/bataille-de-pirates/server/internal/tournament"
+
+	pb "github.com/trezz/bataille-de-pirates/server/gen/pirates/v1"
+)
+
+func (s *PiratesServer) CreateTournament(
+	ctx context.Context,
+	req *connect.Request[pb.CreateTournamentRequest],
+) (*connect.Response[pb.Tournament], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.tournaments.Create(p.Proto.Id, req.Msg.Name, req.Msg.Format, game.RulesFromProto(req.Msg.Rules))
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+
+	return connect.NewResponse(t), nil
+}
+
+func (s *PiratesServer) ListTournaments(
+	ctx context.Context,
+	req *connect.Request[pb.ListTournamentsRequest],
+) (*connect.Response[pb.ListTournamentsResponse], error) {
+	if _, err := s.authenticate(req.Header(), req.Msg.SessionToken); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.ListTournamentsResponse{
+		Tournaments: s.tournaments.List(),
+	}), nil
+}
+
+func (s *PiratesServer) RegisterForTournament(
+	ctx context.Context,
+	req *connect.Request[pb.RegisterForTournamentRequest],
+) (*connect.Response[pb.Tournament], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.tournaments.Register(req.Msg.TournamentId, p.Proto.Id)
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+
+	return connect.NewResponse(t), nil
+}
+
+func (s *PiratesServer) WithdrawFromTournament(
+	ctx context.Context,
+	req *connect.Request[pb.WithdrawFromTournamentRequest],
+) (*connect.Response[pb.Tournament], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.tournaments.Withdraw(req.Msg.TournamentId, p.Proto.Id)
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+
+	return connect.NewResponse(t), nil
+}
+
+func (s *PiratesServer) StartTournament(
+	ctx context.Context,
+	req *connect.Request[pb.StartTournamentRequest],
+) (*connect.Response[pb.TournamentBracket], error) {
+	p, err := s.authenticate(req.Header(), req.Msg.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	bracket, err := s.tournaments.Start(req.Msg.TournamentId, p.Proto.Id)
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+
+	return connect.NewResponse(bracket), nil
+}
+
+func (s *PiratesServer) GetTournament(
+	ctx context.Context,
+	req *connect.Request[pb.GetTournamentRequest],
+) (*connect.Response[pb.TournamentBracket], error) {
+	if _, err := s.authenticate(req.Header(), req.Msg.SessionToken); err != nil {
+		return nil, err
+	}
+
+	bracket, err := s.tournaments.Bracket(req.Msg.TournamentId)
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+
+	return connect.NewResponse(bracket), nil
+}
+
+func tournamentError(err error) error {
+	switch {
+	case errors.Is(err, tournament.ErrTournamentNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, tournament.ErrInvalidFormat):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, tournament.ErrNotOrganizer):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, err)
+}
+
+func (s *PiratesServer) runTournaments() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.scheduleTournaments()
+	}
+}
+
+// scheduleTournaments proposes every tournament match waiting for its game
+// to its players, once both are connected and free. Players who decline
+// are proposed the match again until its no-show deadline.
+func (s *PiratesServer) scheduleTournaments() {
+	for _, pairing := range s.tournaments.Schedule(time.Now(), s.registry.IsConnected) {
+		if !s.freeForTournament(pairing.Player1ID) || !s.freeForTournament(pairing.Player2ID) {
+			continue
+		}
+		s.matchmaker.ProposeTournamentMatch(pairing.Player1ID, pairing.Player2ID, pairing.Rules, pairing.MatchID)
+	}
+}
+
+func (s *PiratesServer) freeForTournament(playerID string) bool {
+	p, ok := s.registry.GetByID(playerID)
+	if !ok || !s.registry.IsConnected(playerID) {
+		return false
+	}
+	return s.activeGame(p) == nil && s.matchmaker.GetPendingMatch(playerID) == nil
+}
+
+func (s *PiratesServer) handleTournamentUpdate(playerIDs []string, bracket *pb.TournamentBracket) {
+	for _, id := range playerIDs {
+		if p, ok := s.registry.GetByID(id); ok {
+			s.sendEvent(p, &pb.GameEvent{
+				Event: &pb.GameEvent_TournamentUpdate{TournamentUpdate: bracket},
+			})
+		}
+	}
+}